@@ -0,0 +1,37 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/momonoki1990/tech-blog-api/domain/errs"
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+)
+
+// 権限がない場合のレスポンスのdetails
+type PermissionDetails struct {
+	MissingPermission model.Permission `json:"missingPermission"`
+}
+
+// ctxの送り手がpermissionを持っているか確かめる
+// 認証していなければUnauthenticated、権限がなければForbidden（不足している権限をdetailsで示す）
+func Require(ctx context.Context, permission model.Permission) (error) {
+	p := PrincipalFrom(ctx)
+	if p == nil {
+		return errs.NewUnauthenticated(errs.CodeAuthenticationRequired, "Authentication is required")
+	}
+	if !p.Can(permission) {
+		return errs.NewForbidden(
+			errs.CodePermissionDenied,
+			fmt.Sprintf("Permission %s is required", permission),
+			&PermissionDetails{MissingPermission: permission},
+		)
+	}
+	return nil
+}
+
+// 認証していて、permissionを持っているか（持っていなくてもエラーにしない場合に使う）
+func Can(ctx context.Context, permission model.Permission) bool {
+	p := PrincipalFrom(ctx)
+	return p != nil && p.Can(permission)
+}
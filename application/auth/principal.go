@@ -0,0 +1,50 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+)
+
+// 認証済みのリクエストの送り手
+type Principal struct {
+	UserId uuid.UUID
+	Name string
+	Role model.Role
+	// 認証に使ったアクセストークン（ログアウトで失効させる）。APIキーなら空
+	TokenId string
+	TokenExpiresAt time.Time
+	// APIキーで認証した場合のキーとスコープ。ログインのトークンならnil
+	ApiKeyId *uuid.UUID
+	Scopes []model.Permission
+}
+
+// APIキーなら、役割の権限のうちスコープにあるものだけ使える
+func (p *Principal) Can(permission model.Permission) bool {
+	if !p.Role.Can(permission) {
+		return false
+	}
+	if p.ApiKeyId == nil {
+		return true
+	}
+	for _, v := range p.Scopes {
+		if v == permission {
+			return true
+		}
+	}
+	return false
+}
+
+type principalKey struct{}
+
+func WithPrincipal(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// 認証していないリクエストならnil
+func PrincipalFrom(ctx context.Context) *Principal {
+	p, _ := ctx.Value(principalKey{}).(*Principal)
+	return p
+}
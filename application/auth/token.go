@@ -0,0 +1,32 @@
+package auth
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TokenType string
+
+const (
+	// APIの呼び出しに使う短命なトークン
+	AccessToken TokenType = "access"
+	// アクセストークンの再発行にだけ使うトークン
+	RefreshToken TokenType = "refresh"
+)
+
+type Claims struct {
+	// 失効させるときに使う（トークンごとに異なる）
+	Id string
+	UserId uuid.UUID
+	Type TokenType
+	IssuedAt time.Time
+	ExpiresAt time.Time
+}
+
+// トークンに署名し、検証する
+type TokenSigner interface {
+	Sign(c *Claims) (string, error)
+	// 署名が正しくない、または期限切れならUnauthenticated。失効したかどうかは調べない
+	Verify(token string) (*Claims, error)
+}
@@ -0,0 +1,16 @@
+package render
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+)
+
+// 記事の本文（Markdown）をサニタイズ済みのHTMLにする
+type ContentRenderer interface {
+	// 同じ本文であれば前回の結果を返す
+	Render(ctx context.Context, a *model.Article) (string, error)
+	// 本文が変わった、または削除された記事の結果を破棄する
+	Invalidate(id uuid.UUID)
+}
@@ -0,0 +1,68 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./application/render/content_renderer.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./application/render/content_renderer.go -destination=./application/render/mock/content_renderer.go
+//
+// Package mock_render is a generated GoMock package.
+package mock_render
+
+import (
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	model "github.com/momonoki1990/tech-blog-api/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockContentRenderer is a mock of ContentRenderer interface.
+type MockContentRenderer struct {
+	ctrl     *gomock.Controller
+	recorder *MockContentRendererMockRecorder
+}
+
+// MockContentRendererMockRecorder is the mock recorder for MockContentRenderer.
+type MockContentRendererMockRecorder struct {
+	mock *MockContentRenderer
+}
+
+// NewMockContentRenderer creates a new mock instance.
+func NewMockContentRenderer(ctrl *gomock.Controller) *MockContentRenderer {
+	mock := &MockContentRenderer{ctrl: ctrl}
+	mock.recorder = &MockContentRendererMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockContentRenderer) EXPECT() *MockContentRendererMockRecorder {
+	return m.recorder
+}
+
+// Invalidate mocks base method.
+func (m *MockContentRenderer) Invalidate(id uuid.UUID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Invalidate", id)
+}
+
+// Invalidate indicates an expected call of Invalidate.
+func (mr *MockContentRendererMockRecorder) Invalidate(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Invalidate", reflect.TypeOf((*MockContentRenderer)(nil).Invalidate), id)
+}
+
+// Render mocks base method.
+func (m *MockContentRenderer) Render(ctx context.Context, a *model.Article) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Render", ctx, a)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Render indicates an expected call of Render.
+func (mr *MockContentRendererMockRecorder) Render(ctx, a any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Render", reflect.TypeOf((*MockContentRenderer)(nil).Render), ctx, a)
+}
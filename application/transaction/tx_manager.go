@@ -0,0 +1,21 @@
+package transaction
+
+import (
+	"context"
+
+	"github.com/momonoki1990/tech-blog-api/domain/repository"
+)
+
+// トランザクション内で使うリポジトリ群
+type Repositories struct {
+	ArticleRepository repository.ArticleRepository
+	CategoryRepository repository.CategoryRepository
+	ArticleRevisionRepository repository.ArticleRevisionRepository
+	TagRepository repository.TagRepository
+	AuthorRepository repository.AuthorRepository
+}
+
+type TxManager interface {
+	// fnがエラーを返せばロールバック、nilならコミットする
+	RunInTx(ctx context.Context, fn func(r *Repositories) error) (error)
+}
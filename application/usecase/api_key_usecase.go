@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/momonoki1990/tech-blog-api/application/auth"
+	"github.com/momonoki1990/tech-blog-api/domain/errs"
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+	"github.com/momonoki1990/tech-blog-api/domain/repository"
+)
+
+// APIキーの管理はログインしたユーザーだけができる（漏れたキーで新しいキーを作らせない）
+type ApiKeyUseCase interface {
+	// 自分のキーを作り、キー自体と一緒に返す。自分の役割にないスコープは付けられない
+	CreateApiKey(ctx context.Context, name string, scopes []string, expiresAt *time.Time) (*model.ApiKey, string, error)
+	// 自分のキーの一覧（期限切れも含む）
+	GetApiKeys(ctx context.Context) ([]*model.ApiKey, error)
+	// 自分のキーを失効させる。users:manageがあれば他のユーザーのキーも失効させられる
+	RevokeApiKey(ctx context.Context, id uuid.UUID) (error)
+}
+
+type apiKeyUseCase struct {
+	repository.ApiKeyRepository
+	now func() time.Time
+}
+
+func NewApiKeyUseCase(r repository.ApiKeyRepository, now func() time.Time) ApiKeyUseCase {
+	return &apiKeyUseCase{r, now}
+}
+
+func (u *apiKeyUseCase) CreateApiKey(ctx context.Context, name string, scopes []string, expiresAt *time.Time) (*model.ApiKey, string, error) {
+	principal, err := requireLogin(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	apiKey, key, err := model.NewApiKey(principal.UserId, name, scopes, expiresAt, u.now())
+	if err != nil {
+		return nil, "", err
+	}
+	for _, p := range apiKey.Scopes {
+		if err := auth.Require(ctx, p); err != nil {
+			return nil, "", err
+		}
+	}
+	if err := u.ApiKeyRepository.Insert(ctx, apiKey); err != nil {
+		return nil, "", err
+	}
+	return apiKey, key, nil
+}
+
+func (u *apiKeyUseCase) GetApiKeys(ctx context.Context) ([]*model.ApiKey, error) {
+	principal, err := requireLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return u.ApiKeyRepository.FindAllByUserId(ctx, principal.UserId)
+}
+
+func (u *apiKeyUseCase) RevokeApiKey(ctx context.Context, id uuid.UUID) (error) {
+	principal, err := requireLogin(ctx)
+	if err != nil {
+		return err
+	}
+	apiKey, err := u.ApiKeyRepository.FindOneById(ctx, id)
+	if err != nil {
+		return err
+	}
+	// 他のユーザーのキーがあることは知らせない
+	if apiKey == nil || (apiKey.UserId != principal.UserId && !principal.Can(model.PermUsersManage)) {
+		return errs.NewNotFound(errs.CodeApiKeyNotFound, "API key was not found")
+	}
+	return u.ApiKeyRepository.Delete(ctx, id)
+}
+
+func requireLogin(ctx context.Context) (*auth.Principal, error) {
+	principal := auth.PrincipalFrom(ctx)
+	if principal == nil {
+		return nil, errs.NewUnauthenticated(errs.CodeAuthenticationRequired, "Authentication is required")
+	}
+	if principal.ApiKeyId != nil {
+		return nil, errs.NewForbidden(errs.CodeApiKeyNotAllowed, "API keys cannot be managed with an API key. Log in instead", nil)
+	}
+	return principal, nil
+}
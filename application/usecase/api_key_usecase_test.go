@@ -0,0 +1,135 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/momonoki1990/tech-blog-api/application/auth"
+	"github.com/momonoki1990/tech-blog-api/domain/errs"
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+	mock_repo "github.com/momonoki1990/tech-blog-api/infra/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateApiKey(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	userId := uuid.New()
+	ctx := withTestRole(context.TODO(), userId, model.RoleWriter)
+
+	// Prepare
+	mockApiKeyRepository := mock_repo.NewMockApiKeyRepository(mockCtrl)
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	expiresAt := now.Add(90 * 24 * time.Hour)
+
+	// Expected & Mock
+	var inserted *model.ApiKey
+	mockApiKeyRepository.EXPECT().Insert(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, k *model.ApiKey) error {
+		inserted = k
+		return nil
+	})
+
+	// Execute
+	u := NewApiKeyUseCase(mockApiKeyRepository, func() time.Time { return now })
+	apiKey, key, err := u.CreateApiKey(ctx, "CI", []string{"articles:write", "categories:read"}, &expiresAt)
+
+	// Check
+	if err != nil {
+		t.Fatalf("err of u.CreateApiKey: Expected %v, but got %v", nil, err)
+	}
+	if apiKey != inserted || apiKey.UserId != userId {
+		t.Errorf("apiKey: Expected inserted key of %v, but got %v", userId, apiKey)
+	}
+	// キー自体は保存しない
+	if !model.IsApiKey(key) || apiKey.KeyHash != model.HashApiKey(key) || apiKey.KeyHash == key {
+		t.Errorf("apiKey.KeyHash: Expected hash of %s, but got %s", key, apiKey.KeyHash)
+	}
+	if len(apiKey.Scopes) != 2 || apiKey.Scopes[0] != model.PermArticlesWrite || apiKey.Scopes[1] != model.PermCategoriesRead {
+		t.Errorf("apiKey.Scopes: Expected %v, but got %v", []string{"articles:write", "categories:read"}, apiKey.Scopes)
+	}
+}
+
+func TestCreateApiKeyError(t *testing.T) {
+	apiKeyId := uuid.New()
+	apiKeyCtx := auth.WithPrincipal(context.TODO(), &auth.Principal{UserId: uuid.New(), Role: model.RoleAdmin, ApiKeyId: &apiKeyId, Scopes: model.RoleAdmin.Permissions()})
+	tests := []struct {
+		name string
+		ctx context.Context
+		scopes []string
+		kind errs.Kind
+		code string
+	}{
+		{"役割にないスコープ", withTestRole(context.TODO(), uuid.New(), model.RoleWriter), []string{"articles:publish"}, errs.Forbidden, errs.CodePermissionDenied},
+		{"存在しないスコープ", withTestRole(context.TODO(), uuid.New(), model.RoleAdmin), []string{"categories:delete"}, errs.Validation, errs.CodeValidationFailed},
+		{"スコープなし", withTestRole(context.TODO(), uuid.New(), model.RoleAdmin), nil, errs.Validation, errs.CodeValidationFailed},
+		{"APIキーでの作成", apiKeyCtx, []string{"articles:write"}, errs.Forbidden, errs.CodeApiKeyNotAllowed},
+		{"未認証", context.TODO(), []string{"articles:write"}, errs.Unauthenticated, errs.CodeAuthenticationRequired},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			// Prepare
+			mockApiKeyRepository := mock_repo.NewMockApiKeyRepository(mockCtrl)
+
+			// Expected & Mock: 保存しない
+
+			// Execute
+			u := NewApiKeyUseCase(mockApiKeyRepository, time.Now)
+			_, _, err := u.CreateApiKey(tt.ctx, "CI", tt.scopes, nil)
+
+			// Check
+			if !errs.IsKind(err, tt.kind) || errorCode(err) != tt.code {
+				t.Errorf("err of u.CreateApiKey: Expected %v %v, but got %v", tt.kind, tt.code, err)
+			}
+		})
+	}
+}
+
+func TestRevokeApiKey(t *testing.T) {
+	ownerId := uuid.New()
+	tests := []struct {
+		name string
+		ctx context.Context
+		shouldDelete bool
+	}{
+		{"自分のキー", withTestRole(context.TODO(), ownerId, model.RoleWriter), true},
+		{"他のユーザーのキー", withTestRole(context.TODO(), uuid.New(), model.RoleEditor), false},
+		{"adminは他のユーザーのキーも失効させられる", withTestRole(context.TODO(), uuid.New(), model.RoleAdmin), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			// Prepare
+			mockApiKeyRepository := mock_repo.NewMockApiKeyRepository(mockCtrl)
+			apiKey, _, err := model.NewApiKey(ownerId, "CI", []string{"articles:write"}, nil, time.Now())
+			if err != nil {
+				panic(err)
+			}
+
+			// Expected & Mock
+			mockApiKeyRepository.EXPECT().FindOneById(tt.ctx, apiKey.Id).Return(apiKey, nil)
+			if tt.shouldDelete {
+				mockApiKeyRepository.EXPECT().Delete(tt.ctx, apiKey.Id).Return(nil)
+			}
+
+			// Execute
+			u := NewApiKeyUseCase(mockApiKeyRepository, time.Now)
+			err = u.RevokeApiKey(tt.ctx, apiKey.Id)
+
+			// Check
+			if tt.shouldDelete && err != nil {
+				t.Errorf("err of u.RevokeApiKey: Expected %v, but got %v", nil, err)
+			}
+			// 他のユーザーのキーがあることは知らせない
+			if !tt.shouldDelete && errorCode(err) != errs.CodeApiKeyNotFound {
+				t.Errorf("err of u.RevokeApiKey: Expected %v, but got %v", errs.CodeApiKeyNotFound, err)
+			}
+		})
+	}
+}
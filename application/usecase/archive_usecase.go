@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/momonoki1990/tech-blog-api/domain/errs"
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+	"github.com/momonoki1990/tech-blog-api/domain/repository"
+)
+
+// 年月は読者の地域（loc）で数える（例: 日本時間の4月1日0時30分に公開した記事は4月）
+type ArchiveUseCase interface {
+	// 公開済みの記事がある年月（新しい月から）
+	GetArchiveMonths(ctx context.Context) ([]*model.ArchiveMonth, error)
+	// year年month月に公開した記事。criteriaは並び順とページングだけを使う
+	GetArchiveArticles(ctx context.Context, year int, month int, criteria *repository.ArticleCriteria) ([]*model.Article, *repository.ArticleCursor, error)
+}
+
+type archiveUseCase struct {
+	repository.ArticleRepository
+	loc *time.Location
+}
+
+func NewArchiveUseCase(r repository.ArticleRepository, loc *time.Location) ArchiveUseCase {
+	return &archiveUseCase{r, loc}
+}
+
+func (u *archiveUseCase) GetArchiveMonths(ctx context.Context) ([]*model.ArchiveMonth, error) {
+	return u.ArticleRepository.CountPublishedByMonth(ctx, u.loc)
+}
+
+func (u *archiveUseCase) GetArchiveArticles(ctx context.Context, year int, month int, criteria *repository.ArticleCriteria) ([]*model.Article, *repository.ArticleCursor, error) {
+	var fields []errs.FieldError
+	if year < 1 || year > 9999 {
+		fields = append(fields, errs.FieldError{Field: "year", Message: "year should be from 1 to 9999"})
+	}
+	if month < 1 || month > 12 {
+		fields = append(fields, errs.FieldError{Field: "month", Message: fmt.Sprintf("month should be from %d to %d", 1, 12)})
+	}
+	if err := errs.NewValidationFromFields(errs.CodeValidationFailed, fields); err != nil {
+		return nil, nil, err
+	}
+	published := model.Published
+	from := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, u.loc)
+	to := from.AddDate(0, 1, 0)
+	c := &repository.ArticleCriteria{
+		Status: &published,
+		PublishedFrom: &from,
+		PublishedTo: &to,
+		SortKey: criteria.SortKey,
+		Ascending: criteria.Ascending,
+		Limit: criteria.Limit,
+		After: criteria.After,
+	}
+	return u.ArticleRepository.Find(ctx, c)
+}
@@ -0,0 +1,76 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/momonoki1990/tech-blog-api/domain/errs"
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+	"github.com/momonoki1990/tech-blog-api/domain/repository"
+	mock_repo "github.com/momonoki1990/tech-blog-api/infra/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetArchiveArticles(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := context.TODO()
+
+	// Prepare
+	mockArticleRepository := mock_repo.NewMockArticleRepository(mockCtrl)
+	loc, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		panic(err)
+	}
+	criteria := &repository.ArticleCriteria{SortKey: repository.SortByPublishedAt, Limit: 10}
+
+	// Expected & Mock
+	published := model.Published
+	// 日本時間の月初めはUTCでは前日の15時
+	from := time.Date(2025, 3, 31, 15, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 4, 30, 15, 0, 0, 0, time.UTC)
+	articles := []*model.Article{}
+	mockArticleRepository.EXPECT().Find(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, c *repository.ArticleCriteria) ([]*model.Article, *repository.ArticleCursor, error) {
+		if *c.Status != published {
+			t.Errorf("c.Status: Expected %v, but got %v", published, *c.Status)
+		}
+		if !c.PublishedFrom.Equal(from) {
+			t.Errorf("c.PublishedFrom: Expected %v, but got %v", from, c.PublishedFrom)
+		}
+		if !c.PublishedTo.Equal(to) {
+			t.Errorf("c.PublishedTo: Expected %v, but got %v", to, c.PublishedTo)
+		}
+		if c.Limit != 10 {
+			t.Errorf("c.Limit: Expected %v, but got %v", 10, c.Limit)
+		}
+		return articles, nil, nil
+	})
+
+	// Execute
+	u := NewArchiveUseCase(mockArticleRepository, loc)
+	_, _, err = u.GetArchiveArticles(ctx, 2025, 4, criteria)
+
+	// Check
+	if err != nil {
+		t.Errorf("err of u.GetArchiveArticles(ctx, 2025, 4, criteria): Expected %v, but got %v", nil, err)
+	}
+}
+
+func TestGetArchiveArticlesInvalidMonthError(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := context.TODO()
+
+	// Prepare
+	mockArticleRepository := mock_repo.NewMockArticleRepository(mockCtrl)
+
+	// Execute
+	u := NewArchiveUseCase(mockArticleRepository, time.UTC)
+	_, _, err := u.GetArchiveArticles(ctx, 2025, 13, &repository.ArticleCriteria{})
+
+	// Check
+	if !errs.IsKind(err, errs.Validation) {
+		t.Errorf("err of u.GetArchiveArticles(ctx, 2025, 13, criteria): Expected %v, but got %v", errs.Validation, err)
+	}
+}
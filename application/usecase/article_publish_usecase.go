@@ -0,0 +1,27 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/momonoki1990/tech-blog-api/domain/repository"
+)
+
+// 予約投稿の公開（定期実行される）
+type ArticlePublishUseCase interface {
+	PublishDueArticles(ctx context.Context) (int64, error)
+}
+
+type articlePublishUseCase struct {
+	repository.ArticleRepository
+	// テストでは固定の時刻を返す関数を渡す
+	now func() time.Time
+}
+
+func NewArticlePublishUseCase(r repository.ArticleRepository, now func() time.Time) ArticlePublishUseCase {
+	return &articlePublishUseCase{r, now}
+}
+
+func (u *articlePublishUseCase) PublishDueArticles(ctx context.Context) (int64, error) {
+	return u.ArticleRepository.PublishScheduled(ctx, u.now())
+}
@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mock_repo "github.com/momonoki1990/tech-blog-api/infra/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPublishDueArticles(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := context.TODO()
+
+	// Prepare
+	mockArticleRepository := mock_repo.NewMockArticleRepository(mockCtrl)
+	now := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	// Expected & Mock
+	mockArticleRepository.EXPECT().PublishScheduled(ctx, now).Return(int64(2), nil)
+
+	// Execute
+	u := NewArticlePublishUseCase(mockArticleRepository, func() time.Time { return now })
+	n, err := u.PublishDueArticles(ctx)
+
+	// Check
+	if err != nil {
+		t.Errorf("err of u.PublishDueArticles(ctx): Expected %v, but got %v", nil, err)
+	}
+	if n != 2 {
+		t.Errorf("n: Expected %d, but got %d", 2, n)
+	}
+}
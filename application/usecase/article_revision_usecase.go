@@ -0,0 +1,130 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/momonoki1990/tech-blog-api/application/auth"
+	"github.com/momonoki1990/tech-blog-api/application/render"
+	"github.com/momonoki1990/tech-blog-api/application/transaction"
+	"github.com/momonoki1990/tech-blog-api/domain/errs"
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+	"github.com/momonoki1990/tech-blog-api/domain/repository"
+	"github.com/momonoki1990/tech-blog-api/domain/service"
+)
+
+// 記事の版の参照・比較・復元
+type ArticleRevisionUseCase interface {
+	GetRevisions(ctx context.Context, articleId uuid.UUID) ([]*model.ArticleRevision, error)
+	GetRevision(ctx context.Context, articleId uuid.UUID, number int) (*model.ArticleRevision, error)
+	DiffRevisions(ctx context.Context, articleId uuid.UUID, from int, to int) (*model.RevisionDiff, error)
+	// 指定した版の内容で記事を更新し、その内容を新しい版として残す
+	RestoreRevision(ctx context.Context, articleId uuid.UUID, number int) (error)
+}
+
+type articleRevisionUseCase struct {
+	repository.ArticleRepository
+	repository.ArticleRevisionRepository
+	transaction.TxManager
+	service.ArticleValidator
+	render.ContentRenderer
+	tagNameRules *model.TagNameRules
+}
+
+func NewArticleRevisionUseCase(ar repository.ArticleRepository, rr repository.ArticleRevisionRepository, tm transaction.TxManager, av service.ArticleValidator, cr render.ContentRenderer, rules *model.TagNameRules) ArticleRevisionUseCase {
+	return &articleRevisionUseCase{ar, rr, tm, av, cr, rules}
+}
+
+func (u *articleRevisionUseCase) GetRevisions(ctx context.Context, articleId uuid.UUID) ([]*model.ArticleRevision, error) {
+	if err := auth.Require(ctx, model.PermArticlesReadDrafts); err != nil {
+		return nil, err
+	}
+	article, err := u.ArticleRepository.FindOneById(ctx, articleId)
+	if err != nil {
+		return nil, err
+	}
+	if article == nil {
+		return nil, errs.NewNotFound(errs.CodeArticleNotFound, "Article was not found")
+	}
+	return u.ArticleRevisionRepository.FindByArticleId(ctx, articleId)
+}
+
+func (u *articleRevisionUseCase) GetRevision(ctx context.Context, articleId uuid.UUID, number int) (*model.ArticleRevision, error) {
+	if err := auth.Require(ctx, model.PermArticlesReadDrafts); err != nil {
+		return nil, err
+	}
+	return findRevision(ctx, u.ArticleRevisionRepository, articleId, number)
+}
+
+func (u *articleRevisionUseCase) DiffRevisions(ctx context.Context, articleId uuid.UUID, from int, to int) (*model.RevisionDiff, error) {
+	if err := auth.Require(ctx, model.PermArticlesReadDrafts); err != nil {
+		return nil, err
+	}
+	fromRevision, err := findRevision(ctx, u.ArticleRevisionRepository, articleId, from)
+	if err != nil {
+		return nil, err
+	}
+	toRevision, err := findRevision(ctx, u.ArticleRevisionRepository, articleId, to)
+	if err != nil {
+		return nil, err
+	}
+	return fromRevision.Diff(toRevision), nil
+}
+
+func (u *articleRevisionUseCase) RestoreRevision(ctx context.Context, articleId uuid.UUID, number int) (error) {
+	contentChanged := false
+	err := u.TxManager.RunInTx(ctx, func(r *transaction.Repositories) error {
+		article, err := r.ArticleRepository.FindOneById(ctx, articleId)
+		if err != nil {
+			return err
+		}
+		if article == nil {
+			return errs.NewNotFound(errs.CodeArticleNotFound, "Article to restore was not found")
+		}
+		if err := authorizeArticleEdit(ctx, article); err != nil {
+			return err
+		}
+		if err := authorizeArticlePublish(ctx, article.Status); err != nil {
+			return err
+		}
+		revision, err := findRevision(ctx, r.ArticleRevisionRepository, articleId, number)
+		if err != nil {
+			return err
+		}
+		latest, err := snapshotRevision(ctx, r, article)
+		if err != nil {
+			return err
+		}
+
+		contentChanged = article.Content != revision.Content
+		// 版を保存した後に追加された別名などもタグ名に適用する
+		revision.TagNames = u.tagNameRules.CanonicalNames(revision.TagNames)
+		revision.ApplyTo(article)
+		// 復元先のカテゴリが削除されている場合などは検証エラーになる
+		if err := u.ArticleValidator.Validate(ctx, r.CategoryRepository, article); err != nil {
+			return err
+		}
+		if err := r.ArticleRepository.Update(ctx, article); err != nil {
+			return err
+		}
+		return recordRevision(ctx, r, article, latest)
+	})
+	if err != nil {
+		return err
+	}
+	if contentChanged {
+		u.ContentRenderer.Invalidate(articleId)
+	}
+	return nil
+}
+
+func findRevision(ctx context.Context, r repository.ArticleRevisionRepository, articleId uuid.UUID, number int) (*model.ArticleRevision, error) {
+	revision, err := r.FindOne(ctx, articleId, number)
+	if err != nil {
+		return nil, err
+	}
+	if revision == nil {
+		return nil, errs.NewNotFound(errs.CodeArticleRevisionNotFound, "Article revision was not found")
+	}
+	return revision, nil
+}
@@ -0,0 +1,144 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	mock_render "github.com/momonoki1990/tech-blog-api/application/render/mock"
+	"github.com/momonoki1990/tech-blog-api/application/transaction"
+	mock_transaction "github.com/momonoki1990/tech-blog-api/application/transaction/mock"
+	"github.com/momonoki1990/tech-blog-api/domain/errs"
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+	mock_service "github.com/momonoki1990/tech-blog-api/domain/service/mock"
+	mock_repo "github.com/momonoki1990/tech-blog-api/infra/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetRevisionNotFoundError(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := withTestPrincipal(context.TODO())
+
+	// Prepare
+	mockArticleRepository := mock_repo.NewMockArticleRepository(mockCtrl)
+	mockArticleRevisionRepository := mock_repo.NewMockArticleRevisionRepository(mockCtrl)
+	mockTxManager := mock_transaction.NewMockTxManager(mockCtrl)
+	mockArticleValidator := mock_service.NewMockArticleValidator(mockCtrl)
+	mockContentRenderer := mock_render.NewMockContentRenderer(mockCtrl)
+	articleId := uuid.New()
+
+	// Expected & Mock
+	mockArticleRevisionRepository.EXPECT().FindOne(ctx, articleId, 3).Return(nil, nil)
+
+	// Execute
+	u := NewArticleRevisionUseCase(mockArticleRepository, mockArticleRevisionRepository, mockTxManager, mockArticleValidator, mockContentRenderer, model.NewTagNameRules(nil, nil))
+	revision, err := u.GetRevision(ctx, articleId, 3)
+
+	// Check
+	if revision != nil {
+		t.Errorf("revision: Expected %v, but got %v", nil, revision)
+	}
+	e, ok := errs.As(err)
+	if !ok || e.Kind != errs.NotFound || e.Code != errs.CodeArticleRevisionNotFound {
+		t.Errorf("err of u.GetRevision: Expected %s, but got %v", errs.CodeArticleRevisionNotFound, err)
+	}
+}
+
+func TestDiffRevisions(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := withTestPrincipal(context.TODO())
+
+	// Prepare
+	mockArticleRepository := mock_repo.NewMockArticleRepository(mockCtrl)
+	mockArticleRevisionRepository := mock_repo.NewMockArticleRevisionRepository(mockCtrl)
+	mockTxManager := mock_transaction.NewMockTxManager(mockCtrl)
+	mockArticleValidator := mock_service.NewMockArticleValidator(mockCtrl)
+	mockContentRenderer := mock_render.NewMockContentRenderer(mockCtrl)
+	article, err := model.NewArticle("Title1", "line1\nline2", uuid.New(), []string{"Tag1"}, false)
+	if err != nil {
+		panic(err)
+	}
+	revision1 := model.NewArticleRevision(article, 1)
+	article.Content = "line1\nline2 changed"
+	article.SetTags([]string{"Tag2"})
+	revision2 := model.NewArticleRevision(article, 2)
+
+	// Expected & Mock
+	mockArticleRevisionRepository.EXPECT().FindOne(ctx, article.Id, 1).Return(revision1, nil)
+	mockArticleRevisionRepository.EXPECT().FindOne(ctx, article.Id, 2).Return(revision2, nil)
+
+	// Execute
+	u := NewArticleRevisionUseCase(mockArticleRepository, mockArticleRevisionRepository, mockTxManager, mockArticleValidator, mockContentRenderer, model.NewTagNameRules(nil, nil))
+	diff, err := u.DiffRevisions(ctx, article.Id, 1, 2)
+
+	// Check
+	if err != nil {
+		t.Fatalf("err of u.DiffRevisions: Expected %v, but got %v", nil, err)
+	}
+	if diff.From != 1 || diff.To != 2 {
+		t.Errorf("diff.From, diff.To: Expected %d %d, but got %d %d", 1, 2, diff.From, diff.To)
+	}
+	if len(diff.Content) != 3 || diff.Content[1].Op != model.DiffDelete || diff.Content[2].Op != model.DiffInsert {
+		t.Errorf("diff.Content: Expected %s, but got %v", "equal,delete,insert", diff.Content)
+	}
+	if len(diff.AddedTagNames) != 1 || diff.AddedTagNames[0] != "Tag2" {
+		t.Errorf("diff.AddedTagNames: Expected %v, but got %v", []string{"Tag2"}, diff.AddedTagNames)
+	}
+	if len(diff.RemovedTagNames) != 1 || diff.RemovedTagNames[0] != "Tag1" {
+		t.Errorf("diff.RemovedTagNames: Expected %v, but got %v", []string{"Tag1"}, diff.RemovedTagNames)
+	}
+}
+
+func TestRestoreRevision(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := withTestPrincipal(context.TODO())
+
+	// Prepare
+	mockArticleRepository := mock_repo.NewMockArticleRepository(mockCtrl)
+	mockCategoryRepository := mock_repo.NewMockCategoryRepository(mockCtrl)
+	mockArticleRevisionRepository := mock_repo.NewMockArticleRevisionRepository(mockCtrl)
+	mockTxManager := mock_transaction.NewMockTxManager(mockCtrl)
+	mockArticleValidator := mock_service.NewMockArticleValidator(mockCtrl)
+	mockContentRenderer := mock_render.NewMockContentRenderer(mockCtrl)
+	article, err := model.NewArticle("Title1", "Content1", uuid.New(), []string{"Tag1"}, false)
+	if err != nil {
+		panic(err)
+	}
+	revision1 := model.NewArticleRevision(article, 1)
+	article.Title = "Title1Changed"
+	article.Content = "Content1Changed"
+	revision2 := model.NewArticleRevision(article, 2)
+
+	// Expected & Mock
+	mockTxManager.EXPECT().RunInTx(ctx, gomock.Any()).DoAndReturn(runInTxWith(&transaction.Repositories{ArticleRepository: mockArticleRepository, CategoryRepository: mockCategoryRepository, ArticleRevisionRepository: mockArticleRevisionRepository}))
+	mockArticleRepository.EXPECT().FindOneById(ctx, article.Id).Return(article, nil)
+	mockArticleRevisionRepository.EXPECT().FindOne(ctx, article.Id, 1).Return(revision1, nil)
+	mockArticleRevisionRepository.EXPECT().FindLatest(ctx, article.Id).Return(revision2, nil)
+	mockArticleValidator.EXPECT().Validate(ctx, mockCategoryRepository, article).Return(nil)
+	mockArticleRepository.EXPECT().Update(ctx, article).Return(nil)
+	var inserted *model.ArticleRevision
+	mockArticleRevisionRepository.EXPECT().Insert(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, r *model.ArticleRevision) error {
+		inserted = r
+		return nil
+	})
+	mockContentRenderer.EXPECT().Invalidate(article.Id)
+
+	// Execute
+	u := NewArticleRevisionUseCase(mockArticleRepository, mockArticleRevisionRepository, mockTxManager, mockArticleValidator, mockContentRenderer, model.NewTagNameRules(nil, nil))
+	err = u.RestoreRevision(ctx, article.Id, 1)
+
+	// Check
+	if err != nil {
+		t.Fatalf("err of u.RestoreRevision: Expected %v, but got %v", nil, err)
+	}
+	if article.Title != "Title1" || article.Content != "Content1" {
+		t.Errorf("article: Expected %s %s, but got %s %s", "Title1", "Content1", article.Title, article.Content)
+	}
+	// 復元も新しい版として残す（履歴を巻き戻さない）
+	if inserted == nil || inserted.Number != 3 || inserted.Title != "Title1" {
+		t.Errorf("inserted: Expected number %d with title %s, but got %v", 3, "Title1", inserted)
+	}
+}
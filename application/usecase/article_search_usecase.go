@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/momonoki1990/tech-blog-api/domain/errs"
+	"github.com/momonoki1990/tech-blog-api/domain/repository"
+)
+
+const SearchQueryMaxLength = 100
+
+type ArticleSearchUseCase interface {
+	SearchArticles(ctx context.Context, query string, limit int) ([]*repository.ArticleHit, error)
+}
+
+type articleSearchUseCase struct {
+	repository.ArticleSearcher
+}
+
+func NewArticleSearchUseCase(s repository.ArticleSearcher) ArticleSearchUseCase {
+	return &articleSearchUseCase{s}
+}
+
+func (u *articleSearchUseCase) SearchArticles(ctx context.Context, query string, limit int) ([]*repository.ArticleHit, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, errs.NewValidation(errs.CodeValidationFailed, "q is required", errs.FieldError{Field: "q", Message: "q is required"})
+	}
+	if utf8.RuneCountInString(query) > SearchQueryMaxLength {
+		message := "q should be 100 characters or less"
+		return nil, errs.NewValidation(errs.CodeValidationFailed, message, errs.FieldError{Field: "q", Message: message})
+	}
+	return u.ArticleSearcher.Search(ctx, query, limit)
+}
@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/momonoki1990/tech-blog-api/domain/errs"
+	"github.com/momonoki1990/tech-blog-api/domain/repository"
+	mock_repo "github.com/momonoki1990/tech-blog-api/infra/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSearchArticles(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := context.TODO()
+
+	// Prepare
+	mockArticleSearcher := mock_repo.NewMockArticleSearcher(mockCtrl)
+
+	// Expected & Mock
+	hits := []*repository.ArticleHit{{Score: 1.5, TitleHighlight: "<mark>Go</mark>"}}
+	mockArticleSearcher.EXPECT().Search(ctx, "Go", 20).Return(hits, nil)
+
+	// Execute
+	u := NewArticleSearchUseCase(mockArticleSearcher)
+	actual, err := u.SearchArticles(ctx, " Go ", 20)
+	if err != nil {
+		panic(err)
+	}
+
+	// Check
+	if len(actual) != 1 || actual[0] != hits[0] {
+		t.Errorf("actual: Expected %v, but got %v", hits, actual)
+	}
+}
+
+func TestSearchArticlesValidationError(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := context.TODO()
+
+	// Prepare
+	mockArticleSearcher := mock_repo.NewMockArticleSearcher(mockCtrl)
+	u := NewArticleSearchUseCase(mockArticleSearcher)
+
+	for _, q := range []string{"", "　", strings.Repeat("あ", SearchQueryMaxLength+1)} {
+		// Execute
+		_, err := u.SearchArticles(ctx, q, 20)
+
+		// Check
+		if !errs.IsKind(err, errs.Validation) {
+			t.Errorf("err: Expected validation error, but got %v", err)
+		}
+	}
+}
@@ -1,45 +1,165 @@
 package usecase
 
 import (
-	"errors"
+	"context"
+	"fmt"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/momonoki1990/tech-blog-api/application/auth"
+	"github.com/momonoki1990/tech-blog-api/application/render"
+	"github.com/momonoki1990/tech-blog-api/application/transaction"
+	"github.com/momonoki1990/tech-blog-api/domain/errs"
 	"github.com/momonoki1990/tech-blog-api/domain/model"
 	"github.com/momonoki1990/tech-blog-api/domain/repository"
+	"github.com/momonoki1990/tech-blog-api/domain/service"
 )
 
 type ArticleUseCase interface {
-    GetArticle(id uuid.UUID) (*model.Article, error)
-    GetArticleList() ([]*model.Article, error)
-    RegisterArticle(title string, content string, categoryId uuid.UUID, tagNames []string, shouldPublish bool) (string, error)
-	UpdateArticle(id uuid.UUID, title string, content string, categoryId uuid.UUID, tagNames []string, shouldPublish bool) (error)
-	DeleteArticle(id uuid.UUID) (error)
+    GetArticle(ctx context.Context, id uuid.UUID) (*model.Article, error)
+    GetArticleBySlug(ctx context.Context, slug string) (*model.Article, error)
+    RenderArticleContent(ctx context.Context, a *model.Article) (string, error)
+    GetArticleList(ctx context.Context, criteria *repository.ArticleCriteria) ([]*model.Article, *repository.ArticleCursor, error)
+	// authorSlugsが空なら送り手の著者（まだいなければユーザー名で作る）を著者にする
+    RegisterArticle(ctx context.Context, title string, content string, categoryId uuid.UUID, tagNames []string, shouldPublish bool, slug string, publishAt *time.Time, authorSlugs []string) (string, error)
+	// versionは読み込んだ時点の記事のVersion（If-Match）。一致しなければPreconditionFailed
+	UpdateArticle(ctx context.Context, id uuid.UUID, version int, title string, content string, categoryId uuid.UUID, tagNames []string, shouldPublish bool, slug string, publishAt *time.Time) (error)
+	// patchのnilでないフィールドだけを変更する
+	PatchArticle(ctx context.Context, id uuid.UUID, version int, patch *ArticlePatch) (*model.Article, error)
+	DeleteArticle(ctx context.Context, id uuid.UUID, version int) (error)
+}
+
+// 記事の部分更新の内容（nilのフィールドは変更しない）
+type ArticlePatch struct {
+	Title *string
+	Content *string
+	CategoryId *uuid.UUID
+	TagNames *[]string
+	ShouldPublish *bool
+	// 公開済み・予約中の記事（またはShouldPublishをtrueにする場合）のみ。未来の日時なら予約投稿になる
+	PublishedAt *time.Time
+	// 空文字の場合は変更しない
+	Slug *string
 }
 
 type articleUseCase struct {
     repository.ArticleRepository
+	transaction.TxManager
+	service.ArticleCreator
+	service.ArticleValidator
+	service.ArticleSlugAssigner
+	render.ContentRenderer
+	tagNameRules *model.TagNameRules
 }
 
-func NewArticleUseCase(r repository.ArticleRepository) ArticleUseCase {
-    return &articleUseCase{r}
+func NewArticleUseCase(r repository.ArticleRepository, tm transaction.TxManager, ac service.ArticleCreator, av service.ArticleValidator, sa service.ArticleSlugAssigner, cr render.ContentRenderer, rules *model.TagNameRules) ArticleUseCase {
+    return &articleUseCase{r, tm, ac, av, sa, cr, rules}
 }
 
-func (u *articleUseCase) GetArticle(id uuid.UUID) (*model.Article, error) {
-    article, err := u.ArticleRepository.FindOneById(id)
-	return article, err
+func (u *articleUseCase) GetArticle(ctx context.Context, id uuid.UUID) (*model.Article, error) {
+    article, err := u.ArticleRepository.FindOneById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if article == nil || !canRead(ctx, article) {
+		return nil, errs.NewNotFound(errs.CodeArticleNotFound, "Article was not found")
+	}
+	return article, nil
 }
 
-func (u *articleUseCase) GetArticleList() ([]*model.Article, error) {
-    articles, err := u.ArticleRepository.Find()
-	return articles, err
+// 変更前のスラッグでも取得できる
+func (u *articleUseCase) GetArticleBySlug(ctx context.Context, slug string) (*model.Article, error) {
+    article, err := u.ArticleRepository.FindOneBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	if article == nil || !canRead(ctx, article) {
+		return nil, errs.NewNotFound(errs.CodeArticleNotFound, "Article was not found")
+	}
+	return article, nil
 }
 
-func (u *articleUseCase) RegisterArticle(title string, content string, categoryId uuid.UUID, tagNames []string, shouldPublish bool) (string, error) {
-	article, err := model.NewArticle(title, content, categoryId, tagNames, shouldPublish)
-	if err != nil {
+// 下書き・予約中の記事は権限のあるユーザーにだけ見せる（存在することも知らせない）
+func canRead(ctx context.Context, a *model.Article) bool {
+	return a.Status == model.Published || auth.Can(ctx, model.PermArticlesReadDrafts)
+}
+
+// 自分の記事はarticles:write、他のユーザーの記事（作成者が不明な記事を含む）はarticles:edit_anyが必要
+func authorizeArticleEdit(ctx context.Context, a *model.Article) (error) {
+	if err := auth.Require(ctx, model.PermArticlesWrite); err != nil {
+		return err
+	}
+	if a.CreatedBy != nil && *a.CreatedBy == auth.PrincipalFrom(ctx).UserId {
+		return nil
+	}
+	return auth.Require(ctx, model.PermArticlesEditAny)
+}
+
+// 変更前・変更後のどちらかが下書きでなければarticles:publishが必要
+// （公開状態を変える場合と、公開済み・予約中の記事をレビューなしで書き換える場合）
+func authorizeArticlePublish(ctx context.Context, statuses ...model.Status) (error) {
+	for _, v := range statuses {
+		if v != model.Draft {
+			return auth.Require(ctx, model.PermArticlesPublish)
+		}
+	}
+	return nil
+}
+
+func (u *articleUseCase) RenderArticleContent(ctx context.Context, a *model.Article) (string, error) {
+	return u.ContentRenderer.Render(ctx, a)
+}
+
+func (u *articleUseCase) GetArticleList(ctx context.Context, criteria *repository.ArticleCriteria) ([]*model.Article, *repository.ArticleCursor, error) {
+	// 下書きを読む権限がなければ公開済みの記事だけ
+	if !auth.Can(ctx, model.PermArticlesReadDrafts) {
+		published := model.Published
+		if criteria.Status != nil && *criteria.Status != published {
+			return nil, nil, auth.Require(ctx, model.PermArticlesReadDrafts)
+		}
+		criteria.Status = &published
+	}
+    articles, next, err := u.ArticleRepository.Find(ctx, criteria)
+	return articles, next, err
+}
+
+func (u *articleUseCase) RegisterArticle(ctx context.Context, title string, content string, categoryId uuid.UUID, tagNames []string, shouldPublish bool, slug string, publishAt *time.Time, authorSlugs []string) (string, error) {
+	if err := auth.Require(ctx, model.PermArticlesWrite); err != nil {
 		return "", err
 	}
-	err = u.ArticleRepository.Insert(article)
+	if shouldPublish {
+		if err := auth.Require(ctx, model.PermArticlesPublish); err != nil {
+			return "", err
+		}
+	}
+	var article *model.Article
+	// デッドロックでやり直す場合も、前回の連番や著者を持ち越さないよう記事から作り直す
+	err := u.TxManager.RunInTx(ctx, func(r *transaction.Repositories) error {
+		var err error
+		article, err = u.ArticleCreator.Create(ctx, r.CategoryRepository, title, content, categoryId, tagNames, shouldPublish)
+		if err != nil {
+			return err
+		}
+		createdBy := auth.PrincipalFrom(ctx).UserId
+		article.CreatedBy = &createdBy
+		// 公開日時が未来なら予約投稿にする
+		if shouldPublish && publishAt != nil {
+			article.PublishAt(*publishAt, time.Now())
+		}
+		// slugが空ならタイトルから生成したものを使う
+		if err := u.ArticleSlugAssigner.Assign(ctx, r.ArticleRepository, article, slug); err != nil {
+			return err
+		}
+		authors, err := resolveArticleAuthors(ctx, r.AuthorRepository, authorSlugs)
+		if err != nil {
+			return err
+		}
+		article.Authors = authors
+		if err := r.ArticleRepository.Insert(ctx, article); err != nil {
+			return err
+		}
+		return r.ArticleRevisionRepository.Insert(ctx, model.NewArticleRevision(article, 1))
+	})
 	if err != nil {
 		return "", err
 	}
@@ -47,32 +167,234 @@ func (u *articleUseCase) RegisterArticle(title string, content string, categoryI
 	return articleId, nil
 }
 
-func (u *articleUseCase) UpdateArticle(id uuid.UUID, title string, content string, categoryId uuid.UUID, tagNames []string, shouldPublish bool) (error) {
-	article, err := u.ArticleRepository.FindOneById(id)
+// slugsの著者をその順に返す。存在しない著者はValidation
+func resolveArticleAuthors(ctx context.Context, r repository.AuthorRepository, slugs []string) ([]model.AuthorSummary, error) {
+	if len(slugs) == 0 {
+		author, err := principalAuthor(ctx, r)
+		if err != nil {
+			return nil, err
+		}
+		return []model.AuthorSummary{author.Summary()}, nil
+	}
+	found, err := r.FindBySlugs(ctx, slugs)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	if article == nil {
-		return errors.New("Article to update was not found")
+	bySlug := make(map[string]*model.Author, len(found))
+	for _, v := range found {
+		bySlug[v.Slug] = v
 	}
+	var authors []model.AuthorSummary
+	var fields []errs.FieldError
+	added := map[string]bool{}
+	for _, v := range slugs {
+		author, ok := bySlug[v]
+		if !ok {
+			fields = append(fields, errs.FieldError{Field: "authorSlugs", Message: fmt.Sprintf("author %q does not exist", v)})
+			continue
+		}
+		if !added[v] {
+			added[v] = true
+			authors = append(authors, author.Summary())
+		}
+	}
+	if err := errs.NewValidationFromFields(errs.CodeValidationFailed, fields); err != nil {
+		return nil, err
+	}
+	return authors, nil
+}
 
-	article.Title = title
-	article.Content = content
-	article.CategoryId = categoryId
-	article.SetTags(tagNames)
-	if shouldPublish {
-		article.SetStatus(model.Published)
-	} else {
-		article.SetStatus(model.Draft)
+// 送り手のユーザーの著者。まだいなければユーザー名から作る（スラッグが重なれば連番を付ける）
+func principalAuthor(ctx context.Context, r repository.AuthorRepository) (*model.Author, error) {
+	principal := auth.PrincipalFrom(ctx)
+	author, err := r.FindOneByUserId(ctx, principal.UserId)
+	if err != nil || author != nil {
+		return author, err
+	}
+	author, err = model.NewAuthor(&principal.UserId, principal.Name, "", "", "")
+	if err != nil {
+		return nil, err
+	}
+	base := author.Slug
+	for n := 2; ; n++ {
+		found, err := r.FindOneBySlug(ctx, author.Slug)
+		if err != nil {
+			return nil, err
+		}
+		if found == nil {
+			break
+		}
+		author.Slug = model.SlugWithSuffix(base, n)
 	}
-	err = u.ArticleRepository.Update(article)
+	if err := r.Insert(ctx, author); err != nil {
+		return nil, err
+	}
+	return author, nil
+}
+
+func (u *articleUseCase) UpdateArticle(ctx context.Context, id uuid.UUID, version int, title string, content string, categoryId uuid.UUID, tagNames []string, shouldPublish bool, slug string, publishAt *time.Time) (error) {
+	_, err := u.modifyArticle(ctx, id, version, func(r *transaction.Repositories, article *model.Article) error {
+		article.Title = title
+		article.Content = content
+		article.CategoryId = categoryId
+		article.SetTags(u.tagNameRules.CanonicalNames(tagNames))
+		status := model.Draft
+		if shouldPublish {
+			status = model.Published
+		}
+		if err := article.SetStatus(status); err != nil {
+			return err
+		}
+		if shouldPublish && publishAt != nil {
+			article.PublishAt(*publishAt, time.Now())
+		}
+		// slugが空なら変更しない（タイトルを変えてもURLは変わらない）
+		if slug != "" && slug != article.Slug {
+			if err := u.ArticleSlugAssigner.Assign(ctx, r.ArticleRepository, article, slug); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 	return err
 }
 
-func (u *articleUseCase) DeleteArticle(id uuid.UUID) (error) {
-	err := u.ArticleRepository.Delete(id)
+func (u *articleUseCase) PatchArticle(ctx context.Context, id uuid.UUID, version int, patch *ArticlePatch) (*model.Article, error) {
+	return u.modifyArticle(ctx, id, version, func(r *transaction.Repositories, article *model.Article) error {
+		if patch.Title != nil {
+			article.Title = *patch.Title
+		}
+		if patch.Content != nil {
+			article.Content = *patch.Content
+		}
+		if patch.CategoryId != nil {
+			article.CategoryId = *patch.CategoryId
+		}
+		if patch.TagNames != nil {
+			article.SetTags(u.tagNameRules.CanonicalNames(*patch.TagNames))
+		}
+		if patch.ShouldPublish != nil {
+			status := model.Draft
+			if *patch.ShouldPublish {
+				status = model.Published
+			}
+			if err := article.SetStatus(status); err != nil {
+				return err
+			}
+		}
+		if patch.PublishedAt != nil {
+			// 下書きに公開日時だけを設定することはできない
+			if article.Status == model.Draft {
+				message := "publishedAt can be set only to a published or scheduled article"
+				return errs.NewValidation(errs.CodeValidationFailed, message, errs.FieldError{Field: "publishedAt", Message: message})
+			}
+			article.PublishAt(*patch.PublishedAt, time.Now())
+		}
+		if patch.Slug != nil && *patch.Slug != "" && *patch.Slug != article.Slug {
+			if err := u.ArticleSlugAssigner.Assign(ctx, r.ArticleRepository, article, *patch.Slug); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// 読み込んだ記事にapplyで変更を加え、不変条件を検証して保存する（版も残す）
+func (u *articleUseCase) modifyArticle(ctx context.Context, id uuid.UUID, version int, apply func(r *transaction.Repositories, article *model.Article) error) (*model.Article, error) {
+	var article *model.Article
+	contentChanged := false
+	err := u.TxManager.RunInTx(ctx, func(r *transaction.Repositories) error {
+		var err error
+		article, err = r.ArticleRepository.FindOneById(ctx, id)
+		if err != nil {
+			return err
+		}
+		if article == nil {
+			return errs.NewNotFound(errs.CodeArticleNotFound, "Article to update was not found")
+		}
+		if article.Version != version {
+			return errs.NewPreconditionFailed(errs.CodeArticleVersionMismatch, "Article has been modified by another request")
+		}
+		if err := authorizeArticleEdit(ctx, article); err != nil {
+			return err
+		}
+		latest, err := snapshotRevision(ctx, r, article)
+		if err != nil {
+			return err
+		}
+
+		previousContent := article.Content
+		previousStatus := article.Status
+		if err := apply(r, article); err != nil {
+			return err
+		}
+		if err := authorizeArticlePublish(ctx, previousStatus, article.Status); err != nil {
+			return err
+		}
+		contentChanged = article.Content != previousContent
+		if err := u.ArticleValidator.Validate(ctx, r.CategoryRepository, article); err != nil {
+			return err
+		}
+		if err := r.ArticleRepository.Update(ctx, article); err != nil {
+			return err
+		}
+		return recordRevision(ctx, r, article, latest)
+	})
+	if err != nil {
+		return nil, err
+	}
+	// コミット後に捨てる（ロールバックされた場合は元の本文のまま）
+	if contentChanged {
+		u.ContentRenderer.Invalidate(id)
+	}
+	return article, nil
+}
+
+func (u *articleUseCase) DeleteArticle(ctx context.Context, id uuid.UUID, version int) (error) {
+	err := u.TxManager.RunInTx(ctx, func(r *transaction.Repositories) error {
+		article, err := r.ArticleRepository.FindOneById(ctx, id)
+		if err != nil {
+			return err
+		}
+		if article == nil {
+			return errs.NewNotFound(errs.CodeArticleNotFound, "Article to delete was not found")
+		}
+		if err := authorizeArticleEdit(ctx, article); err != nil {
+			return err
+		}
+		// 公開中の記事を取り下げるのは公開状態の変更になる
+		if err := authorizeArticlePublish(ctx, article.Status); err != nil {
+			return err
+		}
+		return r.ArticleRepository.Delete(ctx, id, version)
+	})
 	if err != nil {
 		return err
 	}
+	u.ContentRenderer.Invalidate(id)
 	return nil
-}
\ No newline at end of file
+}
+
+// 版がまだない記事（版管理を入れる前の記事）は、更新前の状態を1版目として残す
+func snapshotRevision(ctx context.Context, r *transaction.Repositories, a *model.Article) (*model.ArticleRevision, error) {
+	latest, err := r.ArticleRevisionRepository.FindLatest(ctx, a.Id)
+	if err != nil {
+		return nil, err
+	}
+	if latest != nil {
+		return latest, nil
+	}
+	latest = model.NewArticleRevision(a, 1)
+	if err := r.ArticleRevisionRepository.Insert(ctx, latest); err != nil {
+		return nil, err
+	}
+	return latest, nil
+}
+
+// 内容が変わっていなければ版を増やさない
+func recordRevision(ctx context.Context, r *transaction.Repositories, a *model.Article, latest *model.ArticleRevision) (error) {
+	if latest.Matches(a) {
+		return nil
+	}
+	return r.ArticleRevisionRepository.Insert(ctx, model.NewArticleRevision(a, latest.Number+1))
+}
@@ -1,20 +1,52 @@
 package usecase
 
 import (
+	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/momonoki1990/tech-blog-api/application/auth"
+	mock_render "github.com/momonoki1990/tech-blog-api/application/render/mock"
+	"github.com/momonoki1990/tech-blog-api/application/transaction"
+	mock_transaction "github.com/momonoki1990/tech-blog-api/application/transaction/mock"
+	"github.com/momonoki1990/tech-blog-api/domain/errs"
 	"github.com/momonoki1990/tech-blog-api/domain/model"
+	"github.com/momonoki1990/tech-blog-api/domain/repository"
+	mock_service "github.com/momonoki1990/tech-blog-api/domain/service/mock"
 	mock_repo "github.com/momonoki1990/tech-blog-api/infra/mock"
 	"go.uber.org/mock/gomock"
 )
 
+// adminとして認証したリクエストのcontext
+func withTestPrincipal(ctx context.Context) context.Context {
+	return withTestRole(ctx, uuid.New(), model.RoleAdmin)
+}
+
+func withTestRole(ctx context.Context, userId uuid.UUID, role model.Role) context.Context {
+	return auth.WithPrincipal(ctx, &auth.Principal{UserId: userId, Name: string(role), Role: role, TokenId: uuid.New().String()})
+}
+
+// モックのRunInTxで、渡された関数をreposで実行する
+func runInTxWith(repos *transaction.Repositories) func(ctx context.Context, fn func(r *transaction.Repositories) error) error {
+	return func(ctx context.Context, fn func(r *transaction.Repositories) error) error {
+		return fn(repos)
+	}
+}
+
 func TestGetArticle(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
+	ctx := withTestPrincipal(context.TODO())
 
 	// Prepare1
 	mockArticleRepository := mock_repo.NewMockArticleRepository(mockCtrl)
+	mockTxManager := mock_transaction.NewMockTxManager(mockCtrl)
+	mockArticleCreator := mock_service.NewMockArticleCreator(mockCtrl)
+	mockArticleValidator := mock_service.NewMockArticleValidator(mockCtrl)
+	mockArticleSlugAssigner := mock_service.NewMockArticleSlugAssigner(mockCtrl)
+	mockContentRenderer := mock_render.NewMockContentRenderer(mockCtrl)
 	
 	// Expected & Mock
 	categoryId1, err := uuid.Parse("11111111-1111-1111-1111-111111111111")
@@ -25,11 +57,11 @@ func TestGetArticle(t *testing.T) {
 	if err != nil {
 		panic(err)
 	}
-	mockArticleRepository.EXPECT().FindOneById(article.Id).Return(article, nil)
+	mockArticleRepository.EXPECT().FindOneById(ctx, article.Id).Return(article, nil)
 	
 	// Execute
-	u := NewArticleUseCase(mockArticleRepository)
-	actual, err := u.GetArticle(article.Id)
+	u := NewArticleUseCase(mockArticleRepository, mockTxManager, mockArticleCreator, mockArticleValidator, mockArticleSlugAssigner, mockContentRenderer, model.NewTagNameRules(nil, nil))
+	actual, err := u.GetArticle(ctx, article.Id)
 	if err != nil {
 		panic(err)
 	}
@@ -64,20 +96,60 @@ func TestGetArticle(t *testing.T) {
 	}
 }
 
+func TestGetArticleNotFoundError(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := withTestPrincipal(context.TODO())
+
+	// Prepare1
+	mockArticleRepository := mock_repo.NewMockArticleRepository(mockCtrl)
+	mockTxManager := mock_transaction.NewMockTxManager(mockCtrl)
+	mockArticleCreator := mock_service.NewMockArticleCreator(mockCtrl)
+	mockArticleValidator := mock_service.NewMockArticleValidator(mockCtrl)
+	mockArticleSlugAssigner := mock_service.NewMockArticleSlugAssigner(mockCtrl)
+	mockContentRenderer := mock_render.NewMockContentRenderer(mockCtrl)
+	articleId, err := uuid.Parse("11111111-1111-1111-1111-111111111111")
+	if err != nil {
+		panic(err)
+	}
+
+	// Expected & Mock
+	mockArticleRepository.EXPECT().FindOneById(ctx, articleId).Return(nil, nil)
+
+	// Execute
+	u := NewArticleUseCase(mockArticleRepository, mockTxManager, mockArticleCreator, mockArticleValidator, mockArticleSlugAssigner, mockContentRenderer, model.NewTagNameRules(nil, nil))
+	article, err := u.GetArticle(ctx, articleId)
+
+	// Check
+	if !errs.IsKind(err, errs.NotFound) {
+		t.Errorf("err of u.GetArticle(ctx, articleId): Expected %s, but got %v", errs.NotFound, err)
+	}
+	if article != nil {
+		t.Errorf("article: Expected %v, but got %v", nil, article)
+	}
+}
+
 func TestGetArticleList(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
+	ctx := withTestPrincipal(context.TODO())
 
 	// Prepare1
 	mockArticleRepository := mock_repo.NewMockArticleRepository(mockCtrl)
+	mockTxManager := mock_transaction.NewMockTxManager(mockCtrl)
+	mockArticleCreator := mock_service.NewMockArticleCreator(mockCtrl)
+	mockArticleValidator := mock_service.NewMockArticleValidator(mockCtrl)
+	mockArticleSlugAssigner := mock_service.NewMockArticleSlugAssigner(mockCtrl)
+	mockContentRenderer := mock_render.NewMockContentRenderer(mockCtrl)
 	
 	// Expected & Mock
 	articles := []*model.Article{}
-	mockArticleRepository.EXPECT().Find().Return(articles, nil)
+	criteria := &repository.ArticleCriteria{Limit: 20}
+	mockArticleRepository.EXPECT().Find(ctx, criteria).Return(articles, nil, nil)
 	
 	// Execute
-	u := NewArticleUseCase(mockArticleRepository)
-	actual, err := u.GetArticleList()
+	u := NewArticleUseCase(mockArticleRepository, mockTxManager, mockArticleCreator, mockArticleValidator, mockArticleSlugAssigner, mockContentRenderer, model.NewTagNameRules(nil, nil))
+	actual, next, err := u.GetArticleList(ctx, criteria)
 	if err != nil {
 		panic(err)
 	}
@@ -86,41 +158,367 @@ func TestGetArticleList(t *testing.T) {
 	if len(actual) != len(articles) {
 		t.Errorf("len(actual): Expected %d, but got %d", len(actual), len(articles))
 	}
+	if next != nil {
+		t.Errorf("next: Expected %v, but got %v", nil, next)
+	}
+}
+
+func TestGetArticleBySlug(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := withTestPrincipal(context.TODO())
+
+	// Prepare1
+	mockArticleRepository := mock_repo.NewMockArticleRepository(mockCtrl)
+	mockTxManager := mock_transaction.NewMockTxManager(mockCtrl)
+	mockArticleCreator := mock_service.NewMockArticleCreator(mockCtrl)
+	mockArticleValidator := mock_service.NewMockArticleValidator(mockCtrl)
+	mockArticleSlugAssigner := mock_service.NewMockArticleSlugAssigner(mockCtrl)
+	mockContentRenderer := mock_render.NewMockContentRenderer(mockCtrl)
+	article, err := model.NewArticle("Title1", "Content1", uuid.New(), []string{}, false)
+	if err != nil {
+		panic(err)
+	}
+
+	// Expected & Mock
+	mockArticleRepository.EXPECT().FindOneBySlug(ctx, "title1").Return(article, nil)
+	mockArticleRepository.EXPECT().FindOneBySlug(ctx, "not-existing").Return(nil, nil)
+
+	// Execute
+	u := NewArticleUseCase(mockArticleRepository, mockTxManager, mockArticleCreator, mockArticleValidator, mockArticleSlugAssigner, mockContentRenderer, model.NewTagNameRules(nil, nil))
+	actual, err := u.GetArticleBySlug(ctx, "title1")
+	if err != nil {
+		panic(err)
+	}
+	_, notFoundErr := u.GetArticleBySlug(ctx, "not-existing")
+
+	// Check
+	if actual.Id != article.Id {
+		t.Errorf("actual.Id: Expected %s, but got %s", article.Id, actual.Id)
+	}
+	if !errs.IsKind(notFoundErr, errs.NotFound) {
+		t.Errorf("err of u.GetArticleBySlug(ctx, 'not-existing'): Expected %s, but got %v", errs.NotFound, notFoundErr)
+	}
+}
+
+func TestGetArticleDraftWithoutPrincipalNotFoundError(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := context.TODO()
+
+	// Prepare
+	mockArticleRepository := mock_repo.NewMockArticleRepository(mockCtrl)
+	mockTxManager := mock_transaction.NewMockTxManager(mockCtrl)
+	mockArticleCreator := mock_service.NewMockArticleCreator(mockCtrl)
+	mockArticleValidator := mock_service.NewMockArticleValidator(mockCtrl)
+	mockArticleSlugAssigner := mock_service.NewMockArticleSlugAssigner(mockCtrl)
+	mockContentRenderer := mock_render.NewMockContentRenderer(mockCtrl)
+	draft, err := model.NewArticle("Title1", "Content1", uuid.New(), []string{}, false)
+	if err != nil {
+		panic(err)
+	}
+	published, err := model.NewArticle("Title2", "Content2", uuid.New(), []string{}, true)
+	if err != nil {
+		panic(err)
+	}
+
+	// Expected & Mock
+	mockArticleRepository.EXPECT().FindOneById(ctx, draft.Id).Return(draft, nil)
+	mockArticleRepository.EXPECT().FindOneBySlug(ctx, "title2").Return(published, nil)
+
+	// Execute
+	u := NewArticleUseCase(mockArticleRepository, mockTxManager, mockArticleCreator, mockArticleValidator, mockArticleSlugAssigner, mockContentRenderer, model.NewTagNameRules(nil, nil))
+	_, draftErr := u.GetArticle(ctx, draft.Id)
+	actual, err := u.GetArticleBySlug(ctx, "title2")
+
+	// Check
+	if !errs.IsKind(draftErr, errs.NotFound) {
+		t.Errorf("err of u.GetArticle(ctx, draft.Id): Expected %s, but got %v", errs.NotFound, draftErr)
+	}
+	if err != nil {
+		t.Errorf("err of u.GetArticleBySlug(ctx, 'title2'): Expected %v, but got %v", nil, err)
+	}
+	if actual == nil || actual.Id != published.Id {
+		t.Errorf("actual: Expected %v, but got %v", published, actual)
+	}
+}
+
+func TestGetArticleListWithoutPrincipal(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := context.TODO()
+
+	// Prepare
+	mockArticleRepository := mock_repo.NewMockArticleRepository(mockCtrl)
+	mockTxManager := mock_transaction.NewMockTxManager(mockCtrl)
+	mockArticleCreator := mock_service.NewMockArticleCreator(mockCtrl)
+	mockArticleValidator := mock_service.NewMockArticleValidator(mockCtrl)
+	mockArticleSlugAssigner := mock_service.NewMockArticleSlugAssigner(mockCtrl)
+	mockContentRenderer := mock_render.NewMockContentRenderer(mockCtrl)
+	draft := model.Draft
+
+	// Expected & Mock
+	mockArticleRepository.EXPECT().Find(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, c *repository.ArticleCriteria) ([]*model.Article, *repository.ArticleCursor, error) {
+		if c.Status == nil || *c.Status != model.Published {
+			t.Errorf("c.Status: Expected %v, but got %v", model.Published, c.Status)
+		}
+		return []*model.Article{}, nil, nil
+	})
+
+	// Execute
+	u := NewArticleUseCase(mockArticleRepository, mockTxManager, mockArticleCreator, mockArticleValidator, mockArticleSlugAssigner, mockContentRenderer, model.NewTagNameRules(nil, nil))
+	_, _, err := u.GetArticleList(ctx, &repository.ArticleCriteria{Limit: 20})
+	_, _, draftErr := u.GetArticleList(ctx, &repository.ArticleCriteria{Limit: 20, Status: &draft})
+
+	// Check
+	if err != nil {
+		t.Errorf("err of u.GetArticleList(ctx, criteria): Expected %v, but got %v", nil, err)
+	}
+	if !errs.IsKind(draftErr, errs.Unauthenticated) {
+		t.Errorf("err of u.GetArticleList(ctx, draft criteria): Expected %s, but got %v", errs.Unauthenticated, draftErr)
+	}
+}
+
+func TestUpdateArticleSlug(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := withTestPrincipal(context.TODO())
+
+	// Prepare1
+	mockArticleRepository := mock_repo.NewMockArticleRepository(mockCtrl)
+	mockTxManager := mock_transaction.NewMockTxManager(mockCtrl)
+	mockArticleCreator := mock_service.NewMockArticleCreator(mockCtrl)
+	mockArticleValidator := mock_service.NewMockArticleValidator(mockCtrl)
+	mockArticleSlugAssigner := mock_service.NewMockArticleSlugAssigner(mockCtrl)
+	mockContentRenderer := mock_render.NewMockContentRenderer(mockCtrl)
+	mockArticleRevisionRepository := mock_repo.NewMockArticleRevisionRepository(mockCtrl)
+	article, err := model.NewArticle("Title1", "Content1", uuid.New(), []string{}, false)
+	if err != nil {
+		panic(err)
+	}
+
+	// Expected & Mock
+	mockTxManager.EXPECT().RunInTx(ctx, gomock.Any()).DoAndReturn(runInTxWith(&transaction.Repositories{ArticleRepository: mockArticleRepository, ArticleRevisionRepository: mockArticleRevisionRepository}))
+	mockArticleRepository.EXPECT().FindOneById(ctx, article.Id).Return(article, nil)
+	mockArticleRevisionRepository.EXPECT().FindLatest(ctx, article.Id).Return(model.NewArticleRevision(article, 1), nil)
+	mockArticleSlugAssigner.EXPECT().Assign(ctx, mockArticleRepository, article, "taken").Return(errs.NewConflict(errs.CodeArticleSlugConflict, "Article slug is already in use"))
+
+	// Execute
+	u := NewArticleUseCase(mockArticleRepository, mockTxManager, mockArticleCreator, mockArticleValidator, mockArticleSlugAssigner, mockContentRenderer, model.NewTagNameRules(nil, nil))
+	err = u.UpdateArticle(ctx, article.Id, 1, "Title1", "Content1", article.CategoryId, []string{}, false, "taken", nil)
+
+	// Check
+	if !errs.IsKind(err, errs.Conflict) {
+		t.Errorf("err of u.UpdateArticle: Expected %s, but got %v", errs.Conflict, err)
+	}
 }
 
 func TestRegisterArticle(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
+	userId := uuid.New()
+	ctx := withTestRole(context.TODO(), userId, model.RoleWriter)
 
 	// Prepare1
 	mockArticleRepository := mock_repo.NewMockArticleRepository(mockCtrl)
+	mockCategoryRepository := mock_repo.NewMockCategoryRepository(mockCtrl)
+	mockTxArticleRepository := mock_repo.NewMockArticleRepository(mockCtrl)
+	mockTxManager := mock_transaction.NewMockTxManager(mockCtrl)
+	mockArticleCreator := mock_service.NewMockArticleCreator(mockCtrl)
+	mockArticleValidator := mock_service.NewMockArticleValidator(mockCtrl)
+	mockArticleSlugAssigner := mock_service.NewMockArticleSlugAssigner(mockCtrl)
+	mockContentRenderer := mock_render.NewMockContentRenderer(mockCtrl)
+	mockArticleRevisionRepository := mock_repo.NewMockArticleRevisionRepository(mockCtrl)
+	mockAuthorRepository := mock_repo.NewMockAuthorRepository(mockCtrl)
 	categoryId, err := uuid.Parse("11111111-1111-1111-1111-111111111111")
 	if err != nil {
 		panic(err)
 	}
 
+	article, err := model.NewArticle("Title1", "Content1", categoryId, []string{"Tag1", "Tag2"}, false)
+	if err != nil {
+		panic(err)
+	}
+
 	// Expected & Mock
-	mockArticleRepository.EXPECT().Insert(gomock.Any()).Return(nil)
+	mockArticleCreator.EXPECT().Create(ctx, mockCategoryRepository, "Title1", "Content1", categoryId, []string{"Tag1", "Tag2"}, false).Return(article, nil)
+	// スラッグの重複はトランザクションのリポジトリで確かめる
+	mockTxManager.EXPECT().RunInTx(ctx, gomock.Any()).DoAndReturn(runInTxWith(&transaction.Repositories{ArticleRepository: mockTxArticleRepository, CategoryRepository: mockCategoryRepository, ArticleRevisionRepository: mockArticleRevisionRepository, AuthorRepository: mockAuthorRepository}))
+	mockArticleSlugAssigner.EXPECT().Assign(ctx, mockTxArticleRepository, article, "").Return(nil)
+	// 著者を指定しなければ、送り手の著者をユーザー名から作る
+	var author *model.Author
+	mockAuthorRepository.EXPECT().FindOneByUserId(ctx, userId).Return(nil, nil)
+	mockAuthorRepository.EXPECT().FindOneBySlug(ctx, "writer").Return(nil, nil)
+	mockAuthorRepository.EXPECT().Insert(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, a *model.Author) error {
+		author = a
+		return nil
+	})
+	mockTxArticleRepository.EXPECT().Insert(ctx, article).Return(nil)
+	mockArticleRevisionRepository.EXPECT().Insert(ctx, gomock.Any()).Return(nil)
 
 	// Execute
-	u := NewArticleUseCase(mockArticleRepository)
-	id, err := u.RegisterArticle("Title1", "Content1", categoryId, []string{"Tag1", "Tag2"}, false)
+	u := NewArticleUseCase(mockArticleRepository, mockTxManager, mockArticleCreator, mockArticleValidator, mockArticleSlugAssigner, mockContentRenderer, model.NewTagNameRules(nil, nil))
+	id, err := u.RegisterArticle(ctx, "Title1", "Content1", categoryId, []string{"Tag1", "Tag2"}, false, "", nil, nil)
 
 	// Check
 	if err != nil {
-		t.Errorf("err of u.RegisterArticle('Title1', 'Content1', categoryId, []string{'Tag1', 'Tag2'}, false): Expected %v, but got %v", nil, err)
+		t.Errorf("err of u.RegisterArticle(ctx, 'Title1', 'Content1', categoryId, []string{'Tag1', 'Tag2'}, false): Expected %v, but got %v", nil, err)
 	}
 	if id == "" {
-		t.Errorf("id of u.RegisterArticle('Title1', 'Content1', categoryId, []string{'Tag1', 'Tag2'}, false): Expected %s, but got %v", "not empty string", id)
+		t.Errorf("id of u.RegisterArticle(ctx, 'Title1', 'Content1', categoryId, []string{'Tag1', 'Tag2'}, false): Expected %s, but got %v", "not empty string", id)
+	}
+	// 作成者を記録し、後で自分の記事として編集できるようにする
+	if article.CreatedBy == nil || *article.CreatedBy != userId {
+		t.Errorf("article.CreatedBy: Expected %v, but got %v", userId, article.CreatedBy)
+	}
+	if author == nil || author.UserId == nil || *author.UserId != userId || author.Slug != "writer" {
+		t.Fatalf("author: Expected author of %v with slug writer, but got %v", userId, author)
+	}
+	if len(article.Authors) != 1 || article.Authors[0].Id != author.Id {
+		t.Errorf("article.Authors: Expected %v, but got %v", []model.AuthorSummary{author.Summary()}, article.Authors)
+	}
+}
+
+func TestRegisterArticleScheduled(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := withTestPrincipal(context.TODO())
+
+	// Prepare1
+	mockArticleRepository := mock_repo.NewMockArticleRepository(mockCtrl)
+	mockCategoryRepository := mock_repo.NewMockCategoryRepository(mockCtrl)
+	mockTxManager := mock_transaction.NewMockTxManager(mockCtrl)
+	mockArticleCreator := mock_service.NewMockArticleCreator(mockCtrl)
+	mockArticleValidator := mock_service.NewMockArticleValidator(mockCtrl)
+	mockArticleSlugAssigner := mock_service.NewMockArticleSlugAssigner(mockCtrl)
+	mockContentRenderer := mock_render.NewMockContentRenderer(mockCtrl)
+	mockArticleRevisionRepository := mock_repo.NewMockArticleRevisionRepository(mockCtrl)
+	mockAuthorRepository := mock_repo.NewMockAuthorRepository(mockCtrl)
+	alice, err := model.NewAuthor(nil, "Alice", "alice", "", "")
+	if err != nil {
+		panic(err)
+	}
+	categoryId := uuid.New()
+	article, err := model.NewArticle("Title1", "Content1", categoryId, []string{}, true)
+	if err != nil {
+		panic(err)
+	}
+	publishAt := time.Now().Add(time.Hour)
+
+	// Expected & Mock
+	mockArticleCreator.EXPECT().Create(ctx, mockCategoryRepository, "Title1", "Content1", categoryId, []string{}, true).Return(article, nil)
+	mockArticleSlugAssigner.EXPECT().Assign(ctx, mockArticleRepository, article, "").Return(nil)
+	mockTxManager.EXPECT().RunInTx(ctx, gomock.Any()).DoAndReturn(runInTxWith(&transaction.Repositories{ArticleRepository: mockArticleRepository, CategoryRepository: mockCategoryRepository, ArticleRevisionRepository: mockArticleRevisionRepository, AuthorRepository: mockAuthorRepository}))
+	mockAuthorRepository.EXPECT().FindBySlugs(ctx, []string{"alice"}).Return([]*model.Author{alice}, nil)
+	mockArticleRepository.EXPECT().Insert(ctx, article).Return(nil)
+	mockArticleRevisionRepository.EXPECT().Insert(ctx, gomock.Any()).Return(nil)
+
+	// Execute
+	u := NewArticleUseCase(mockArticleRepository, mockTxManager, mockArticleCreator, mockArticleValidator, mockArticleSlugAssigner, mockContentRenderer, model.NewTagNameRules(nil, nil))
+	_, err = u.RegisterArticle(ctx, "Title1", "Content1", categoryId, []string{}, true, "", &publishAt, []string{"alice"})
+
+	// Check
+	if err != nil {
+		t.Errorf("err of u.RegisterArticle: Expected %v, but got %v", nil, err)
+	}
+	if article.Status != model.Scheduled {
+		t.Errorf("article.Status: Expected %s, but got %s", model.Scheduled, article.Status)
+	}
+	if len(article.Authors) != 1 || article.Authors[0].Slug != "alice" {
+		t.Errorf("article.Authors: Expected %v, but got %v", []model.AuthorSummary{alice.Summary()}, article.Authors)
+	}
+	if !article.PublishedAt.Equal(publishAt) {
+		t.Errorf("article.PublishedAt: Expected %s, but got %s", publishAt, article.PublishedAt)
+	}
+}
+
+func TestRegisterArticleRetried(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := withTestPrincipal(context.TODO())
+
+	// Prepare
+	mockArticleRepository := mock_repo.NewMockArticleRepository(mockCtrl)
+	mockCategoryRepository := mock_repo.NewMockCategoryRepository(mockCtrl)
+	mockTxManager := mock_transaction.NewMockTxManager(mockCtrl)
+	mockArticleCreator := mock_service.NewMockArticleCreator(mockCtrl)
+	mockArticleValidator := mock_service.NewMockArticleValidator(mockCtrl)
+	mockArticleSlugAssigner := mock_service.NewMockArticleSlugAssigner(mockCtrl)
+	mockContentRenderer := mock_render.NewMockContentRenderer(mockCtrl)
+	mockArticleRevisionRepository := mock_repo.NewMockArticleRevisionRepository(mockCtrl)
+	mockAuthorRepository := mock_repo.NewMockAuthorRepository(mockCtrl)
+	alice, err := model.NewAuthor(nil, "Alice", "alice", "", "")
+	if err != nil {
+		panic(err)
+	}
+	categoryId := uuid.New()
+	repos := &transaction.Repositories{ArticleRepository: mockArticleRepository, CategoryRepository: mockCategoryRepository, ArticleRevisionRepository: mockArticleRevisionRepository, AuthorRepository: mockAuthorRepository}
+	deadlock := errors.New("deadlock")
+
+	// Expected & Mock: 1回目はデッドロックで失敗し、関数ごとやり直す
+	mockArticleCreator.EXPECT().Create(ctx, mockCategoryRepository, "Title1", "Content1", categoryId, []string{}, false).DoAndReturn(func(_ context.Context, _ repository.CategoryRepository, title string, content string, categoryId uuid.UUID, tagNames []string, shouldPublish bool) (*model.Article, error) {
+		return model.NewArticle(title, content, categoryId, tagNames, shouldPublish)
+	}).Times(2)
+	mockTxManager.EXPECT().RunInTx(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(r *transaction.Repositories) error) error {
+		if err := fn(repos); err != deadlock {
+			return err
+		}
+		return fn(repos)
+	})
+	// 各回の開始時のスラッグ（既存の記事と重なって連番を付ける）
+	var assignedFrom []string
+	mockArticleSlugAssigner.EXPECT().Assign(ctx, mockArticleRepository, gomock.Any(), "").DoAndReturn(func(_ context.Context, _ repository.ArticleRepository, a *model.Article, _ string) error {
+		assignedFrom = append(assignedFrom, a.Slug)
+		a.Slug = model.SlugWithSuffix(a.Slug, 2)
+		return nil
+	}).Times(2)
+	mockAuthorRepository.EXPECT().FindBySlugs(ctx, []string{"alice"}).Return([]*model.Author{alice}, nil).Times(2)
+	var inserted *model.Article
+	gomock.InOrder(
+		mockArticleRepository.EXPECT().Insert(ctx, gomock.Any()).Return(deadlock),
+		mockArticleRepository.EXPECT().Insert(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, a *model.Article) error {
+			inserted = a
+			return nil
+		}),
+	)
+	mockArticleRevisionRepository.EXPECT().Insert(ctx, gomock.Any()).Return(nil)
+
+	// Execute
+	u := NewArticleUseCase(mockArticleRepository, mockTxManager, mockArticleCreator, mockArticleValidator, mockArticleSlugAssigner, mockContentRenderer, model.NewTagNameRules(nil, nil))
+	id, err := u.RegisterArticle(ctx, "Title1", "Content1", categoryId, []string{}, false, "", nil, []string{"alice"})
+
+	// Check
+	if err != nil {
+		t.Fatalf("err of u.RegisterArticle: Expected %v, but got %v", nil, err)
+	}
+	if len(assignedFrom) != 2 || assignedFrom[0] != "title1" || assignedFrom[1] != "title1" {
+		t.Errorf("assignedFrom: Expected %v, but got %v", []string{"title1", "title1"}, assignedFrom)
+	}
+	if inserted == nil || inserted.Id.String() != id {
+		t.Fatalf("inserted: Expected article %s, but got %v", id, inserted)
+	}
+	if inserted.Slug != "title1-2" {
+		t.Errorf("inserted.Slug: Expected %s, but got %s", "title1-2", inserted.Slug)
+	}
+	if len(inserted.Authors) != 1 || inserted.Authors[0].Slug != "alice" {
+		t.Errorf("inserted.Authors: Expected %v, but got %v", []model.AuthorSummary{alice.Summary()}, inserted.Authors)
 	}
 }
 
 func TestUpdateArticle(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
+	ctx := withTestPrincipal(context.TODO())
 
 	// Prepare1
 	mockArticleRepository := mock_repo.NewMockArticleRepository(mockCtrl)
+	mockCategoryRepository := mock_repo.NewMockCategoryRepository(mockCtrl)
+	mockTxManager := mock_transaction.NewMockTxManager(mockCtrl)
+	mockArticleCreator := mock_service.NewMockArticleCreator(mockCtrl)
+	mockArticleValidator := mock_service.NewMockArticleValidator(mockCtrl)
+	mockArticleSlugAssigner := mock_service.NewMockArticleSlugAssigner(mockCtrl)
+	mockContentRenderer := mock_render.NewMockContentRenderer(mockCtrl)
+	mockArticleRevisionRepository := mock_repo.NewMockArticleRevisionRepository(mockCtrl)
 	categoryId1, err := uuid.Parse("11111111-1111-1111-1111-111111111111")
 	if err != nil {
 		panic(err)
@@ -136,25 +534,126 @@ func TestUpdateArticle(t *testing.T) {
 	articleId := article.Id
 
 	// Expected & Mock
-	mockArticleRepository.EXPECT().FindOneById(articleId).Return(article, nil)
-	mockArticleRepository.EXPECT().Update(article).Return(nil)
+	mockTxManager.EXPECT().RunInTx(ctx, gomock.Any()).DoAndReturn(runInTxWith(&transaction.Repositories{ArticleRepository: mockArticleRepository, CategoryRepository: mockCategoryRepository, ArticleRevisionRepository: mockArticleRevisionRepository}))
+	mockArticleRepository.EXPECT().FindOneById(ctx, articleId).Return(article, nil)
+	// 版がまだないので、更新前を1版目・更新後を2版目として残す
+	mockArticleRevisionRepository.EXPECT().FindLatest(ctx, articleId).Return(nil, nil)
+	var revisions []*model.ArticleRevision
+	recordInsert := func(ctx context.Context, r *model.ArticleRevision) error {
+		revisions = append(revisions, r)
+		return nil
+	}
+	mockArticleRevisionRepository.EXPECT().Insert(ctx, gomock.Any()).DoAndReturn(recordInsert).Times(2)
+	mockArticleValidator.EXPECT().Validate(ctx, mockCategoryRepository, article).Return(nil)
+	mockArticleRepository.EXPECT().Update(ctx, article).Return(nil)
+	// 本文が変わったので変換結果のキャッシュを捨てる
+	mockContentRenderer.EXPECT().Invalidate(articleId)
 
 	// Execute
-	u := NewArticleUseCase(mockArticleRepository)
-	err = u.UpdateArticle(articleId, "Title1Changed", "Content1Changed", categoryId2, []string{"Tag3", "Tag4"}, true)
+	u := NewArticleUseCase(mockArticleRepository, mockTxManager, mockArticleCreator, mockArticleValidator, mockArticleSlugAssigner, mockContentRenderer, model.NewTagNameRules(nil, nil))
+	err = u.UpdateArticle(ctx, articleId, 1, "Title1Changed", "Content1Changed", categoryId2, []string{"Tag3", "Tag4"}, true, "", nil)
 
 	// Check
 	if err != nil {
-		t.Errorf("err of u.UpdateArticle(articleId, 'Title1Changed', 'Content1Changed', categoryId2, []string{'Tag3', 'Tag4'}, true): Expected %v, but got %v", nil, err)
+		t.Errorf("err of u.UpdateArticle(ctx, articleId, 'Title1Changed', 'Content1Changed', categoryId2, []string{'Tag3', 'Tag4'}, true): Expected %v, but got %v", nil, err)
+	}
+	if len(revisions) != 2 {
+		t.Fatalf("len(revisions): Expected %d, but got %d", 2, len(revisions))
+	}
+	if revisions[0].Number != 1 || revisions[0].Title != "Title1" {
+		t.Errorf("revisions[0]: Expected %d %s, but got %d %s", 1, "Title1", revisions[0].Number, revisions[0].Title)
+	}
+	if revisions[1].Number != 2 || revisions[1].Title != "Title1Changed" {
+		t.Errorf("revisions[1]: Expected %d %s, but got %d %s", 2, "Title1Changed", revisions[1].Number, revisions[1].Title)
+	}
+}
+
+func TestUpdateArticleSameContent(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := withTestPrincipal(context.TODO())
+
+	// Prepare1
+	mockArticleRepository := mock_repo.NewMockArticleRepository(mockCtrl)
+	mockCategoryRepository := mock_repo.NewMockCategoryRepository(mockCtrl)
+	mockTxManager := mock_transaction.NewMockTxManager(mockCtrl)
+	mockArticleCreator := mock_service.NewMockArticleCreator(mockCtrl)
+	mockArticleValidator := mock_service.NewMockArticleValidator(mockCtrl)
+	mockArticleSlugAssigner := mock_service.NewMockArticleSlugAssigner(mockCtrl)
+	mockContentRenderer := mock_render.NewMockContentRenderer(mockCtrl)
+	mockArticleRevisionRepository := mock_repo.NewMockArticleRevisionRepository(mockCtrl)
+	article, err := model.NewArticle("Title1", "Content1", uuid.New(), []string{}, false)
+	if err != nil {
+		panic(err)
+	}
+
+	// Expected & Mock: 本文が同じならキャッシュは捨てない（Invalidateは呼ばれない）
+	mockTxManager.EXPECT().RunInTx(ctx, gomock.Any()).DoAndReturn(runInTxWith(&transaction.Repositories{ArticleRepository: mockArticleRepository, CategoryRepository: mockCategoryRepository, ArticleRevisionRepository: mockArticleRevisionRepository}))
+	mockArticleRepository.EXPECT().FindOneById(ctx, article.Id).Return(article, nil)
+	mockArticleRevisionRepository.EXPECT().FindLatest(ctx, article.Id).Return(model.NewArticleRevision(article, 1), nil)
+	mockArticleValidator.EXPECT().Validate(ctx, mockCategoryRepository, article).Return(nil)
+	mockArticleRepository.EXPECT().Update(ctx, article).Return(nil)
+	mockArticleRevisionRepository.EXPECT().Insert(ctx, gomock.Any()).Return(nil)
+
+	// Execute
+	u := NewArticleUseCase(mockArticleRepository, mockTxManager, mockArticleCreator, mockArticleValidator, mockArticleSlugAssigner, mockContentRenderer, model.NewTagNameRules(nil, nil))
+	err = u.UpdateArticle(ctx, article.Id, 1, "Title1Changed", "Content1", article.CategoryId, []string{}, false, "", nil)
+
+	// Check
+	if err != nil {
+		t.Errorf("err of u.UpdateArticle: Expected %v, but got %v", nil, err)
+	}
+}
+
+func TestUpdateArticleVersionMismatch(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := withTestPrincipal(context.TODO())
+
+	// Prepare1
+	mockArticleRepository := mock_repo.NewMockArticleRepository(mockCtrl)
+	mockTxManager := mock_transaction.NewMockTxManager(mockCtrl)
+	mockArticleCreator := mock_service.NewMockArticleCreator(mockCtrl)
+	mockArticleValidator := mock_service.NewMockArticleValidator(mockCtrl)
+	mockArticleSlugAssigner := mock_service.NewMockArticleSlugAssigner(mockCtrl)
+	mockContentRenderer := mock_render.NewMockContentRenderer(mockCtrl)
+	article, err := model.NewArticle("Title1", "Content1", uuid.New(), []string{}, false)
+	if err != nil {
+		panic(err)
+	}
+	// 読み込んだ後に他の編集者が更新した
+	article.Version = 2
+
+	// Expected & Mock: 更新しない
+	mockTxManager.EXPECT().RunInTx(ctx, gomock.Any()).DoAndReturn(runInTxWith(&transaction.Repositories{ArticleRepository: mockArticleRepository}))
+	mockArticleRepository.EXPECT().FindOneById(ctx, article.Id).Return(article, nil)
+
+	// Execute
+	u := NewArticleUseCase(mockArticleRepository, mockTxManager, mockArticleCreator, mockArticleValidator, mockArticleSlugAssigner, mockContentRenderer, model.NewTagNameRules(nil, nil))
+	err = u.UpdateArticle(ctx, article.Id, 1, "Title1Changed", "Content1", article.CategoryId, []string{}, false, "", nil)
+
+	// Check
+	e, ok := errs.As(err)
+	if !ok || e.Kind != errs.PreconditionFailed || e.Code != errs.CodeArticleVersionMismatch {
+		t.Errorf("err of u.UpdateArticle: Expected %s, but got %v", errs.CodeArticleVersionMismatch, err)
+	}
+	if article.Title != "Title1" {
+		t.Errorf("article.Title: Expected %s, but got %s", "Title1", article.Title)
 	}
 }
 
 func TestUpdateArticleNotFoundError(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
+	ctx := withTestPrincipal(context.TODO())
 
 	// Prepare1
 	mockArticleRepository := mock_repo.NewMockArticleRepository(mockCtrl)
+	mockTxManager := mock_transaction.NewMockTxManager(mockCtrl)
+	mockArticleCreator := mock_service.NewMockArticleCreator(mockCtrl)
+	mockArticleValidator := mock_service.NewMockArticleValidator(mockCtrl)
+	mockArticleSlugAssigner := mock_service.NewMockArticleSlugAssigner(mockCtrl)
+	mockContentRenderer := mock_render.NewMockContentRenderer(mockCtrl)
 	categoryId1, err := uuid.Parse("11111111-1111-1111-1111-111111111111")
 	if err != nil {
 		panic(err)
@@ -165,38 +664,315 @@ func TestUpdateArticleNotFoundError(t *testing.T) {
 	}
 
 	// Expected & Mock
-	mockArticleRepository.EXPECT().FindOneById(articleId).Return(nil, nil)
+	mockTxManager.EXPECT().RunInTx(ctx, gomock.Any()).DoAndReturn(runInTxWith(&transaction.Repositories{ArticleRepository: mockArticleRepository}))
+	mockArticleRepository.EXPECT().FindOneById(ctx, articleId).Return(nil, nil)
 
 	// Execute
-	u := NewArticleUseCase(mockArticleRepository)
-	err = u.UpdateArticle(articleId, "Title1Changed", "Content1Changed", categoryId1, []string{"Tag3", "Tag4"}, true)
+	u := NewArticleUseCase(mockArticleRepository, mockTxManager, mockArticleCreator, mockArticleValidator, mockArticleSlugAssigner, mockContentRenderer, model.NewTagNameRules(nil, nil))
+	err = u.UpdateArticle(ctx, articleId, 1, "Title1Changed", "Content1Changed", categoryId1, []string{"Tag3", "Tag4"}, true, "", nil)
 
 	// Check
+	if !errs.IsKind(err, errs.NotFound) {
+		t.Errorf("err of u.UpdateArticle: Expected %s, but got %v", errs.NotFound, err)
+	}
 	if err.Error() != "Article to update was not found" {
-		t.Errorf("err.Error() of u.UpdateArticle(articleId, 'Name1Changed', 101): Expected %s, but got %v", "Article to update was not found", err)
+		t.Errorf("err.Error() of u.UpdateArticle(ctx, articleId, 'Name1Changed', 101): Expected %s, but got %v", "Article to update was not found", err)
+	}
+}
+
+func TestUpdateArticleValidationError(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := withTestPrincipal(context.TODO())
+
+	// Prepare1
+	mockArticleRepository := mock_repo.NewMockArticleRepository(mockCtrl)
+	mockCategoryRepository := mock_repo.NewMockCategoryRepository(mockCtrl)
+	mockTxManager := mock_transaction.NewMockTxManager(mockCtrl)
+	mockArticleCreator := mock_service.NewMockArticleCreator(mockCtrl)
+	mockArticleValidator := mock_service.NewMockArticleValidator(mockCtrl)
+	mockArticleSlugAssigner := mock_service.NewMockArticleSlugAssigner(mockCtrl)
+	mockContentRenderer := mock_render.NewMockContentRenderer(mockCtrl)
+	mockArticleRevisionRepository := mock_repo.NewMockArticleRevisionRepository(mockCtrl)
+	categoryId1, err := uuid.Parse("11111111-1111-1111-1111-111111111111")
+	if err != nil {
+		panic(err)
+	}
+	article, err := model.NewArticle("Title1", "Content1", categoryId1, []string{"Tag1", "Tag2"}, false)
+	if err != nil {
+		panic(err)
+	}
+	articleId := article.Id
+	validationErr := errs.NewValidation(errs.CodeValidationFailed, "title is required", errs.FieldError{Field: "title", Message: "title is required"})
+
+	// Expected & Mock
+	mockTxManager.EXPECT().RunInTx(ctx, gomock.Any()).DoAndReturn(runInTxWith(&transaction.Repositories{ArticleRepository: mockArticleRepository, CategoryRepository: mockCategoryRepository, ArticleRevisionRepository: mockArticleRevisionRepository}))
+	mockArticleRepository.EXPECT().FindOneById(ctx, articleId).Return(article, nil)
+	mockArticleRevisionRepository.EXPECT().FindLatest(ctx, articleId).Return(model.NewArticleRevision(article, 1), nil)
+	mockArticleValidator.EXPECT().Validate(ctx, mockCategoryRepository, article).Return(validationErr)
+
+	// Execute
+	u := NewArticleUseCase(mockArticleRepository, mockTxManager, mockArticleCreator, mockArticleValidator, mockArticleSlugAssigner, mockContentRenderer, model.NewTagNameRules(nil, nil))
+	err = u.UpdateArticle(ctx, articleId, 1, "", "Content1Changed", categoryId1, []string{"Tag3"}, false, "", nil)
+
+	// Check
+	if err != validationErr {
+		t.Errorf("err of u.UpdateArticle: Expected %v, but got %v", validationErr, err)
+	}
+}
+
+func TestPatchArticle(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := withTestPrincipal(context.TODO())
+
+	// Prepare1
+	mockArticleRepository := mock_repo.NewMockArticleRepository(mockCtrl)
+	mockCategoryRepository := mock_repo.NewMockCategoryRepository(mockCtrl)
+	mockTxManager := mock_transaction.NewMockTxManager(mockCtrl)
+	mockArticleCreator := mock_service.NewMockArticleCreator(mockCtrl)
+	mockArticleValidator := mock_service.NewMockArticleValidator(mockCtrl)
+	mockArticleSlugAssigner := mock_service.NewMockArticleSlugAssigner(mockCtrl)
+	mockContentRenderer := mock_render.NewMockContentRenderer(mockCtrl)
+	mockArticleRevisionRepository := mock_repo.NewMockArticleRevisionRepository(mockCtrl)
+	article, err := model.NewArticle("Title1", "Content1", uuid.New(), []string{"Tag1", "Tag2"}, false)
+	if err != nil {
+		panic(err)
+	}
+	categoryId := article.CategoryId
+	title := "Title1Changed"
+	shouldPublish := true
+
+	// Expected & Mock: 本文は変わらないのでキャッシュは捨てない
+	mockTxManager.EXPECT().RunInTx(ctx, gomock.Any()).DoAndReturn(runInTxWith(&transaction.Repositories{ArticleRepository: mockArticleRepository, CategoryRepository: mockCategoryRepository, ArticleRevisionRepository: mockArticleRevisionRepository}))
+	mockArticleRepository.EXPECT().FindOneById(ctx, article.Id).Return(article, nil)
+	mockArticleRevisionRepository.EXPECT().FindLatest(ctx, article.Id).Return(model.NewArticleRevision(article, 1), nil)
+	mockArticleValidator.EXPECT().Validate(ctx, mockCategoryRepository, article).Return(nil)
+	mockArticleRepository.EXPECT().Update(ctx, article).Return(nil)
+	mockArticleRevisionRepository.EXPECT().Insert(ctx, gomock.Any()).Return(nil)
+
+	// Execute
+	u := NewArticleUseCase(mockArticleRepository, mockTxManager, mockArticleCreator, mockArticleValidator, mockArticleSlugAssigner, mockContentRenderer, model.NewTagNameRules(nil, nil))
+	patched, err := u.PatchArticle(ctx, article.Id, 1, &ArticlePatch{Title: &title, ShouldPublish: &shouldPublish})
+
+	// Check
+	if err != nil {
+		t.Fatalf("err of u.PatchArticle: Expected %v, but got %v", nil, err)
+	}
+	if patched.Title != "Title1Changed" || patched.Status != model.Published {
+		t.Errorf("patched: Expected %s %s, but got %s %s", "Title1Changed", model.Published, patched.Title, patched.Status)
+	}
+	// 指定しなかった項目はそのまま
+	if patched.Content != "Content1" || patched.CategoryId != categoryId || len(patched.Tags) != 2 {
+		t.Errorf("patched: Expected %s %v %d tags, but got %s %v %d tags", "Content1", categoryId, 2, patched.Content, patched.CategoryId, len(patched.Tags))
+	}
+}
+
+func TestPatchArticlePublishedAtOfDraftError(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := withTestPrincipal(context.TODO())
+
+	// Prepare1
+	mockArticleRepository := mock_repo.NewMockArticleRepository(mockCtrl)
+	mockTxManager := mock_transaction.NewMockTxManager(mockCtrl)
+	mockArticleCreator := mock_service.NewMockArticleCreator(mockCtrl)
+	mockArticleValidator := mock_service.NewMockArticleValidator(mockCtrl)
+	mockArticleSlugAssigner := mock_service.NewMockArticleSlugAssigner(mockCtrl)
+	mockContentRenderer := mock_render.NewMockContentRenderer(mockCtrl)
+	mockArticleRevisionRepository := mock_repo.NewMockArticleRevisionRepository(mockCtrl)
+	article, err := model.NewArticle("Title1", "Content1", uuid.New(), []string{}, false)
+	if err != nil {
+		panic(err)
+	}
+	publishedAt := time.Now().Add(time.Hour)
+
+	// Expected & Mock: 更新しない
+	mockTxManager.EXPECT().RunInTx(ctx, gomock.Any()).DoAndReturn(runInTxWith(&transaction.Repositories{ArticleRepository: mockArticleRepository, ArticleRevisionRepository: mockArticleRevisionRepository}))
+	mockArticleRepository.EXPECT().FindOneById(ctx, article.Id).Return(article, nil)
+	mockArticleRevisionRepository.EXPECT().FindLatest(ctx, article.Id).Return(model.NewArticleRevision(article, 1), nil)
+
+	// Execute
+	u := NewArticleUseCase(mockArticleRepository, mockTxManager, mockArticleCreator, mockArticleValidator, mockArticleSlugAssigner, mockContentRenderer, model.NewTagNameRules(nil, nil))
+	patched, err := u.PatchArticle(ctx, article.Id, 1, &ArticlePatch{PublishedAt: &publishedAt})
+
+	// Check
+	if patched != nil {
+		t.Errorf("patched: Expected %v, but got %v", nil, patched)
+	}
+	e, ok := errs.As(err)
+	if !ok || e.Kind != errs.Validation || len(e.Fields) != 1 || e.Fields[0].Field != "publishedAt" {
+		t.Errorf("err of u.PatchArticle: Expected %s of publishedAt, but got %v", errs.Validation, err)
+	}
+}
+
+func missingPermission(err error) model.Permission {
+	e, ok := errs.As(err)
+	if !ok || e.Kind != errs.Forbidden {
+		return ""
+	}
+	details, ok := e.Details.(*auth.PermissionDetails)
+	if !ok {
+		return ""
+	}
+	return details.MissingPermission
+}
+
+func TestRegisterArticleWriterPublishForbidden(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := withTestRole(context.TODO(), uuid.New(), model.RoleWriter)
+
+	// Prepare
+	mockArticleRepository := mock_repo.NewMockArticleRepository(mockCtrl)
+	mockTxManager := mock_transaction.NewMockTxManager(mockCtrl)
+	mockArticleCreator := mock_service.NewMockArticleCreator(mockCtrl)
+	mockArticleValidator := mock_service.NewMockArticleValidator(mockCtrl)
+	mockArticleSlugAssigner := mock_service.NewMockArticleSlugAssigner(mockCtrl)
+	mockContentRenderer := mock_render.NewMockContentRenderer(mockCtrl)
+
+	// Expected & Mock: 記事を作らない
+
+	// Execute
+	u := NewArticleUseCase(mockArticleRepository, mockTxManager, mockArticleCreator, mockArticleValidator, mockArticleSlugAssigner, mockContentRenderer, model.NewTagNameRules(nil, nil))
+	_, err := u.RegisterArticle(ctx, "Title1", "Content1", uuid.New(), []string{}, true, "", nil, nil)
+
+	// Check
+	if missingPermission(err) != model.PermArticlesPublish {
+		t.Errorf("err of u.RegisterArticle: Expected missing %s, but got %v", model.PermArticlesPublish, err)
+	}
+}
+
+func TestPatchArticleWriterPermissions(t *testing.T) {
+	writerId := uuid.New()
+	otherId := uuid.New()
+	title := "Title1Changed"
+	shouldPublish := true
+
+	tests := []struct {
+		name string
+		createdBy *uuid.UUID
+		status model.Status
+		patch *ArticlePatch
+		missing model.Permission
+	}{
+		{"自分の下書きの編集", &writerId, model.Draft, &ArticlePatch{Title: &title}, ""},
+		{"他のユーザーの下書き", &otherId, model.Draft, &ArticlePatch{Title: &title}, model.PermArticlesEditAny},
+		{"作成者が不明な記事", nil, model.Draft, &ArticlePatch{Title: &title}, model.PermArticlesEditAny},
+		{"自分の下書きの公開", &writerId, model.Draft, &ArticlePatch{ShouldPublish: &shouldPublish}, model.PermArticlesPublish},
+		{"自分の公開済みの記事", &writerId, model.Published, &ArticlePatch{Title: &title}, model.PermArticlesPublish},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			ctx := withTestRole(context.TODO(), writerId, model.RoleWriter)
+
+			// Prepare
+			mockArticleRepository := mock_repo.NewMockArticleRepository(mockCtrl)
+	mockCategoryRepository := mock_repo.NewMockCategoryRepository(mockCtrl)
+			mockTxManager := mock_transaction.NewMockTxManager(mockCtrl)
+			mockArticleCreator := mock_service.NewMockArticleCreator(mockCtrl)
+			mockArticleValidator := mock_service.NewMockArticleValidator(mockCtrl)
+			mockArticleSlugAssigner := mock_service.NewMockArticleSlugAssigner(mockCtrl)
+			mockContentRenderer := mock_render.NewMockContentRenderer(mockCtrl)
+			mockArticleRevisionRepository := mock_repo.NewMockArticleRevisionRepository(mockCtrl)
+			article, err := model.NewArticle("Title1", "Content1", uuid.New(), []string{}, tt.status == model.Published)
+			if err != nil {
+				panic(err)
+			}
+			article.CreatedBy = tt.createdBy
+
+			// Expected & Mock: 権限がなければ保存しない
+			mockTxManager.EXPECT().RunInTx(ctx, gomock.Any()).DoAndReturn(runInTxWith(&transaction.Repositories{ArticleRepository: mockArticleRepository, CategoryRepository: mockCategoryRepository, ArticleRevisionRepository: mockArticleRevisionRepository}))
+			mockArticleRepository.EXPECT().FindOneById(ctx, article.Id).Return(article, nil)
+			mockArticleRevisionRepository.EXPECT().FindLatest(ctx, article.Id).Return(model.NewArticleRevision(article, 1), nil).MaxTimes(1)
+			if tt.missing == "" {
+				mockArticleValidator.EXPECT().Validate(ctx, mockCategoryRepository, article).Return(nil)
+				mockArticleRepository.EXPECT().Update(ctx, article).Return(nil)
+				mockArticleRevisionRepository.EXPECT().Insert(ctx, gomock.Any()).Return(nil)
+			}
+
+			// Execute
+			u := NewArticleUseCase(mockArticleRepository, mockTxManager, mockArticleCreator, mockArticleValidator, mockArticleSlugAssigner, mockContentRenderer, model.NewTagNameRules(nil, nil))
+			_, err = u.PatchArticle(ctx, article.Id, 1, tt.patch)
+
+			// Check
+			if tt.missing == "" && err != nil {
+				t.Errorf("err of u.PatchArticle: Expected %v, but got %v", nil, err)
+			}
+			if missingPermission(err) != tt.missing {
+				t.Errorf("err of u.PatchArticle: Expected missing %q, but got %v", tt.missing, err)
+			}
+		})
 	}
 }
 
 func TestDeleteArticle(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
+	ctx := withTestPrincipal(context.TODO())
 
 	// Prepare1
 	mockArticleRepository := mock_repo.NewMockArticleRepository(mockCtrl)
-	articleId, err := uuid.Parse("11111111-1111-1111-1111-111111111111")
+	mockTxManager := mock_transaction.NewMockTxManager(mockCtrl)
+	mockArticleCreator := mock_service.NewMockArticleCreator(mockCtrl)
+	mockArticleValidator := mock_service.NewMockArticleValidator(mockCtrl)
+	mockArticleSlugAssigner := mock_service.NewMockArticleSlugAssigner(mockCtrl)
+	mockContentRenderer := mock_render.NewMockContentRenderer(mockCtrl)
+	article, err := model.NewArticle("Title1", "Content1", uuid.New(), []string{}, false)
 	if err != nil {
 		panic(err)
 	}
+	articleId := article.Id
 
 	// Expected & Mock
-	mockArticleRepository.EXPECT().Delete(articleId).Return(nil)
+	mockTxManager.EXPECT().RunInTx(ctx, gomock.Any()).DoAndReturn(runInTxWith(&transaction.Repositories{ArticleRepository: mockArticleRepository}))
+	mockArticleRepository.EXPECT().FindOneById(ctx, articleId).Return(article, nil)
+	mockArticleRepository.EXPECT().Delete(ctx, articleId, 1).Return(nil)
+	mockContentRenderer.EXPECT().Invalidate(articleId)
 
 	// Execute
-	u := NewArticleUseCase(mockArticleRepository)
-	err = u.DeleteArticle(articleId)
+	u := NewArticleUseCase(mockArticleRepository, mockTxManager, mockArticleCreator, mockArticleValidator, mockArticleSlugAssigner, mockContentRenderer, model.NewTagNameRules(nil, nil))
+	err = u.DeleteArticle(ctx, articleId, 1)
 
 	// Check
 	if err != nil {
-		t.Errorf("err of u.DeleteArticle(articleId): Expected %v, but got %v", nil, err)
+		t.Errorf("err of u.DeleteArticle(ctx, articleId): Expected %v, but got %v", nil, err)
+	}
+}
+
+func TestRegisterArticleUnknownAuthorError(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := withTestPrincipal(context.TODO())
+
+	// Prepare
+	mockArticleRepository := mock_repo.NewMockArticleRepository(mockCtrl)
+	mockCategoryRepository := mock_repo.NewMockCategoryRepository(mockCtrl)
+	mockTxManager := mock_transaction.NewMockTxManager(mockCtrl)
+	mockArticleCreator := mock_service.NewMockArticleCreator(mockCtrl)
+	mockArticleValidator := mock_service.NewMockArticleValidator(mockCtrl)
+	mockArticleSlugAssigner := mock_service.NewMockArticleSlugAssigner(mockCtrl)
+	mockContentRenderer := mock_render.NewMockContentRenderer(mockCtrl)
+	mockAuthorRepository := mock_repo.NewMockAuthorRepository(mockCtrl)
+	article, err := model.NewArticle("Title1", "Content1", uuid.New(), []string{}, false)
+	if err != nil {
+		panic(err)
 	}
-}
\ No newline at end of file
+
+	// Expected & Mock: 記事を保存しない
+	mockArticleCreator.EXPECT().Create(ctx, mockCategoryRepository, "Title1", "Content1", article.CategoryId, []string{}, false).Return(article, nil)
+	mockArticleSlugAssigner.EXPECT().Assign(ctx, mockArticleRepository, article, "").Return(nil)
+	mockTxManager.EXPECT().RunInTx(ctx, gomock.Any()).DoAndReturn(runInTxWith(&transaction.Repositories{ArticleRepository: mockArticleRepository, CategoryRepository: mockCategoryRepository, AuthorRepository: mockAuthorRepository}))
+	mockAuthorRepository.EXPECT().FindBySlugs(ctx, []string{"nobody"}).Return([]*model.Author{}, nil)
+
+	// Execute
+	u := NewArticleUseCase(mockArticleRepository, mockTxManager, mockArticleCreator, mockArticleValidator, mockArticleSlugAssigner, mockContentRenderer, model.NewTagNameRules(nil, nil))
+	_, err = u.RegisterArticle(ctx, "Title1", "Content1", article.CategoryId, []string{}, false, "", nil, []string{"nobody"})
+
+	// Check
+	if !errs.IsKind(err, errs.Validation) {
+		t.Errorf("err of u.RegisterArticle: Expected %v, but got %v", errs.Validation, err)
+	}
+}
@@ -0,0 +1,229 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/momonoki1990/tech-blog-api/application/auth"
+	"github.com/momonoki1990/tech-blog-api/domain/errs"
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+	"github.com/momonoki1990/tech-blog-api/domain/repository"
+)
+
+type AuthUseCase interface {
+	// 名前とパスワードが正しければトークンを発行する
+	Login(ctx context.Context, name string, password string) (*TokenPair, error)
+	// リフレッシュトークンを失効させて新しいトークンを発行する（同じリフレッシュトークンは1度しか使えない）
+	Refresh(ctx context.Context, refreshToken string) (*TokenPair, error)
+	// ctxのPrincipalのアクセストークンと、refreshToken（空でなければ）を失効させる
+	Logout(ctx context.Context, refreshToken string) (error)
+	// アクセストークンまたはAPIキーを検証し、送り手を返す
+	Authenticate(ctx context.Context, token string) (*auth.Principal, error)
+}
+
+type TokenPair struct {
+	AccessToken string
+	RefreshToken string
+	// アクセストークンの有効期限
+	ExpiresAt time.Time
+}
+
+type authUseCase struct {
+	repository.UserRepository
+	repository.RevokedTokenRepository
+	repository.ApiKeyRepository
+	auth.TokenSigner
+	accessTokenTTL time.Duration
+	refreshTokenTTL time.Duration
+	// テストでは固定の時刻を返す関数を渡す
+	now func() time.Time
+}
+
+// APIキーの最終使用日時は、リクエストのたびに書き込まないようにこの間隔で更新する
+const apiKeyLastUsedInterval = time.Minute
+
+func NewAuthUseCase(ur repository.UserRepository, rr repository.RevokedTokenRepository, kr repository.ApiKeyRepository, ts auth.TokenSigner, accessTokenTTL time.Duration, refreshTokenTTL time.Duration, now func() time.Time) AuthUseCase {
+	return &authUseCase{ur, rr, kr, ts, accessTokenTTL, refreshTokenTTL, now}
+}
+
+func (u *authUseCase) Login(ctx context.Context, name string, password string) (*TokenPair, error) {
+	user, err := u.UserRepository.FindOneByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	// 名前が存在するかどうかを応答時間で推測されないように、ユーザーがいなくても照合する
+	if user == nil {
+		model.VerifyDummyPassword(password)
+		return nil, invalidCredentials()
+	}
+	if !user.VerifyPassword(password) {
+		return nil, invalidCredentials()
+	}
+	return u.issue(user.Id)
+}
+
+func (u *authUseCase) Refresh(ctx context.Context, refreshToken string) (*TokenPair, error) {
+	claims, err := u.verify(ctx, refreshToken, auth.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+	user, err := u.UserRepository.FindOneById(ctx, claims.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, errs.NewUnauthenticated(errs.CodeInvalidToken, "User of the token was not found")
+	}
+	// 同時に同じトークンで再発行しても、成功するのは1つだけ
+	revoked, err := u.RevokedTokenRepository.Insert(ctx, claims.Id, claims.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	if !revoked {
+		return nil, revokedToken()
+	}
+	return u.issue(user.Id)
+}
+
+func (u *authUseCase) Logout(ctx context.Context, refreshToken string) (error) {
+	principal := auth.PrincipalFrom(ctx)
+	if principal == nil {
+		return errs.NewUnauthenticated(errs.CodeAuthenticationRequired, "Authentication is required")
+	}
+	if principal.ApiKeyId != nil {
+		return errs.NewForbidden(errs.CodeApiKeyNotAllowed, "API keys cannot log out. Revoke the key instead", nil)
+	}
+	if refreshToken != "" {
+		claims, err := u.verify(ctx, refreshToken, auth.RefreshToken)
+		if err != nil {
+			return err
+		}
+		// 他のユーザーのトークンは失効させない
+		if claims.UserId != principal.UserId {
+			return errs.NewUnauthenticated(errs.CodeInvalidToken, "Refresh token belongs to another user")
+		}
+		if _, err := u.RevokedTokenRepository.Insert(ctx, claims.Id, claims.ExpiresAt); err != nil {
+			return err
+		}
+	}
+	if _, err := u.RevokedTokenRepository.Insert(ctx, principal.TokenId, principal.TokenExpiresAt); err != nil {
+		return err
+	}
+	// 期限切れのトークンはどのみち使えないので、ついでに消しておく
+	_, err := u.RevokedTokenRepository.DeleteExpired(ctx, u.now())
+	return err
+}
+
+func (u *authUseCase) Authenticate(ctx context.Context, token string) (*auth.Principal, error) {
+	if model.IsApiKey(token) {
+		return u.authenticateApiKey(ctx, token)
+	}
+	claims, err := u.verify(ctx, token, auth.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	// 削除したユーザーのトークンは期限内でも使えない
+	user, err := u.UserRepository.FindOneById(ctx, claims.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, errs.NewUnauthenticated(errs.CodeInvalidToken, "User of the token was not found")
+	}
+	return &auth.Principal{
+		UserId: user.Id,
+		Name: user.Name,
+		Role: user.Role,
+		TokenId: claims.Id,
+		TokenExpiresAt: claims.ExpiresAt,
+	}, nil
+}
+
+func (u *authUseCase) authenticateApiKey(ctx context.Context, key string) (*auth.Principal, error) {
+	apiKey, err := u.ApiKeyRepository.FindOneByHash(ctx, model.HashApiKey(key))
+	if err != nil {
+		return nil, err
+	}
+	// 失効させたキーは行ごと消えている
+	if apiKey == nil {
+		return nil, errs.NewUnauthenticated(errs.CodeInvalidToken, "API key is invalid or has been revoked")
+	}
+	now := u.now()
+	if apiKey.IsExpired(now) {
+		return nil, errs.NewUnauthenticated(errs.CodeTokenExpired, "API key has expired")
+	}
+	user, err := u.UserRepository.FindOneById(ctx, apiKey.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, errs.NewUnauthenticated(errs.CodeInvalidToken, "User of the API key was not found")
+	}
+	if apiKey.LastUsedAt == nil || now.Sub(*apiKey.LastUsedAt) >= apiKeyLastUsedInterval {
+		if err := u.ApiKeyRepository.UpdateLastUsedAt(ctx, apiKey.Id, now); err != nil {
+			return nil, err
+		}
+	}
+	return &auth.Principal{
+		UserId: user.Id,
+		Name: user.Name,
+		Role: user.Role,
+		ApiKeyId: &apiKey.Id,
+		Scopes: apiKey.Scopes,
+	}, nil
+}
+
+// 署名・期限・種類を検証し、失効していないことを確かめる
+func (u *authUseCase) verify(ctx context.Context, token string, tokenType auth.TokenType) (*auth.Claims, error) {
+	claims, err := u.TokenSigner.Verify(token)
+	if err != nil {
+		return nil, err
+	}
+	// リフレッシュトークンをアクセストークンとして使えないようにする（逆も同様）
+	if claims.Type != tokenType {
+		return nil, errs.NewUnauthenticated(errs.CodeInvalidToken, "Token type is invalid")
+	}
+	revoked, err := u.RevokedTokenRepository.Exists(ctx, claims.Id)
+	if err != nil {
+		return nil, err
+	}
+	if revoked {
+		return nil, revokedToken()
+	}
+	return claims, nil
+}
+
+func (u *authUseCase) issue(userId uuid.UUID) (*TokenPair, error) {
+	now := u.now()
+	access := &auth.Claims{
+		Id: uuid.New().String(),
+		UserId: userId,
+		Type: auth.AccessToken,
+		IssuedAt: now,
+		ExpiresAt: now.Add(u.accessTokenTTL),
+	}
+	accessToken, err := u.TokenSigner.Sign(access)
+	if err != nil {
+		return nil, err
+	}
+	refreshToken, err := u.TokenSigner.Sign(&auth.Claims{
+		Id: uuid.New().String(),
+		UserId: userId,
+		Type: auth.RefreshToken,
+		IssuedAt: now,
+		ExpiresAt: now.Add(u.refreshTokenTTL),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &TokenPair{AccessToken: accessToken, RefreshToken: refreshToken, ExpiresAt: access.ExpiresAt}, nil
+}
+
+func invalidCredentials() error {
+	return errs.NewUnauthenticated(errs.CodeInvalidCredentials, "Name or password is incorrect")
+}
+
+func revokedToken() error {
+	return errs.NewUnauthenticated(errs.CodeInvalidToken, "Token has been revoked")
+}
@@ -0,0 +1,330 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/momonoki1990/tech-blog-api/application/auth"
+	mock_auth "github.com/momonoki1990/tech-blog-api/application/auth/mock"
+	"github.com/momonoki1990/tech-blog-api/domain/errs"
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+	mock_repo "github.com/momonoki1990/tech-blog-api/infra/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func errorCode(err error) string {
+	if e, ok := errs.As(err); ok {
+		return e.Code
+	}
+	return ""
+}
+
+func TestLogin(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := context.TODO()
+
+	// Prepare
+	mockUserRepository := mock_repo.NewMockUserRepository(mockCtrl)
+	mockRevokedTokenRepository := mock_repo.NewMockRevokedTokenRepository(mockCtrl)
+	mockApiKeyRepository := mock_repo.NewMockApiKeyRepository(mockCtrl)
+	mockTokenSigner := mock_auth.NewMockTokenSigner(mockCtrl)
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	user, err := model.NewUser("admin", "correct horse battery", model.RoleAdmin)
+	if err != nil {
+		panic(err)
+	}
+
+	// Expected & Mock
+	mockUserRepository.EXPECT().FindOneByName(ctx, "admin").Return(user, nil).Times(2)
+	mockUserRepository.EXPECT().FindOneByName(ctx, "unknown").Return(nil, nil)
+	mockTokenSigner.EXPECT().Sign(gomock.Any()).DoAndReturn(func(c *auth.Claims) (string, error) {
+		if c.UserId != user.Id {
+			t.Errorf("c.UserId: Expected %v, but got %v", user.Id, c.UserId)
+		}
+		return string(c.Type), nil
+	}).Times(2)
+
+	// Execute
+	u := NewAuthUseCase(mockUserRepository, mockRevokedTokenRepository, mockApiKeyRepository, mockTokenSigner, 15*time.Minute, 24*time.Hour, func() time.Time { return now })
+	pair, err := u.Login(ctx, "admin", "correct horse battery")
+	_, wrongPasswordErr := u.Login(ctx, "admin", "wrong password")
+	_, unknownUserErr := u.Login(ctx, "unknown", "correct horse battery")
+
+	// Check
+	if err != nil {
+		t.Fatalf("err of u.Login(ctx, 'admin', password): Expected %v, but got %v", nil, err)
+	}
+	if pair.AccessToken != string(auth.AccessToken) {
+		t.Errorf("pair.AccessToken: Expected %v, but got %v", auth.AccessToken, pair.AccessToken)
+	}
+	if pair.RefreshToken != string(auth.RefreshToken) {
+		t.Errorf("pair.RefreshToken: Expected %v, but got %v", auth.RefreshToken, pair.RefreshToken)
+	}
+	if !pair.ExpiresAt.Equal(now.Add(15 * time.Minute)) {
+		t.Errorf("pair.ExpiresAt: Expected %v, but got %v", now.Add(15*time.Minute), pair.ExpiresAt)
+	}
+	// 名前とパスワードのどちらが違うかは知らせない
+	if errorCode(wrongPasswordErr) != errs.CodeInvalidCredentials {
+		t.Errorf("err of u.Login(ctx, 'admin', wrong password): Expected %v, but got %v", errs.CodeInvalidCredentials, wrongPasswordErr)
+	}
+	if errorCode(unknownUserErr) != errs.CodeInvalidCredentials {
+		t.Errorf("err of u.Login(ctx, 'unknown', password): Expected %v, but got %v", errs.CodeInvalidCredentials, unknownUserErr)
+	}
+}
+
+func TestRefresh(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := context.TODO()
+
+	// Prepare
+	mockUserRepository := mock_repo.NewMockUserRepository(mockCtrl)
+	mockRevokedTokenRepository := mock_repo.NewMockRevokedTokenRepository(mockCtrl)
+	mockApiKeyRepository := mock_repo.NewMockApiKeyRepository(mockCtrl)
+	mockTokenSigner := mock_auth.NewMockTokenSigner(mockCtrl)
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	user, err := model.NewUser("admin", "correct horse battery", model.RoleAdmin)
+	if err != nil {
+		panic(err)
+	}
+	claims := &auth.Claims{Id: uuid.New().String(), UserId: user.Id, Type: auth.RefreshToken, IssuedAt: now.Add(-time.Hour), ExpiresAt: now.Add(time.Hour)}
+
+	// Expected & Mock
+	gomock.InOrder(
+		mockTokenSigner.EXPECT().Verify("refresh1").Return(claims, nil),
+		mockRevokedTokenRepository.EXPECT().Exists(ctx, claims.Id).Return(false, nil),
+		mockUserRepository.EXPECT().FindOneById(ctx, user.Id).Return(user, nil),
+		// 使ったリフレッシュトークンは失効させる
+		mockRevokedTokenRepository.EXPECT().Insert(ctx, claims.Id, claims.ExpiresAt).Return(true, nil),
+		mockTokenSigner.EXPECT().Sign(gomock.Any()).Return("access2", nil),
+		mockTokenSigner.EXPECT().Sign(gomock.Any()).Return("refresh2", nil),
+	)
+
+	// Execute
+	u := NewAuthUseCase(mockUserRepository, mockRevokedTokenRepository, mockApiKeyRepository, mockTokenSigner, 15*time.Minute, 24*time.Hour, func() time.Time { return now })
+	pair, err := u.Refresh(ctx, "refresh1")
+
+	// Check
+	if err != nil {
+		t.Fatalf("err of u.Refresh(ctx, 'refresh1'): Expected %v, but got %v", nil, err)
+	}
+	if pair.AccessToken != "access2" {
+		t.Errorf("pair.AccessToken: Expected %v, but got %v", "access2", pair.AccessToken)
+	}
+	if pair.RefreshToken != "refresh2" {
+		t.Errorf("pair.RefreshToken: Expected %v, but got %v", "refresh2", pair.RefreshToken)
+	}
+}
+
+func TestRefreshInvalidTokenError(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := context.TODO()
+
+	// Prepare
+	mockUserRepository := mock_repo.NewMockUserRepository(mockCtrl)
+	mockRevokedTokenRepository := mock_repo.NewMockRevokedTokenRepository(mockCtrl)
+	mockApiKeyRepository := mock_repo.NewMockApiKeyRepository(mockCtrl)
+	mockTokenSigner := mock_auth.NewMockTokenSigner(mockCtrl)
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	user, err := model.NewUser("admin", "correct horse battery", model.RoleAdmin)
+	if err != nil {
+		panic(err)
+	}
+	access := &auth.Claims{Id: uuid.New().String(), UserId: user.Id, Type: auth.AccessToken, IssuedAt: now, ExpiresAt: now.Add(time.Minute)}
+	revoked := &auth.Claims{Id: uuid.New().String(), UserId: user.Id, Type: auth.RefreshToken, IssuedAt: now, ExpiresAt: now.Add(time.Hour)}
+	raced := &auth.Claims{Id: uuid.New().String(), UserId: user.Id, Type: auth.RefreshToken, IssuedAt: now, ExpiresAt: now.Add(time.Hour)}
+
+	// Expected & Mock
+	mockTokenSigner.EXPECT().Verify("expired").Return(nil, errs.NewUnauthenticated(errs.CodeTokenExpired, "Token has expired"))
+	mockTokenSigner.EXPECT().Verify("access").Return(access, nil)
+	mockTokenSigner.EXPECT().Verify("revoked").Return(revoked, nil)
+	mockRevokedTokenRepository.EXPECT().Exists(ctx, revoked.Id).Return(true, nil)
+	// 検証した後、別のリクエストが先に同じトークンを使った
+	mockTokenSigner.EXPECT().Verify("raced").Return(raced, nil)
+	mockRevokedTokenRepository.EXPECT().Exists(ctx, raced.Id).Return(false, nil)
+	mockUserRepository.EXPECT().FindOneById(ctx, user.Id).Return(user, nil)
+	mockRevokedTokenRepository.EXPECT().Insert(ctx, raced.Id, raced.ExpiresAt).Return(false, nil)
+
+	u := NewAuthUseCase(mockUserRepository, mockRevokedTokenRepository, mockApiKeyRepository, mockTokenSigner, 15*time.Minute, 24*time.Hour, func() time.Time { return now })
+	tests := []struct {
+		name string
+		token string
+		code string
+	}{
+		{"期限切れ", "expired", errs.CodeTokenExpired},
+		{"アクセストークン", "access", errs.CodeInvalidToken},
+		{"失効済み", "revoked", errs.CodeInvalidToken},
+		{"同時に再発行", "raced", errs.CodeInvalidToken},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Execute
+			pair, err := u.Refresh(ctx, tt.token)
+
+			// Check
+			if errorCode(err) != tt.code {
+				t.Errorf("err of u.Refresh(ctx, %q): Expected %v, but got %v", tt.token, tt.code, err)
+			}
+			if pair != nil {
+				t.Errorf("pair: Expected %v, but got %v", nil, pair)
+			}
+		})
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := context.TODO()
+
+	// Prepare
+	mockUserRepository := mock_repo.NewMockUserRepository(mockCtrl)
+	mockRevokedTokenRepository := mock_repo.NewMockRevokedTokenRepository(mockCtrl)
+	mockApiKeyRepository := mock_repo.NewMockApiKeyRepository(mockCtrl)
+	mockTokenSigner := mock_auth.NewMockTokenSigner(mockCtrl)
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	user, err := model.NewUser("admin", "correct horse battery", model.RoleAdmin)
+	if err != nil {
+		panic(err)
+	}
+	access := &auth.Claims{Id: uuid.New().String(), UserId: user.Id, Type: auth.AccessToken, IssuedAt: now, ExpiresAt: now.Add(time.Minute)}
+	refresh := &auth.Claims{Id: uuid.New().String(), UserId: user.Id, Type: auth.RefreshToken, IssuedAt: now, ExpiresAt: now.Add(time.Hour)}
+
+	// Expected & Mock
+	mockTokenSigner.EXPECT().Verify("access").Return(access, nil)
+	mockRevokedTokenRepository.EXPECT().Exists(ctx, access.Id).Return(false, nil)
+	mockUserRepository.EXPECT().FindOneById(ctx, user.Id).Return(user, nil)
+	mockTokenSigner.EXPECT().Verify("refresh").Return(refresh, nil)
+
+	// Execute
+	u := NewAuthUseCase(mockUserRepository, mockRevokedTokenRepository, mockApiKeyRepository, mockTokenSigner, 15*time.Minute, 24*time.Hour, func() time.Time { return now })
+	principal, err := u.Authenticate(ctx, "access")
+	_, refreshErr := u.Authenticate(ctx, "refresh")
+
+	// Check
+	if err != nil {
+		t.Fatalf("err of u.Authenticate(ctx, 'access'): Expected %v, but got %v", nil, err)
+	}
+	if principal.UserId != user.Id {
+		t.Errorf("principal.UserId: Expected %v, but got %v", user.Id, principal.UserId)
+	}
+	if principal.TokenId != access.Id {
+		t.Errorf("principal.TokenId: Expected %v, but got %v", access.Id, principal.TokenId)
+	}
+	// リフレッシュトークンではAPIを呼べない
+	if errorCode(refreshErr) != errs.CodeInvalidToken {
+		t.Errorf("err of u.Authenticate(ctx, 'refresh'): Expected %v, but got %v", errs.CodeInvalidToken, refreshErr)
+	}
+}
+
+func TestLogout(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	// Prepare
+	mockUserRepository := mock_repo.NewMockUserRepository(mockCtrl)
+	mockRevokedTokenRepository := mock_repo.NewMockRevokedTokenRepository(mockCtrl)
+	mockApiKeyRepository := mock_repo.NewMockApiKeyRepository(mockCtrl)
+	mockTokenSigner := mock_auth.NewMockTokenSigner(mockCtrl)
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	principal := &auth.Principal{UserId: uuid.New(), Name: "admin", TokenId: uuid.New().String(), TokenExpiresAt: now.Add(time.Minute)}
+	ctx := auth.WithPrincipal(context.TODO(), principal)
+	refresh := &auth.Claims{Id: uuid.New().String(), UserId: principal.UserId, Type: auth.RefreshToken, IssuedAt: now, ExpiresAt: now.Add(time.Hour)}
+
+	// Expected & Mock
+	mockTokenSigner.EXPECT().Verify("refresh").Return(refresh, nil)
+	mockRevokedTokenRepository.EXPECT().Exists(ctx, refresh.Id).Return(false, nil)
+	mockRevokedTokenRepository.EXPECT().Insert(ctx, refresh.Id, refresh.ExpiresAt).Return(true, nil)
+	mockRevokedTokenRepository.EXPECT().Insert(ctx, principal.TokenId, principal.TokenExpiresAt).Return(true, nil)
+	mockRevokedTokenRepository.EXPECT().DeleteExpired(ctx, now).Return(int64(0), nil)
+
+	// Execute
+	u := NewAuthUseCase(mockUserRepository, mockRevokedTokenRepository, mockApiKeyRepository, mockTokenSigner, 15*time.Minute, 24*time.Hour, func() time.Time { return now })
+	err := u.Logout(ctx, "refresh")
+
+	// Check
+	if err != nil {
+		t.Errorf("err of u.Logout(ctx, 'refresh'): Expected %v, but got %v", nil, err)
+	}
+}
+
+func TestAuthenticateApiKey(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	expiresAt := now.Add(time.Hour)
+	expiredAt := now.Add(-time.Hour)
+	recentlyUsedAt := now.Add(-10 * time.Second)
+	tests := []struct {
+		name string
+		expiresAt *time.Time
+		lastUsedAt *time.Time
+		found bool
+		shouldUpdateLastUsedAt bool
+		code string
+	}{
+		{"有効なキー", &expiresAt, nil, true, true, ""},
+		{"直前に使ったキーは最終使用日時を更新しない", nil, &recentlyUsedAt, true, false, ""},
+		{"期限切れのキー", &expiredAt, nil, true, false, errs.CodeTokenExpired},
+		{"失効させたキー", nil, nil, false, false, errs.CodeInvalidToken},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			ctx := context.TODO()
+
+			// Prepare
+			mockUserRepository := mock_repo.NewMockUserRepository(mockCtrl)
+			mockRevokedTokenRepository := mock_repo.NewMockRevokedTokenRepository(mockCtrl)
+			mockApiKeyRepository := mock_repo.NewMockApiKeyRepository(mockCtrl)
+			mockTokenSigner := mock_auth.NewMockTokenSigner(mockCtrl)
+			user, err := model.NewUser("ci", "correct horse battery", model.RoleEditor)
+			if err != nil {
+				panic(err)
+			}
+			apiKey, key, err := model.NewApiKey(user.Id, "CI", []string{"articles:write"}, tt.expiresAt, now.Add(-24*time.Hour))
+			if err != nil {
+				panic(err)
+			}
+			apiKey.LastUsedAt = tt.lastUsedAt
+
+			// Expected & Mock
+			if tt.found {
+				mockApiKeyRepository.EXPECT().FindOneByHash(ctx, model.HashApiKey(key)).Return(apiKey, nil)
+			} else {
+				mockApiKeyRepository.EXPECT().FindOneByHash(ctx, model.HashApiKey(key)).Return(nil, nil)
+			}
+			if tt.code == "" {
+				mockUserRepository.EXPECT().FindOneById(ctx, user.Id).Return(user, nil)
+			}
+			if tt.shouldUpdateLastUsedAt {
+				mockApiKeyRepository.EXPECT().UpdateLastUsedAt(ctx, apiKey.Id, now).Return(nil)
+			}
+
+			// Execute
+			u := NewAuthUseCase(mockUserRepository, mockRevokedTokenRepository, mockApiKeyRepository, mockTokenSigner, 15*time.Minute, 24*time.Hour, func() time.Time { return now })
+			principal, err := u.Authenticate(ctx, key)
+
+			// Check
+			if errorCode(err) != tt.code {
+				t.Fatalf("err of u.Authenticate(ctx, key): Expected %v, but got %v", tt.code, err)
+			}
+			if tt.code != "" {
+				return
+			}
+			if principal.ApiKeyId == nil || *principal.ApiKeyId != apiKey.Id {
+				t.Errorf("principal.ApiKeyId: Expected %v, but got %v", apiKey.Id, principal.ApiKeyId)
+			}
+			// 役割の権限のうち、スコープにあるものだけ使える
+			if !principal.Can(model.PermArticlesWrite) {
+				t.Errorf("principal.Can(%s): Expected %v, but got %v", model.PermArticlesWrite, true, false)
+			}
+			if principal.Can(model.PermArticlesPublish) {
+				t.Errorf("principal.Can(%s): Expected %v, but got %v", model.PermArticlesPublish, false, true)
+			}
+		})
+	}
+}
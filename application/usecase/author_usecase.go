@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/momonoki1990/tech-blog-api/domain/errs"
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+	"github.com/momonoki1990/tech-blog-api/domain/repository"
+)
+
+// 著者のプロフィールと、著者ごとの公開済みの記事
+type AuthorUseCase interface {
+	// 表示名の順
+	GetAuthors(ctx context.Context) ([]*model.Author, error)
+	GetAuthor(ctx context.Context, slug string) (*model.Author, error)
+	// 著者の公開済みの記事。criteriaは並び順とページングだけを使う
+	GetAuthorArticles(ctx context.Context, slug string, criteria *repository.ArticleCriteria) ([]*model.Article, *repository.ArticleCursor, error)
+}
+
+type authorUseCase struct {
+	repository.AuthorRepository
+	repository.ArticleRepository
+}
+
+func NewAuthorUseCase(r repository.AuthorRepository, ar repository.ArticleRepository) AuthorUseCase {
+	return &authorUseCase{r, ar}
+}
+
+func (u *authorUseCase) GetAuthors(ctx context.Context) ([]*model.Author, error) {
+	return u.AuthorRepository.Find(ctx)
+}
+
+func (u *authorUseCase) GetAuthor(ctx context.Context, slug string) (*model.Author, error) {
+	author, err := u.AuthorRepository.FindOneBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	if author == nil {
+		return nil, errs.NewNotFound(errs.CodeAuthorNotFound, "Author was not found")
+	}
+	return author, nil
+}
+
+func (u *authorUseCase) GetAuthorArticles(ctx context.Context, slug string, criteria *repository.ArticleCriteria) ([]*model.Article, *repository.ArticleCursor, error) {
+	author, err := u.GetAuthor(ctx, slug)
+	if err != nil {
+		return nil, nil, err
+	}
+	published := model.Published
+	c := &repository.ArticleCriteria{
+		AuthorId: &author.Id,
+		Status: &published,
+		SortKey: criteria.SortKey,
+		Ascending: criteria.Ascending,
+		Limit: criteria.Limit,
+		After: criteria.After,
+	}
+	return u.ArticleRepository.Find(ctx, c)
+}
@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/momonoki1990/tech-blog-api/domain/errs"
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+	"github.com/momonoki1990/tech-blog-api/domain/repository"
+	mock_repo "github.com/momonoki1990/tech-blog-api/infra/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetAuthorArticles(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := context.TODO()
+
+	// Prepare
+	mockAuthorRepository := mock_repo.NewMockAuthorRepository(mockCtrl)
+	mockArticleRepository := mock_repo.NewMockArticleRepository(mockCtrl)
+	author, err := model.NewAuthor(nil, "Alice", "alice", "Go developer", "https://example.com/alice.png")
+	if err != nil {
+		panic(err)
+	}
+	published := model.Published
+	criteria := &repository.ArticleCriteria{SortKey: repository.SortByPublishedAt, Limit: 10, Status: &published}
+
+	// Expected & Mock
+	mockAuthorRepository.EXPECT().FindOneBySlug(ctx, "alice").Return(author, nil)
+	mockArticleRepository.EXPECT().Find(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, c *repository.ArticleCriteria) ([]*model.Article, *repository.ArticleCursor, error) {
+		if c.AuthorId == nil || *c.AuthorId != author.Id {
+			t.Errorf("c.AuthorId: Expected %v, but got %v", author.Id, c.AuthorId)
+		}
+		// 下書きは著者のページに出さない
+		if *c.Status != published {
+			t.Errorf("c.Status: Expected %v, but got %v", published, *c.Status)
+		}
+		if c.Limit != 10 {
+			t.Errorf("c.Limit: Expected %v, but got %v", 10, c.Limit)
+		}
+		return []*model.Article{}, nil, nil
+	})
+
+	// Execute
+	u := NewAuthorUseCase(mockAuthorRepository, mockArticleRepository)
+	_, _, err = u.GetAuthorArticles(ctx, "alice", criteria)
+
+	// Check
+	if err != nil {
+		t.Errorf("err of u.GetAuthorArticles(ctx, 'alice', criteria): Expected %v, but got %v", nil, err)
+	}
+}
+
+func TestGetAuthorNotFoundError(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := context.TODO()
+
+	// Prepare
+	mockAuthorRepository := mock_repo.NewMockAuthorRepository(mockCtrl)
+	mockArticleRepository := mock_repo.NewMockArticleRepository(mockCtrl)
+
+	// Expected & Mock
+	mockAuthorRepository.EXPECT().FindOneBySlug(ctx, "nobody").Return(nil, nil).Times(2)
+
+	// Execute
+	u := NewAuthorUseCase(mockAuthorRepository, mockArticleRepository)
+	_, err := u.GetAuthor(ctx, "nobody")
+	_, _, articlesErr := u.GetAuthorArticles(ctx, "nobody", &repository.ArticleCriteria{})
+
+	// Check
+	if errorCode(err) != errs.CodeAuthorNotFound {
+		t.Errorf("err of u.GetAuthor(ctx, 'nobody'): Expected %v, but got %v", errs.CodeAuthorNotFound, err)
+	}
+	if errorCode(articlesErr) != errs.CodeAuthorNotFound {
+		t.Errorf("err of u.GetAuthorArticles(ctx, 'nobody'): Expected %v, but got %v", errs.CodeAuthorNotFound, articlesErr)
+	}
+}
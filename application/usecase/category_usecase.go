@@ -1,65 +1,344 @@
 package usecase
 
 import (
-	"errors"
+	"context"
 
 	"github.com/google/uuid"
+	"github.com/momonoki1990/tech-blog-api/application/auth"
+	"github.com/momonoki1990/tech-blog-api/application/transaction"
+	"github.com/momonoki1990/tech-blog-api/domain/errs"
 	"github.com/momonoki1990/tech-blog-api/domain/model"
 	"github.com/momonoki1990/tech-blog-api/domain/repository"
 	"github.com/momonoki1990/tech-blog-api/domain/service"
 )
 
 type CategoryUseCase interface {
-    GetCategoryList() ([]*model.Category, error)
-    RegisterCategory(name string, displayOrder int) (string, error)
-	UpdateCategory(id uuid.UUID, name string, displayOrder int) (error)
-	DeleteCategory(id uuid.UUID) (error)
+	// 記事の集計（Stats）を含めて返す
+	GetCategory(ctx context.Context, id uuid.UUID) (*model.Category, error)
+	// withStatsがtrueなら記事の集計（Stats）も返す
+    GetCategoryList(ctx context.Context, withStats bool) ([]*model.Category, error)
+	// 最上位のカテゴリから、子を表示順に入れ子にして返す
+	GetCategoryTree(ctx context.Context, withStats bool) ([]*model.CategoryNode, error)
+	// slugが空なら名前から生成する
+    RegisterCategory(ctx context.Context, name string, displayOrder int, parentId *uuid.UUID, slug string, description string) (string, error)
+	// versionは読み込んだ時点のカテゴリのVersion（If-Match）。一致しなければPreconditionFailed
+	// parentIdがnilなら最上位にする。slugが空なら変更しない
+	UpdateCategory(ctx context.Context, id uuid.UUID, version int, name string, displayOrder int, parentId *uuid.UUID, slug string, description string) (error)
+	// patchのnilでないフィールドだけを変更する
+	PatchCategory(ctx context.Context, id uuid.UUID, version int, patch *CategoryPatch) (*model.Category, error)
+	// 記事があるカテゴリはConflict（記事の件数を返す）。reassignToがあれば記事をそのカテゴリに移してから削除する
+	DeleteCategory(ctx context.Context, id uuid.UUID, version int, reassignTo *uuid.UUID) (error)
+	// idsの順に表示順を1から振り直し、並べ替えたカテゴリの一覧を返す。idsにはすべてのカテゴリを含めること
+	ReorderCategories(ctx context.Context, ids []uuid.UUID) ([]*model.Category, error)
+}
+
+// カテゴリの部分更新の内容（nilのフィールドは変更しない）
+type CategoryPatch struct {
+	Name *string
+	DisplayOrder *int
+	// trueならParentIdに付け替える（ParentIdがnilなら最上位にする）
+	SetParent bool
+	ParentId *uuid.UUID
+	Slug *string
+	Description *string
 }
 
 type categoryUseCase struct {
     repository.CategoryRepository
 	service.CategoryCreator
+	service.CategorySlugAssigner
+	transaction.TxManager
+}
+
+func NewCategoryUseCase(r repository.CategoryRepository, s service.CategoryCreator, sa service.CategorySlugAssigner, tm transaction.TxManager) CategoryUseCase {
+    return &categoryUseCase{r, s, sa, tm}
+}
+
+func (u *categoryUseCase) GetCategory(ctx context.Context, id uuid.UUID) (*model.Category, error) {
+	c, err := u.CategoryRepository.FindOneById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if c == nil {
+		return nil, errs.NewNotFound(errs.CodeCategoryNotFound, "Category was not found")
+	}
+	if err := u.setCategoryStats(ctx, []*model.Category{c}); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (u *categoryUseCase) GetCategoryList(ctx context.Context, withStats bool) ([]*model.Category, error) {
+    categories, err := u.CategoryRepository.Find(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if withStats {
+		if err := u.setCategoryStats(ctx, categories); err != nil {
+			return nil, err
+		}
+	}
+	return categories, nil
 }
 
-func NewCategoryUseCase(r repository.CategoryRepository, s service.CategoryCreator) CategoryUseCase {
-    return &categoryUseCase{r, s}
+func (u *categoryUseCase) GetCategoryTree(ctx context.Context, withStats bool) ([]*model.CategoryNode, error) {
+	categories, err := u.GetCategoryList(ctx, withStats)
+	if err != nil {
+		return nil, err
+	}
+	return model.NewCategoryTree(categories).Nodes(), nil
 }
 
-func (u *categoryUseCase) GetCategoryList() ([]*model.Category, error) {
-    categories, err := u.CategoryRepository.Find()
-	return categories, err
+// 全カテゴリ分を1回のクエリで集計する
+func (u *categoryUseCase) setCategoryStats(ctx context.Context, categories []*model.Category) (error) {
+	ids := make([]uuid.UUID, 0, len(categories))
+	for _, v := range categories {
+		ids = append(ids, v.Id)
+	}
+	stats, err := u.CategoryRepository.FindStats(ctx, ids)
+	if err != nil {
+		return err
+	}
+	// categories:readがなければ公開済みの記事の件数だけ（下書きや予約中の記事があることを知らせない）
+	canReadAll := auth.Can(ctx, model.PermCategoriesRead)
+	for _, v := range categories {
+		s := stats[v.Id]
+		if s != nil && !canReadAll {
+			s = &model.CategoryStats{
+				ArticleCounts: model.CategoryArticleCounts{Published: s.ArticleCounts.Published},
+				LatestPublishedAt: s.LatestPublishedAt,
+			}
+		}
+		v.Stats = s
+	}
+	return nil
 }
 
-func (u *categoryUseCase) RegisterCategory(name string, displayOrder int) (string, error) {
-	c, err := u.CategoryCreator.Create(name, displayOrder)
+func (u *categoryUseCase) RegisterCategory(ctx context.Context, name string, displayOrder int, parentId *uuid.UUID, slug string, description string) (string, error) {
+	if err := auth.Require(ctx, model.PermCategoriesManage); err != nil {
+		return "", err
+	}
+	c, err := u.CategoryCreator.Create(ctx, name, displayOrder, parentId)
 	if err != nil {
 		return "", err
 	}
-	err = u.CategoryRepository.Insert(c)
+	if err := c.SetDescription(description); err != nil {
+		return "", err
+	}
+	generatedSlug := c.Slug
+	err = u.TxManager.RunInTx(ctx, func(r *transaction.Repositories) error {
+		// デッドロックでやり直す場合に、前回の連番を持ち越さない
+		c.Slug = generatedSlug
+		if err := u.CategorySlugAssigner.Assign(ctx, r.CategoryRepository, c, slug); err != nil {
+			return err
+		}
+		return r.CategoryRepository.Insert(ctx, c)
+	})
 	if err != nil {
 		return "", err
 	}
 	return c.Id.String(), nil
 }
 
-func (u *categoryUseCase) UpdateCategory(id uuid.UUID, name string, displayOrder int) (error) {
-	c, err := u.CategoryRepository.FindOneById(id)
+func (u *categoryUseCase) UpdateCategory(ctx context.Context, id uuid.UUID, version int, name string, displayOrder int, parentId *uuid.UUID, slug string, description string) (error) {
+	_, err := u.modifyCategory(ctx, id, version, func(r *transaction.Repositories, c *model.Category) error {
+		c.Name = name
+		if err := c.SetDisplayOrder(displayOrder); err != nil {
+			return err
+		}
+		if err := c.SetDescription(description); err != nil {
+			return err
+		}
+		if err := u.assignCategorySlug(ctx, r, c, slug); err != nil {
+			return err
+		}
+		return setCategoryParent(ctx, r, c, parentId)
+	})
+	return err
+}
+
+func (u *categoryUseCase) PatchCategory(ctx context.Context, id uuid.UUID, version int, patch *CategoryPatch) (*model.Category, error) {
+	return u.modifyCategory(ctx, id, version, func(r *transaction.Repositories, c *model.Category) error {
+		if patch.Name != nil {
+			c.Name = *patch.Name
+		}
+		if patch.DisplayOrder != nil {
+			if err := c.SetDisplayOrder(*patch.DisplayOrder); err != nil {
+				return err
+			}
+		}
+		if patch.Description != nil {
+			if err := c.SetDescription(*patch.Description); err != nil {
+				return err
+			}
+		}
+		if patch.Slug != nil {
+			if err := u.assignCategorySlug(ctx, r, c, *patch.Slug); err != nil {
+				return err
+			}
+		}
+		if patch.SetParent {
+			return setCategoryParent(ctx, r, c, patch.ParentId)
+		}
+		return nil
+	})
+}
+
+// 名前を変えてもスラッグは変えない（URLが変わらないように）。空なら変更しない
+func (u *categoryUseCase) assignCategorySlug(ctx context.Context, r *transaction.Repositories, c *model.Category, slug string) (error) {
+	if slug == "" || slug == c.Slug {
+		return nil
+	}
+	return u.CategorySlugAssigner.Assign(ctx, r.CategoryRepository, c, slug)
+}
+
+// 記事を移している間に移し先が削除されないよう、移し先もロックする
+func validateReassignTo(ctx context.Context, r *transaction.Repositories, id uuid.UUID, reassignTo uuid.UUID) (error) {
+	message := ""
+	if reassignTo == id {
+		message = "reassignTo should be another category"
+	} else {
+		found, err := r.CategoryRepository.FindOneByIdForUpdate(ctx, reassignTo)
+		if err != nil {
+			return err
+		}
+		if found == nil {
+			message = "reassignTo category was not found"
+		}
+	}
+	if message == "" {
+		return nil
+	}
+	return errs.NewValidation(errs.CodeValidationFailed, message, errs.FieldError{Field: "reassignTo", Message: message})
+}
+
+// 確かめてから削除するまでに子が移されないよう、カテゴリを行ロックして読む
+func validateNoChildren(ctx context.Context, r *transaction.Repositories, id uuid.UUID) (error) {
+	categories, err := r.CategoryRepository.FindForUpdate(ctx)
 	if err != nil {
 		return err
 	}
-	if c == nil {
-		return errors.New("Category to update was not found")
+	for _, v := range categories {
+		if v.ParentId != nil && *v.ParentId == id {
+			return errs.NewConflict(errs.CodeCategoryHasChildren, "Category has child categories. Move or delete them first")
+		}
 	}
-	c.Name = name
-	c.DisplayOrder = displayOrder
-	err = u.CategoryRepository.Update(c)
-	return err
+	return nil
 }
 
-func (u *categoryUseCase) DeleteCategory(id uuid.UUID) (error) {
-	err := u.CategoryRepository.Delete(id)
+// 親が変わる場合だけ、全カテゴリの親子関係から循環しないことを確かめる
+// 同時に互いの下へ移す2つのリクエストが両方とも通らないよう、カテゴリを行ロックして読む
+func setCategoryParent(ctx context.Context, r *transaction.Repositories, c *model.Category, parentId *uuid.UUID) (error) {
+	if parentId == nil && c.ParentId == nil || parentId != nil && c.ParentId != nil && *parentId == *c.ParentId {
+		return nil
+	}
+	categories, err := r.CategoryRepository.FindForUpdate(ctx)
+	if err != nil {
+		return err
+	}
+	return c.SetParent(parentId, model.NewCategoryTree(categories))
+}
+
+func (u *categoryUseCase) modifyCategory(ctx context.Context, id uuid.UUID, version int, apply func(r *transaction.Repositories, c *model.Category) error) (*model.Category, error) {
+	if err := auth.Require(ctx, model.PermCategoriesManage); err != nil {
+		return nil, err
+	}
+	var c *model.Category
+	err := u.TxManager.RunInTx(ctx, func(r *transaction.Repositories) error {
+		var err error
+		c, err = r.CategoryRepository.FindOneById(ctx, id)
+		if err != nil {
+			return err
+		}
+		if c == nil {
+			return errs.NewNotFound(errs.CodeCategoryNotFound, "Category to update was not found")
+		}
+		if c.Version != version {
+			return errs.NewPreconditionFailed(errs.CodeCategoryVersionMismatch, "Category has been modified by another request")
+		}
+		if err := apply(r, c); err != nil {
+			return err
+		}
+		return r.CategoryRepository.Update(ctx, c)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (u *categoryUseCase) DeleteCategory(ctx context.Context, id uuid.UUID, version int, reassignTo *uuid.UUID) (error) {
+	if err := auth.Require(ctx, model.PermCategoriesManage); err != nil {
+		return err
+	}
+	err := u.TxManager.RunInTx(ctx, func(r *transaction.Repositories) error {
+		// 記事を数える前にロックし、同時に復元・保存される記事を待つ（待った後の件数で判断する）
+		c, err := r.CategoryRepository.FindOneByIdForUpdate(ctx, id)
+		if err != nil {
+			return err
+		}
+		if c == nil {
+			return errs.NewNotFound(errs.CodeCategoryNotFound, "Category to delete was not found")
+		}
+		// 記事を移す前に確かめる（古い内容を見て削除を決めたリクエストで記事を動かさない）
+		if c.Version != version {
+			return errs.NewPreconditionFailed(errs.CodeCategoryVersionMismatch, "Category has been modified by another request")
+		}
+		// 子のカテゴリが残っていると、ゴミ箱の親を指したまま最上位に表示されてしまう
+		if err := validateNoChildren(ctx, r, id); err != nil {
+			return err
+		}
+		if reassignTo == nil {
+			counts, err := r.ArticleRepository.CountByCategory(ctx, id)
+			if err != nil {
+				return err
+			}
+			// ゴミ箱の記事だけなら削除できる（記事を復元するにはカテゴリの復元が必要）
+			if counts.Active() > 0 {
+				return errs.NewConflictWithDetails(errs.CodeCategoryInUse, "Category has articles. Specify reassignTo to move them", counts)
+			}
+		} else {
+			if err := validateReassignTo(ctx, r, id, *reassignTo); err != nil {
+				return err
+			}
+			if _, err := r.ArticleRepository.ReassignCategory(ctx, id, *reassignTo); err != nil {
+				return err
+			}
+		}
+		return r.CategoryRepository.Delete(ctx, id, version)
+	})
 	if err != nil {
 		return err
 	}
 	return nil
-}
\ No newline at end of file
+}
+
+func (u *categoryUseCase) ReorderCategories(ctx context.Context, ids []uuid.UUID) ([]*model.Category, error) {
+	if err := auth.Require(ctx, model.PermCategoriesManage); err != nil {
+		return nil, err
+	}
+	var categories []*model.Category
+	err := u.TxManager.RunInTx(ctx, func(r *transaction.Repositories) error {
+		var err error
+		categories, err = r.CategoryRepository.Find(ctx)
+		if err != nil {
+			return err
+		}
+		changed, err := model.ReorderCategories(categories, ids)
+		if err != nil {
+			return err
+		}
+		// 途中で他の更新とぶつかればPreconditionFailedになり、すべて元に戻る
+		for _, v := range changed {
+			if err := r.CategoryRepository.Update(ctx, v); err != nil {
+				return err
+			}
+		}
+		categories, err = r.CategoryRepository.Find(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return categories, nil
+}
@@ -1,9 +1,17 @@
 package usecase
 
 import (
+	"context"
 	"testing"
+	"time"
 
+	"github.com/google/uuid"
+	"github.com/momonoki1990/tech-blog-api/application/auth"
+	"github.com/momonoki1990/tech-blog-api/application/transaction"
+	mock_transaction "github.com/momonoki1990/tech-blog-api/application/transaction/mock"
+	"github.com/momonoki1990/tech-blog-api/domain/errs"
 	"github.com/momonoki1990/tech-blog-api/domain/model"
+	"github.com/momonoki1990/tech-blog-api/domain/repository"
 	mock_service "github.com/momonoki1990/tech-blog-api/domain/service/mock"
 	mock_repo "github.com/momonoki1990/tech-blog-api/infra/mock"
 	"go.uber.org/mock/gomock"
@@ -12,18 +20,21 @@ import (
 func TestGetCategoryList(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
+	ctx := withTestPrincipal(context.TODO())
 
 	// Prepare1
 	mockCategoryRepository := mock_repo.NewMockCategoryRepository(mockCtrl)
 	mockCategoryCreator := mock_service.NewMockCategoryCreator(mockCtrl)
+	mockCategorySlugAssigner := mock_service.NewMockCategorySlugAssigner(mockCtrl)
+	mockTxManager := mock_transaction.NewMockTxManager(mockCtrl)
 	
 	// Expected & Mock
 	categories := []*model.Category{}
-	mockCategoryRepository.EXPECT().Find().Return(categories, nil)
+	mockCategoryRepository.EXPECT().Find(ctx).Return(categories, nil)
 	
 	// Execute
-	u := NewCategoryUseCase(mockCategoryRepository, mockCategoryCreator)
-	actual, err := u.GetCategoryList()
+	u := NewCategoryUseCase(mockCategoryRepository, mockCategoryCreator, mockCategorySlugAssigner, mockTxManager)
+	actual, err := u.GetCategoryList(ctx, false)
 	if err != nil {
 		panic(err)
 	}
@@ -37,39 +48,49 @@ func TestGetCategoryList(t *testing.T) {
 func TestRegisterCategory(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
+	ctx := withTestPrincipal(context.TODO())
 
 	// Prepare1
 	mockCategoryRepository := mock_repo.NewMockCategoryRepository(mockCtrl)
+	mockTxCategoryRepository := mock_repo.NewMockCategoryRepository(mockCtrl)
 	mockCategoryCreator := mock_service.NewMockCategoryCreator(mockCtrl)
+	mockCategorySlugAssigner := mock_service.NewMockCategorySlugAssigner(mockCtrl)
+	mockTxManager := mock_transaction.NewMockTxManager(mockCtrl)
 	category, err := model.NewCategory("Name1", 1)
 	if err != nil {
 		panic(err)
 	}
 
 	// Expected & Mock
-	mockCategoryCreator.EXPECT().Create("Name1", 1).Return(category, nil)
-	mockCategoryRepository.EXPECT().Insert(category).Return(nil)
+	// スラッグの重複はトランザクションのリポジトリで確かめる
+	mockCategoryCreator.EXPECT().Create(ctx, "Name1", 1, nil).Return(category, nil)
+	mockTxManager.EXPECT().RunInTx(ctx, gomock.Any()).DoAndReturn(runInTxWith(&transaction.Repositories{CategoryRepository: mockTxCategoryRepository}))
+	mockCategorySlugAssigner.EXPECT().Assign(ctx, mockTxCategoryRepository, category, "").Return(nil)
+	mockTxCategoryRepository.EXPECT().Insert(ctx, category).Return(nil)
 
 	// Execute
-	u := NewCategoryUseCase(mockCategoryRepository, mockCategoryCreator)
-	id, err := u.RegisterCategory("Name1", 1)
+	u := NewCategoryUseCase(mockCategoryRepository, mockCategoryCreator, mockCategorySlugAssigner, mockTxManager)
+	id, err := u.RegisterCategory(ctx, "Name1", 1, nil, "", "")
 
 	// Check
 	if err != nil {
-		t.Errorf("err of u.RegisterCategory('Name1', 1): Expected %v, but got %v", nil, err)
+		t.Errorf("err of u.RegisterCategory(ctx, 'Name1', 1): Expected %v, but got %v", nil, err)
 	}
 	if id == "" {
-		t.Errorf("id of u.RegisterCategory('Name1', 1): Expected %s, but got %s", "not empty string", id)
+		t.Errorf("id of u.RegisterCategory(ctx, 'Name1', 1): Expected %s, but got %s", "not empty string", id)
 	}
 }
 
 func TestUpdateCategory(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
+	ctx := withTestPrincipal(context.TODO())
 
 	// Prepare1
 	mockCategoryRepository := mock_repo.NewMockCategoryRepository(mockCtrl)
 	mockCategoryCreator := mock_service.NewMockCategoryCreator(mockCtrl)
+	mockCategorySlugAssigner := mock_service.NewMockCategorySlugAssigner(mockCtrl)
+	mockTxManager := mock_transaction.NewMockTxManager(mockCtrl)
 	category, err := model.NewCategory("Name1", 1)
 	if err != nil {
 		panic(err)
@@ -77,26 +98,30 @@ func TestUpdateCategory(t *testing.T) {
 	categoryId := category.Id
 
 	// Expected & Mock
-	mockCategoryRepository.EXPECT().FindOneById(categoryId).Return(category, nil)
-	mockCategoryRepository.EXPECT().Update(category).Return(nil)
+	mockTxManager.EXPECT().RunInTx(ctx, gomock.Any()).DoAndReturn(runInTxWith(&transaction.Repositories{CategoryRepository: mockCategoryRepository}))
+	mockCategoryRepository.EXPECT().FindOneById(ctx, categoryId).Return(category, nil)
+	mockCategoryRepository.EXPECT().Update(ctx, category).Return(nil)
 
 	// Execute
-	u := NewCategoryUseCase(mockCategoryRepository, mockCategoryCreator)
-	err = u.UpdateCategory(categoryId, "Name1Changed", 101)
+	u := NewCategoryUseCase(mockCategoryRepository, mockCategoryCreator, mockCategorySlugAssigner, mockTxManager)
+	err = u.UpdateCategory(ctx, categoryId, 1, "Name1Changed", 101, nil, "", "")
 
 	// Check
 	if err != nil {
-		t.Errorf("err of u.UpdateCategory(categoryId, 'Name1Changed', 101): Expected %v, but got %v", nil, err)
+		t.Errorf("err of u.UpdateCategory(ctx, categoryId, 'Name1Changed', 101): Expected %v, but got %v", nil, err)
 	}
 }
 
 func TestUpdateCategoryNotFoundError(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
+	ctx := withTestPrincipal(context.TODO())
 
 	// Prepare1
 	mockCategoryRepository := mock_repo.NewMockCategoryRepository(mockCtrl)
 	mockCategoryCreator := mock_service.NewMockCategoryCreator(mockCtrl)
+	mockCategorySlugAssigner := mock_service.NewMockCategorySlugAssigner(mockCtrl)
+	mockTxManager := mock_transaction.NewMockTxManager(mockCtrl)
 	category, err := model.NewCategory("Name1", 1)
 	if err != nil {
 		panic(err)
@@ -104,25 +129,158 @@ func TestUpdateCategoryNotFoundError(t *testing.T) {
 	categoryId := category.Id
 
 	// Expected & Mock
-	mockCategoryRepository.EXPECT().FindOneById(categoryId).Return(nil, nil)
+	mockTxManager.EXPECT().RunInTx(ctx, gomock.Any()).DoAndReturn(runInTxWith(&transaction.Repositories{CategoryRepository: mockCategoryRepository}))
+	mockCategoryRepository.EXPECT().FindOneById(ctx, categoryId).Return(nil, nil)
 
 	// Execute
-	u := NewCategoryUseCase(mockCategoryRepository, mockCategoryCreator)
-	err = u.UpdateCategory(categoryId, "Name1Changed", 101)
+	u := NewCategoryUseCase(mockCategoryRepository, mockCategoryCreator, mockCategorySlugAssigner, mockTxManager)
+	err = u.UpdateCategory(ctx, categoryId, 1, "Name1Changed", 101, nil, "", "")
 
 	// Check
+	if !errs.IsKind(err, errs.NotFound) {
+		t.Errorf("err of u.UpdateCategory: Expected %s, but got %v", errs.NotFound, err)
+	}
 	if err.Error() != "Category to update was not found" {
-		t.Errorf("err.Error() of u.UpdateCategory(categoryId, 'Name1Changed', 101): Expected %s, but got %v", "Category to update was not found", err)
+		t.Errorf("err.Error() of u.UpdateCategory(ctx, categoryId, 'Name1Changed', 101): Expected %s, but got %v", "Category to update was not found", err)
+	}
+}
+
+func TestUpdateCategoryDisplayOrderError(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := withTestPrincipal(context.TODO())
+
+	// Prepare1
+	mockCategoryRepository := mock_repo.NewMockCategoryRepository(mockCtrl)
+	mockCategoryCreator := mock_service.NewMockCategoryCreator(mockCtrl)
+	mockCategorySlugAssigner := mock_service.NewMockCategorySlugAssigner(mockCtrl)
+	mockTxManager := mock_transaction.NewMockTxManager(mockCtrl)
+	category, err := model.NewCategory("Name1", 1)
+	if err != nil {
+		panic(err)
+	}
+	categoryId := category.Id
+
+	// Expected & Mock
+	mockTxManager.EXPECT().RunInTx(ctx, gomock.Any()).DoAndReturn(runInTxWith(&transaction.Repositories{CategoryRepository: mockCategoryRepository}))
+	mockCategoryRepository.EXPECT().FindOneById(ctx, categoryId).Return(category, nil)
+
+	// Execute
+	u := NewCategoryUseCase(mockCategoryRepository, mockCategoryCreator, mockCategorySlugAssigner, mockTxManager)
+	err = u.UpdateCategory(ctx, categoryId, 1, "Name1Changed", 1000, nil, "", "")
+
+	// Check
+	if !errs.IsKind(err, errs.Validation) {
+		t.Errorf("err of u.UpdateCategory(ctx, categoryId, 'Name1Changed', 1000): Expected %s, but got %v", errs.Validation, err)
+	}
+}
+
+func TestUpdateCategoryParentCycleError(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := withTestPrincipal(context.TODO())
+
+	// Prepare
+	mockCategoryRepository := mock_repo.NewMockCategoryRepository(mockCtrl)
+	mockCategoryCreator := mock_service.NewMockCategoryCreator(mockCtrl)
+	mockCategorySlugAssigner := mock_service.NewMockCategorySlugAssigner(mockCtrl)
+	mockTxManager := mock_transaction.NewMockTxManager(mockCtrl)
+	parent, err := model.NewCategory("Parent", 1)
+	if err != nil {
+		panic(err)
+	}
+	child, err := model.NewCategory("Child", 1)
+	if err != nil {
+		panic(err)
+	}
+	child.ParentId = &parent.Id
+
+	// Expected & Mock
+	mockTxManager.EXPECT().RunInTx(ctx, gomock.Any()).DoAndReturn(runInTxWith(&transaction.Repositories{CategoryRepository: mockCategoryRepository}))
+	mockCategoryRepository.EXPECT().FindOneById(ctx, parent.Id).Return(parent, nil)
+	mockCategoryRepository.EXPECT().FindForUpdate(ctx).Return([]*model.Category{parent, child}, nil)
+
+	// Execute
+	u := NewCategoryUseCase(mockCategoryRepository, mockCategoryCreator, mockCategorySlugAssigner, mockTxManager)
+	err = u.UpdateCategory(ctx, parent.Id, 1, "Parent", 1, &child.Id, "", "")
+
+	// Check
+	if !errs.IsKind(err, errs.Validation) {
+		t.Errorf("err of u.UpdateCategory(ctx, parent.Id, 1, 'Parent', 1, &child.Id): Expected %s, but got %v", errs.Validation, err)
+	}
+	if parent.ParentId != nil {
+		t.Errorf("parent.ParentId: Expected %v, but got %v", nil, parent.ParentId)
+	}
+}
+
+func TestPatchCategory(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := withTestPrincipal(context.TODO())
+
+	// Prepare1
+	mockCategoryRepository := mock_repo.NewMockCategoryRepository(mockCtrl)
+	mockCategoryCreator := mock_service.NewMockCategoryCreator(mockCtrl)
+	mockCategorySlugAssigner := mock_service.NewMockCategorySlugAssigner(mockCtrl)
+	mockTxManager := mock_transaction.NewMockTxManager(mockCtrl)
+	category, err := model.NewCategory("Name1", 5)
+	if err != nil {
+		panic(err)
+	}
+	name := "Name1Changed"
+
+	// Expected & Mock
+	mockTxManager.EXPECT().RunInTx(ctx, gomock.Any()).DoAndReturn(runInTxWith(&transaction.Repositories{CategoryRepository: mockCategoryRepository}))
+	mockCategoryRepository.EXPECT().FindOneById(ctx, category.Id).Return(category, nil)
+	mockCategoryRepository.EXPECT().Update(ctx, category).Return(nil)
+
+	// Execute
+	u := NewCategoryUseCase(mockCategoryRepository, mockCategoryCreator, mockCategorySlugAssigner, mockTxManager)
+	patched, err := u.PatchCategory(ctx, category.Id, 1, &CategoryPatch{Name: &name})
+
+	// Check
+	if err != nil {
+		t.Fatalf("err of u.PatchCategory: Expected %v, but got %v", nil, err)
+	}
+	if patched.Name != "Name1Changed" || patched.DisplayOrder != 5 {
+		t.Errorf("patched: Expected %s %d, but got %s %d", "Name1Changed", 5, patched.Name, patched.DisplayOrder)
+	}
+}
+
+func TestDeleteCategoryEditorForbidden(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := withTestRole(context.TODO(), uuid.New(), model.RoleEditor)
+
+	// Prepare
+	mockCategoryRepository := mock_repo.NewMockCategoryRepository(mockCtrl)
+	mockCategoryCreator := mock_service.NewMockCategoryCreator(mockCtrl)
+	mockCategorySlugAssigner := mock_service.NewMockCategorySlugAssigner(mockCtrl)
+	mockTxManager := mock_transaction.NewMockTxManager(mockCtrl)
+
+	// Expected & Mock: 削除しない
+
+	// Execute
+	u := NewCategoryUseCase(mockCategoryRepository, mockCategoryCreator, mockCategorySlugAssigner, mockTxManager)
+	err := u.DeleteCategory(ctx, uuid.New(), 1, nil)
+
+	// Check
+	if missingPermission(err) != model.PermCategoriesManage {
+		t.Errorf("err of u.DeleteCategory: Expected missing %s, but got %v", model.PermCategoriesManage, err)
 	}
 }
 
 func TestDeleteCategory(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
+	ctx := withTestPrincipal(context.TODO())
 
 	// Prepare1
+	mockArticleRepository := mock_repo.NewMockArticleRepository(mockCtrl)
 	mockCategoryRepository := mock_repo.NewMockCategoryRepository(mockCtrl)
 	mockCategoryCreator := mock_service.NewMockCategoryCreator(mockCtrl)
+	mockCategorySlugAssigner := mock_service.NewMockCategorySlugAssigner(mockCtrl)
+	mockTxManager := mock_transaction.NewMockTxManager(mockCtrl)
 	category, err := model.NewCategory("Name1", 1)
 	if err != nil {
 		panic(err)
@@ -130,14 +288,360 @@ func TestDeleteCategory(t *testing.T) {
 	categoryId := category.Id
 
 	// Expected & Mock
-	mockCategoryRepository.EXPECT().Delete(categoryId).Return(nil)
+	mockTxManager.EXPECT().RunInTx(ctx, gomock.Any()).DoAndReturn(runInTxWith(&transaction.Repositories{ArticleRepository: mockArticleRepository, CategoryRepository: mockCategoryRepository}))
+	mockCategoryRepository.EXPECT().FindOneByIdForUpdate(ctx, categoryId).Return(category, nil)
+	mockCategoryRepository.EXPECT().FindForUpdate(ctx).Return([]*model.Category{category}, nil)
+	mockArticleRepository.EXPECT().CountByCategory(ctx, categoryId).Return(&model.CategoryArticleCounts{Trashed: 1}, nil)
+	mockCategoryRepository.EXPECT().Delete(ctx, categoryId, 1).Return(nil)
+
+	// Execute
+	u := NewCategoryUseCase(mockCategoryRepository, mockCategoryCreator, mockCategorySlugAssigner, mockTxManager)
+	err = u.DeleteCategory(ctx, categoryId, 1, nil)
+
+	// Check
+	if err != nil {
+		t.Errorf("err of u.DeleteCategory(ctx, categoryId): Expected %v, but got %v", nil, err)
+	}
+}
+
+func TestDeleteCategoryInUseError(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := withTestPrincipal(context.TODO())
+
+	// Prepare
+	mockArticleRepository := mock_repo.NewMockArticleRepository(mockCtrl)
+	mockCategoryRepository := mock_repo.NewMockCategoryRepository(mockCtrl)
+	mockCategoryCreator := mock_service.NewMockCategoryCreator(mockCtrl)
+	mockCategorySlugAssigner := mock_service.NewMockCategorySlugAssigner(mockCtrl)
+	mockTxManager := mock_transaction.NewMockTxManager(mockCtrl)
+	category, err := model.NewCategory("Name1", 1)
+	if err != nil {
+		panic(err)
+	}
+	counts := &model.CategoryArticleCounts{Published: 2, Draft: 1, Trashed: 1}
+
+	// Expected & Mock
+	mockTxManager.EXPECT().RunInTx(ctx, gomock.Any()).DoAndReturn(runInTxWith(&transaction.Repositories{ArticleRepository: mockArticleRepository, CategoryRepository: mockCategoryRepository}))
+	mockCategoryRepository.EXPECT().FindOneByIdForUpdate(ctx, category.Id).Return(category, nil)
+	mockCategoryRepository.EXPECT().FindForUpdate(ctx).Return([]*model.Category{category}, nil)
+	mockArticleRepository.EXPECT().CountByCategory(ctx, category.Id).Return(counts, nil)
+
+	// Execute
+	u := NewCategoryUseCase(mockCategoryRepository, mockCategoryCreator, mockCategorySlugAssigner, mockTxManager)
+	err = u.DeleteCategory(ctx, category.Id, 1, nil)
+
+	// Check
+	e, ok := errs.As(err)
+	if !ok || e.Kind != errs.Conflict || e.Code != errs.CodeCategoryInUse {
+		t.Fatalf("err of u.DeleteCategory(ctx, category.Id, 1, nil): Expected %s, but got %v", errs.CodeCategoryInUse, err)
+	}
+	if e.Details != counts {
+		t.Errorf("e.Details: Expected %v, but got %v", counts, e.Details)
+	}
+}
+
+func TestDeleteCategoryHasChildrenError(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := withTestPrincipal(context.TODO())
+
+	// Prepare
+	mockArticleRepository := mock_repo.NewMockArticleRepository(mockCtrl)
+	mockCategoryRepository := mock_repo.NewMockCategoryRepository(mockCtrl)
+	mockCategoryCreator := mock_service.NewMockCategoryCreator(mockCtrl)
+	mockCategorySlugAssigner := mock_service.NewMockCategorySlugAssigner(mockCtrl)
+	mockTxManager := mock_transaction.NewMockTxManager(mockCtrl)
+	category, err := model.NewCategory("Name1", 1)
+	if err != nil {
+		panic(err)
+	}
+	child, err := model.NewCategory("Name2", 1)
+	if err != nil {
+		panic(err)
+	}
+	child.ParentId = &category.Id
+	target, err := model.NewCategory("Name3", 1)
+	if err != nil {
+		panic(err)
+	}
+
+	// Expected & Mock: 記事を移す前に止める（ReassignCategory・Deleteは呼ばない）
+	mockTxManager.EXPECT().RunInTx(ctx, gomock.Any()).DoAndReturn(runInTxWith(&transaction.Repositories{ArticleRepository: mockArticleRepository, CategoryRepository: mockCategoryRepository}))
+	mockCategoryRepository.EXPECT().FindOneByIdForUpdate(ctx, category.Id).Return(category, nil)
+	mockCategoryRepository.EXPECT().FindForUpdate(ctx).Return([]*model.Category{category, child, target}, nil)
+
+	// Execute
+	u := NewCategoryUseCase(mockCategoryRepository, mockCategoryCreator, mockCategorySlugAssigner, mockTxManager)
+	err = u.DeleteCategory(ctx, category.Id, 1, &target.Id)
+
+	// Check
+	e, ok := errs.As(err)
+	if !ok || e.Kind != errs.Conflict || e.Code != errs.CodeCategoryHasChildren {
+		t.Errorf("err of u.DeleteCategory(ctx, category.Id, 1, &target.Id): Expected %s, but got %v", errs.CodeCategoryHasChildren, err)
+	}
+}
+
+func TestDeleteCategoryReassign(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := withTestPrincipal(context.TODO())
+
+	// Prepare
+	mockArticleRepository := mock_repo.NewMockArticleRepository(mockCtrl)
+	mockCategoryRepository := mock_repo.NewMockCategoryRepository(mockCtrl)
+	mockCategoryCreator := mock_service.NewMockCategoryCreator(mockCtrl)
+	mockCategorySlugAssigner := mock_service.NewMockCategorySlugAssigner(mockCtrl)
+	mockTxManager := mock_transaction.NewMockTxManager(mockCtrl)
+	category, err := model.NewCategory("Name1", 1)
+	if err != nil {
+		panic(err)
+	}
+	target, err := model.NewCategory("Name2", 1)
+	if err != nil {
+		panic(err)
+	}
+
+	// Expected & Mock
+	mockTxManager.EXPECT().RunInTx(ctx, gomock.Any()).DoAndReturn(runInTxWith(&transaction.Repositories{ArticleRepository: mockArticleRepository, CategoryRepository: mockCategoryRepository}))
+	mockCategoryRepository.EXPECT().FindOneByIdForUpdate(ctx, category.Id).Return(category, nil)
+	mockCategoryRepository.EXPECT().FindForUpdate(ctx).Return([]*model.Category{category, target}, nil)
+	mockCategoryRepository.EXPECT().FindOneByIdForUpdate(ctx, target.Id).Return(target, nil)
+	gomock.InOrder(
+		mockArticleRepository.EXPECT().ReassignCategory(ctx, category.Id, target.Id).Return(int64(3), nil),
+		mockCategoryRepository.EXPECT().Delete(ctx, category.Id, 1).Return(nil),
+	)
+
+	// Execute
+	u := NewCategoryUseCase(mockCategoryRepository, mockCategoryCreator, mockCategorySlugAssigner, mockTxManager)
+	err = u.DeleteCategory(ctx, category.Id, 1, &target.Id)
+
+	// Check
+	if err != nil {
+		t.Errorf("err of u.DeleteCategory(ctx, category.Id, 1, &target.Id): Expected %v, but got %v", nil, err)
+	}
+}
+
+func TestDeleteCategoryReassignToSelfError(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := withTestPrincipal(context.TODO())
+
+	// Prepare
+	mockArticleRepository := mock_repo.NewMockArticleRepository(mockCtrl)
+	mockCategoryRepository := mock_repo.NewMockCategoryRepository(mockCtrl)
+	mockCategoryCreator := mock_service.NewMockCategoryCreator(mockCtrl)
+	mockCategorySlugAssigner := mock_service.NewMockCategorySlugAssigner(mockCtrl)
+	mockTxManager := mock_transaction.NewMockTxManager(mockCtrl)
+	category, err := model.NewCategory("Name1", 1)
+	if err != nil {
+		panic(err)
+	}
+
+	// Expected & Mock
+	mockTxManager.EXPECT().RunInTx(ctx, gomock.Any()).DoAndReturn(runInTxWith(&transaction.Repositories{ArticleRepository: mockArticleRepository, CategoryRepository: mockCategoryRepository}))
+	mockCategoryRepository.EXPECT().FindOneByIdForUpdate(ctx, category.Id).Return(category, nil)
+	mockCategoryRepository.EXPECT().FindForUpdate(ctx).Return([]*model.Category{category}, nil)
+
+	// Execute
+	u := NewCategoryUseCase(mockCategoryRepository, mockCategoryCreator, mockCategorySlugAssigner, mockTxManager)
+	err = u.DeleteCategory(ctx, category.Id, 1, &category.Id)
+
+	// Check
+	if !errs.IsKind(err, errs.Validation) {
+		t.Errorf("err of u.DeleteCategory(ctx, category.Id, 1, &category.Id): Expected %s, but got %v", errs.Validation, err)
+	}
+}
+
+func TestReorderCategories(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := withTestPrincipal(context.TODO())
+
+	// Prepare
+	mockCategoryRepository := mock_repo.NewMockCategoryRepository(mockCtrl)
+	mockCategoryCreator := mock_service.NewMockCategoryCreator(mockCtrl)
+	mockCategorySlugAssigner := mock_service.NewMockCategorySlugAssigner(mockCtrl)
+	mockTxManager := mock_transaction.NewMockTxManager(mockCtrl)
+	category1, err := model.NewCategory("Name1", 1)
+	if err != nil {
+		panic(err)
+	}
+	category2, err := model.NewCategory("Name2", 2)
+	if err != nil {
+		panic(err)
+	}
+
+	// Expected & Mock
+	mockTxManager.EXPECT().RunInTx(ctx, gomock.Any()).DoAndReturn(runInTxWith(&transaction.Repositories{CategoryRepository: mockCategoryRepository}))
+	gomock.InOrder(
+		mockCategoryRepository.EXPECT().Find(ctx).Return([]*model.Category{category1, category2}, nil),
+		mockCategoryRepository.EXPECT().Update(ctx, category2).Return(nil),
+		mockCategoryRepository.EXPECT().Update(ctx, category1).Return(nil),
+		mockCategoryRepository.EXPECT().Find(ctx).Return([]*model.Category{category2, category1}, nil),
+	)
+
+	// Execute
+	u := NewCategoryUseCase(mockCategoryRepository, mockCategoryCreator, mockCategorySlugAssigner, mockTxManager)
+	categories, err := u.ReorderCategories(ctx, []uuid.UUID{category2.Id, category1.Id})
+
+	// Check
+	if err != nil {
+		t.Fatalf("err of u.ReorderCategories: Expected %v, but got %v", nil, err)
+	}
+	if len(categories) != 2 || categories[0] != category2 || categories[1] != category1 {
+		t.Errorf("categories: Expected %v, but got %v", []string{"Name2", "Name1"}, categories)
+	}
+	if category2.DisplayOrder != 1 || category1.DisplayOrder != 2 {
+		t.Errorf("DisplayOrder: Expected %v, but got %v", []int{2, 1}, []int{category1.DisplayOrder, category2.DisplayOrder})
+	}
+}
+
+func TestGetCategory(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := withTestPrincipal(context.TODO())
+
+	// Prepare
+	mockCategoryRepository := mock_repo.NewMockCategoryRepository(mockCtrl)
+	mockCategoryCreator := mock_service.NewMockCategoryCreator(mockCtrl)
+	mockCategorySlugAssigner := mock_service.NewMockCategorySlugAssigner(mockCtrl)
+	mockTxManager := mock_transaction.NewMockTxManager(mockCtrl)
+	category, err := model.NewCategory("Name1", 1)
+	if err != nil {
+		panic(err)
+	}
+	stats := &model.CategoryStats{ArticleCounts: model.CategoryArticleCounts{Published: 1}}
+
+	// Expected & Mock
+	mockCategoryRepository.EXPECT().FindOneById(ctx, category.Id).Return(category, nil)
+	mockCategoryRepository.EXPECT().FindStats(ctx, []uuid.UUID{category.Id}).Return(map[uuid.UUID]*model.CategoryStats{category.Id: stats}, nil)
 
 	// Execute
-	u := NewCategoryUseCase(mockCategoryRepository, mockCategoryCreator)
-	err = u.DeleteCategory(categoryId)
+	u := NewCategoryUseCase(mockCategoryRepository, mockCategoryCreator, mockCategorySlugAssigner, mockTxManager)
+	actual, err := u.GetCategory(ctx, category.Id)
 
 	// Check
 	if err != nil {
-		t.Errorf("err of u.DeleteCategory(categoryId): Expected %v, but got %v", nil, err)
+		t.Fatalf("err of u.GetCategory(ctx, category.Id): Expected %v, but got %v", nil, err)
 	}
-}
\ No newline at end of file
+	if actual.Stats != stats {
+		t.Errorf("actual.Stats: Expected %v, but got %v", stats, actual.Stats)
+	}
+}
+
+func TestGetCategoryStatsWithoutPermission(t *testing.T) {
+	apiKeyId := uuid.New()
+	tests := []struct {
+		name string
+		ctx context.Context
+		expected model.CategoryArticleCounts
+	}{
+		{"未認証", context.TODO(), model.CategoryArticleCounts{Published: 1}},
+		{"categories:readのないAPIキー", auth.WithPrincipal(context.TODO(), &auth.Principal{UserId: uuid.New(), Role: model.RoleAdmin, ApiKeyId: &apiKeyId, Scopes: []model.Permission{model.PermArticlesWrite}}), model.CategoryArticleCounts{Published: 1}},
+		{"categories:readのあるAPIキー", auth.WithPrincipal(context.TODO(), &auth.Principal{UserId: uuid.New(), Role: model.RoleWriter, ApiKeyId: &apiKeyId, Scopes: []model.Permission{model.PermCategoriesRead}}), model.CategoryArticleCounts{Published: 1, Scheduled: 2, Draft: 3, Trashed: 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			// Prepare
+			mockCategoryRepository := mock_repo.NewMockCategoryRepository(mockCtrl)
+			mockCategoryCreator := mock_service.NewMockCategoryCreator(mockCtrl)
+			mockCategorySlugAssigner := mock_service.NewMockCategorySlugAssigner(mockCtrl)
+			mockTxManager := mock_transaction.NewMockTxManager(mockCtrl)
+			category, err := model.NewCategory("Name1", 1)
+			if err != nil {
+				panic(err)
+			}
+			latest := time.Now()
+			stats := &model.CategoryStats{ArticleCounts: model.CategoryArticleCounts{Published: 1, Scheduled: 2, Draft: 3, Trashed: 4}, LatestPublishedAt: &latest}
+
+			// Expected & Mock
+			mockCategoryRepository.EXPECT().FindOneById(tt.ctx, category.Id).Return(category, nil)
+			mockCategoryRepository.EXPECT().FindStats(tt.ctx, []uuid.UUID{category.Id}).Return(map[uuid.UUID]*model.CategoryStats{category.Id: stats}, nil)
+
+			// Execute
+			u := NewCategoryUseCase(mockCategoryRepository, mockCategoryCreator, mockCategorySlugAssigner, mockTxManager)
+			actual, err := u.GetCategory(tt.ctx, category.Id)
+
+			// Check
+			if err != nil {
+				t.Fatalf("err of u.GetCategory(ctx, category.Id): Expected %v, but got %v", nil, err)
+			}
+			if actual.Stats.ArticleCounts != tt.expected {
+				t.Errorf("actual.Stats.ArticleCounts: Expected %v, but got %v", tt.expected, actual.Stats.ArticleCounts)
+			}
+			if actual.Stats.LatestPublishedAt != &latest {
+				t.Errorf("actual.Stats.LatestPublishedAt: Expected %v, but got %v", latest, actual.Stats.LatestPublishedAt)
+			}
+		})
+	}
+}
+
+func TestGetCategoryNotFoundError(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := withTestPrincipal(context.TODO())
+
+	// Prepare
+	mockCategoryRepository := mock_repo.NewMockCategoryRepository(mockCtrl)
+	mockCategoryCreator := mock_service.NewMockCategoryCreator(mockCtrl)
+	mockCategorySlugAssigner := mock_service.NewMockCategorySlugAssigner(mockCtrl)
+	mockTxManager := mock_transaction.NewMockTxManager(mockCtrl)
+	categoryId := uuid.New()
+
+	// Expected & Mock
+	mockCategoryRepository.EXPECT().FindOneById(ctx, categoryId).Return(nil, nil)
+
+	// Execute
+	u := NewCategoryUseCase(mockCategoryRepository, mockCategoryCreator, mockCategorySlugAssigner, mockTxManager)
+	actual, err := u.GetCategory(ctx, categoryId)
+
+	// Check
+	if !errs.IsKind(err, errs.NotFound) {
+		t.Errorf("err of u.GetCategory(ctx, categoryId): Expected %s, but got %v", errs.NotFound, err)
+	}
+	if actual != nil {
+		t.Errorf("actual: Expected %v, but got %v", nil, actual)
+	}
+}
+
+func TestPatchCategorySlug(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := withTestPrincipal(context.TODO())
+
+	// Prepare
+	mockCategoryRepository := mock_repo.NewMockCategoryRepository(mockCtrl)
+	mockCategoryCreator := mock_service.NewMockCategoryCreator(mockCtrl)
+	mockCategorySlugAssigner := mock_service.NewMockCategorySlugAssigner(mockCtrl)
+	mockTxManager := mock_transaction.NewMockTxManager(mockCtrl)
+	category, err := model.NewCategory("Name1", 1)
+	if err != nil {
+		panic(err)
+	}
+	name := "Name1Changed"
+	slug := "renamed"
+
+	// Expected & Mock
+	mockTxManager.EXPECT().RunInTx(ctx, gomock.Any()).DoAndReturn(runInTxWith(&transaction.Repositories{CategoryRepository: mockCategoryRepository}))
+	mockCategoryRepository.EXPECT().FindOneById(ctx, category.Id).Return(category, nil)
+	mockCategorySlugAssigner.EXPECT().Assign(ctx, mockCategoryRepository, category, slug).DoAndReturn(func(ctx context.Context, r repository.CategoryRepository, c *model.Category, slug string) error {
+		return c.SetSlug(slug)
+	})
+	mockCategoryRepository.EXPECT().Update(ctx, category).Return(nil)
+
+	// Execute
+	u := NewCategoryUseCase(mockCategoryRepository, mockCategoryCreator, mockCategorySlugAssigner, mockTxManager)
+	actual, err := u.PatchCategory(ctx, category.Id, 1, &CategoryPatch{Name: &name, Slug: &slug})
+
+	// Check
+	if err != nil {
+		t.Fatalf("err of u.PatchCategory: Expected %v, but got %v", nil, err)
+	}
+	if actual.Name != name || actual.Slug != slug {
+		t.Errorf("actual: Expected %s/%s, but got %s/%s", name, slug, actual.Name, actual.Slug)
+	}
+}
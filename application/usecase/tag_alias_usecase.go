@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+	"github.com/momonoki1990/tech-blog-api/domain/repository"
+)
+
+// tag_aliasesの変更を再起動せずに反映する
+type TagAliasUseCase interface {
+	// 別名を読み込み直し、記事に付けるタグ名の規則の別名を置き換える（正規化の設定は変えない）
+	ReloadTagAliases(ctx context.Context) error
+}
+
+type tagAliasUseCase struct {
+	repository.TagAliasRepository
+	tagNameRules *model.TagNameRules
+}
+
+func NewTagAliasUseCase(r repository.TagAliasRepository, rules *model.TagNameRules) TagAliasUseCase {
+	return &tagAliasUseCase{r, rules}
+}
+
+func (u *tagAliasUseCase) ReloadTagAliases(ctx context.Context) error {
+	aliases, err := u.TagAliasRepository.Find(ctx)
+	if err != nil {
+		return err
+	}
+	u.tagNameRules.SetAliases(aliases)
+	return nil
+}
@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+	mock_repo "github.com/momonoki1990/tech-blog-api/infra/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestReloadTagAliases(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := context.TODO()
+
+	// Prepare
+	mockTagAliasRepository := mock_repo.NewMockTagAliasRepository(mockCtrl)
+	rules := model.NewTagNameRules(nil, []*model.TagAlias{{Alias: "golang", TagName: "Go"}})
+
+	// Expected & Mock: 起動後に別名が追加・削除された
+	mockTagAliasRepository.EXPECT().Find(ctx).Return([]*model.TagAlias{{Alias: "js", TagName: "JavaScript"}}, nil)
+
+	// Execute
+	u := NewTagAliasUseCase(mockTagAliasRepository, rules)
+	err := u.ReloadTagAliases(ctx)
+
+	// Check
+	if err != nil {
+		t.Fatalf("err of u.ReloadTagAliases: Expected %v, but got %v", nil, err)
+	}
+	if got := rules.Canonical("js"); got != "JavaScript" {
+		t.Errorf("rules.Canonical(js): Expected %s, but got %s", "JavaScript", got)
+	}
+	if got := rules.Canonical("golang"); got != "golang" {
+		t.Errorf("rules.Canonical(golang): Expected %s, but got %s", "golang", got)
+	}
+}
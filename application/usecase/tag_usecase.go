@@ -0,0 +1,197 @@
+package usecase
+
+import (
+	"context"
+	"sort"
+
+	"github.com/momonoki1990/tech-blog-api/application/auth"
+	"github.com/momonoki1990/tech-blog-api/application/transaction"
+	"github.com/momonoki1990/tech-blog-api/domain/errs"
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+	"github.com/momonoki1990/tech-blog-api/domain/repository"
+)
+
+type TagUseCase interface {
+	// 公開前の記事を読めない送り手には、公開済みの記事があるタグだけを、公開済みの記事の件数で返す
+	GetTagList(ctx context.Context, sortKey repository.TagSortKey) ([]*model.TagUsage, error)
+	// 改名先のタグが既にある場合はConflict（統合を使う）
+	RenameTag(ctx context.Context, name string, newName string) (*model.TagUsage, error)
+	// sourcesのタグをすべてtargetに統合する（targetがなければ作る）
+	MergeTags(ctx context.Context, sources []string, target string) (*model.TagUsage, error)
+	// 既存のタグを現在の規則（正規化・別名）で付け替え、変更内容を返す。dryRunなら変更しない
+	// コマンド（cmd/normalize-tags）からだけ呼ぶので、権限は確かめない
+	NormalizeTags(ctx context.Context, dryRun bool) ([]*TagNameChange, error)
+}
+
+type TagNameChange struct {
+	From string
+	To string
+}
+
+type tagUseCase struct {
+	repository.TagRepository
+	transaction.TxManager
+	tagNameRules *model.TagNameRules
+}
+
+func NewTagUseCase(tr repository.TagRepository, tm transaction.TxManager, rules *model.TagNameRules) TagUseCase {
+	return &tagUseCase{tr, tm, rules}
+}
+
+func (u *tagUseCase) GetTagList(ctx context.Context, sortKey repository.TagSortKey) ([]*model.TagUsage, error) {
+	tags, err := u.TagRepository.Find(ctx, sortKey)
+	if err != nil {
+		return nil, err
+	}
+	if auth.Can(ctx, model.PermArticlesReadDrafts) {
+		return tags, nil
+	}
+	// 公開前の記事を読めなければ、公開済みの記事があるタグだけをその件数で返す（公開前の記事だけのタグの名前も知らせない）
+	public := make([]*model.TagUsage, 0, len(tags))
+	for _, v := range tags {
+		if v.PublishedCount == nil || *v.PublishedCount == 0 {
+			continue
+		}
+		public = append(public, &model.TagUsage{Name: v.Name, ArticleCount: *v.PublishedCount})
+	}
+	if sortKey == repository.SortTagsByCount {
+		sort.SliceStable(public, func(i, j int) bool {
+			if public[i].ArticleCount != public[j].ArticleCount {
+				return public[i].ArticleCount > public[j].ArticleCount
+			}
+			return public[i].Name < public[j].Name
+		})
+	}
+	return public, nil
+}
+
+func (u *tagUseCase) RenameTag(ctx context.Context, name string, newName string) (*model.TagUsage, error) {
+	if err := auth.Require(ctx, model.PermTagsManage); err != nil {
+		return nil, err
+	}
+	// 記事に付けるときと同じ名前にしておく（別名には改名できない）
+	newName = u.tagNameRules.Canonical(newName)
+	if err := model.ValidateTagName("name", newName); err != nil {
+		return nil, err
+	}
+	var tag *model.TagUsage
+	err := u.TxManager.RunInTx(ctx, func(r *transaction.Repositories) error {
+		if _, err := findTag(ctx, r.TagRepository, name); err != nil {
+			return err
+		}
+		if newName != name {
+			found, err := r.TagRepository.FindOneByName(ctx, newName)
+			if err != nil {
+				return err
+			}
+			// 大文字・小文字だけの改名などで同じタグが見つかる場合は除く
+			if found != nil && found.Name != name {
+				return errs.NewConflict(errs.CodeTagNameConflict, "Tag name is already in use. Merge the tags instead")
+			}
+			if err := r.TagRepository.Merge(ctx, name, newName); err != nil {
+				return err
+			}
+		}
+		var err error
+		tag, err = findTag(ctx, r.TagRepository, newName)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tag, nil
+}
+
+func (u *tagUseCase) MergeTags(ctx context.Context, sources []string, target string) (*model.TagUsage, error) {
+	if err := auth.Require(ctx, model.PermTagsManage); err != nil {
+		return nil, err
+	}
+	target = u.tagNameRules.Canonical(target)
+	if err := model.ValidateTagName("target", target); err != nil {
+		return nil, err
+	}
+	if len(sources) == 0 {
+		message := "sources is required"
+		return nil, errs.NewValidation(errs.CodeValidationFailed, message, errs.FieldError{Field: "sources", Message: message})
+	}
+	var tag *model.TagUsage
+	err := u.TxManager.RunInTx(ctx, func(r *transaction.Repositories) error {
+		// 1つでも見つからなければ何も統合しない
+		for _, v := range sources {
+			if _, err := findTag(ctx, r.TagRepository, v); err != nil {
+				return err
+			}
+		}
+		for _, v := range sources {
+			if err := r.TagRepository.Merge(ctx, v, target); err != nil {
+				return err
+			}
+		}
+		var err error
+		tag, err = findTag(ctx, r.TagRepository, target)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tag, nil
+}
+
+func (u *tagUseCase) NormalizeTags(ctx context.Context, dryRun bool) ([]*TagNameChange, error) {
+	changes := []*TagNameChange{}
+	err := u.TxManager.RunInTx(ctx, func(r *transaction.Repositories) error {
+		tags, err := r.TagRepository.Find(ctx, repository.SortTagsByName)
+		if err != nil {
+			return err
+		}
+		for _, v := range tags {
+			canonical := u.tagNameRules.Canonical(v.Name)
+			if canonical == v.Name {
+				continue
+			}
+			// 正規化すると空になるなど、記事に付けられない名前にはしない
+			if err := model.ValidateTagName("name", canonical); err != nil {
+				return err
+			}
+			changes = append(changes, &TagNameChange{From: v.Name, To: canonical})
+			if dryRun {
+				continue
+			}
+			if err := r.TagRepository.Merge(ctx, v.Name, canonical); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+// 別名を読み込み、namesの順に正規化する規則を作る（namesが空なら既定の正規化）
+func LoadTagNameRules(ctx context.Context, r repository.TagAliasRepository, names []string) (*model.TagNameRules, error) {
+	if len(names) == 0 {
+		names = model.DefaultTagNormalizerNames
+	}
+	normalizers, err := model.TagNormalizersByNames(names)
+	if err != nil {
+		return nil, err
+	}
+	aliases, err := r.Find(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return model.NewTagNameRules(normalizers, aliases), nil
+}
+
+func findTag(ctx context.Context, r repository.TagRepository, name string) (*model.TagUsage, error) {
+	tag, err := r.FindOneByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if tag == nil {
+		return nil, errs.NewNotFound(errs.CodeTagNotFound, "Tag was not found")
+	}
+	return tag, nil
+}
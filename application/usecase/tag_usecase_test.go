@@ -0,0 +1,253 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/momonoki1990/tech-blog-api/application/transaction"
+	mock_transaction "github.com/momonoki1990/tech-blog-api/application/transaction/mock"
+	"github.com/momonoki1990/tech-blog-api/domain/errs"
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+	"github.com/momonoki1990/tech-blog-api/domain/repository"
+	mock_repo "github.com/momonoki1990/tech-blog-api/infra/mock"
+	"go.uber.org/mock/gomock"
+)
+
+// "名前 記事数/公開済みの記事数"（公開済みの記事数を返さない場合は"-"）
+func tagUsageString(v *model.TagUsage) string {
+	published := "-"
+	if v.PublishedCount != nil {
+		published = fmt.Sprint(*v.PublishedCount)
+	}
+	return fmt.Sprintf("%s %d/%s", v.Name, v.ArticleCount, published)
+}
+
+func TestGetTagList(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	// Prepare
+	mockTagRepository := mock_repo.NewMockTagRepository(mockCtrl)
+	mockTxManager := mock_transaction.NewMockTxManager(mockCtrl)
+	// 記事の多い順（golangは下書きが3件、unreleasedは下書きにだけ付いている）
+	found := func() []*model.TagUsage {
+		count := func(n int) *int { return &n }
+		return []*model.TagUsage{
+			{Name: "golang", ArticleCount: 4, PublishedCount: count(1)},
+			{Name: "rust", ArticleCount: 2, PublishedCount: count(2)},
+			{Name: "docker", ArticleCount: 2, PublishedCount: count(1)},
+			{Name: "unreleased", ArticleCount: 1, PublishedCount: count(0)},
+		}
+	}
+	tests := []struct {
+		name string
+		ctx context.Context
+		expected []string
+	}{
+		{
+			name: "公開前の記事を読める場合はすべてのタグとすべての記事を数える",
+			ctx: withTestRole(context.TODO(), uuid.New(), model.RoleWriter),
+			expected: []string{"golang 4/1", "rust 2/2", "docker 2/1", "unreleased 1/0"},
+		},
+		{
+			name: "未ログインの場合は公開済みの記事があるタグだけを、その件数で並べ直して返す",
+			ctx: context.TODO(),
+			expected: []string{"rust 2/-", "docker 1/-", "golang 1/-"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Expected & Mock
+			mockTagRepository.EXPECT().Find(tt.ctx, repository.SortTagsByCount).Return(found(), nil)
+
+			// Execute
+			u := NewTagUseCase(mockTagRepository, mockTxManager, model.NewTagNameRules(nil, nil))
+			actual, err := u.GetTagList(tt.ctx, repository.SortTagsByCount)
+
+			// Check
+			if err != nil {
+				t.Fatalf("err of u.GetTagList: Expected %v, but got %v", nil, err)
+			}
+			actualStrings := make([]string, 0, len(actual))
+			for _, v := range actual {
+				actualStrings = append(actualStrings, tagUsageString(v))
+			}
+			if fmt.Sprint(actualStrings) != fmt.Sprint(tt.expected) {
+				t.Errorf("actual: Expected %v, but got %v", tt.expected, actualStrings)
+			}
+		})
+	}
+}
+
+func TestRenameTag(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := withTestPrincipal(context.TODO())
+
+	// Prepare
+	mockTagRepository := mock_repo.NewMockTagRepository(mockCtrl)
+	mockTxManager := mock_transaction.NewMockTxManager(mockCtrl)
+
+	// Expected & Mock
+	mockTxManager.EXPECT().RunInTx(ctx, gomock.Any()).DoAndReturn(runInTxWith(&transaction.Repositories{TagRepository: mockTagRepository}))
+	mockTagRepository.EXPECT().FindOneByName(ctx, "golnag").Return(&model.TagUsage{Name: "golnag", ArticleCount: 2}, nil)
+	mockTagRepository.EXPECT().FindOneByName(ctx, "golang").Return(nil, nil)
+	mockTagRepository.EXPECT().Merge(ctx, "golnag", "golang").Return(nil)
+	mockTagRepository.EXPECT().FindOneByName(ctx, "golang").Return(&model.TagUsage{Name: "golang", ArticleCount: 2}, nil)
+
+	// Execute
+	u := NewTagUseCase(mockTagRepository, mockTxManager, model.NewTagNameRules(nil, nil))
+	tag, err := u.RenameTag(ctx, "golnag", "golang")
+
+	// Check
+	if err != nil {
+		t.Fatalf("err of u.RenameTag: Expected %v, but got %v", nil, err)
+	}
+	if tag.Name != "golang" || tag.ArticleCount != 2 {
+		t.Errorf("tag: Expected %s %d, but got %s %d", "golang", 2, tag.Name, tag.ArticleCount)
+	}
+}
+
+func TestRenameTagConflictError(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := withTestPrincipal(context.TODO())
+
+	// Prepare
+	mockTagRepository := mock_repo.NewMockTagRepository(mockCtrl)
+	mockTxManager := mock_transaction.NewMockTxManager(mockCtrl)
+
+	// Expected & Mock: 既にあるタグ名には改名しない（統合を使う）
+	mockTxManager.EXPECT().RunInTx(ctx, gomock.Any()).DoAndReturn(runInTxWith(&transaction.Repositories{TagRepository: mockTagRepository}))
+	mockTagRepository.EXPECT().FindOneByName(ctx, "golang").Return(&model.TagUsage{Name: "golang", ArticleCount: 2}, nil)
+	mockTagRepository.EXPECT().FindOneByName(ctx, "Go").Return(&model.TagUsage{Name: "Go", ArticleCount: 1}, nil)
+
+	// Execute
+	u := NewTagUseCase(mockTagRepository, mockTxManager, model.NewTagNameRules(nil, nil))
+	tag, err := u.RenameTag(ctx, "golang", "Go")
+
+	// Check
+	if tag != nil {
+		t.Errorf("tag: Expected %v, but got %v", nil, tag)
+	}
+	e, ok := errs.As(err)
+	if !ok || e.Kind != errs.Conflict || e.Code != errs.CodeTagNameConflict {
+		t.Errorf("err of u.RenameTag: Expected %s, but got %v", errs.CodeTagNameConflict, err)
+	}
+}
+
+func TestRenameTagValidationError(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := withTestPrincipal(context.TODO())
+
+	// Prepare
+	mockTagRepository := mock_repo.NewMockTagRepository(mockCtrl)
+	mockTxManager := mock_transaction.NewMockTxManager(mockCtrl)
+
+	// Execute
+	u := NewTagUseCase(mockTagRepository, mockTxManager, model.NewTagNameRules(nil, nil))
+	_, err := u.RenameTag(ctx, "golang", "go/lang")
+
+	// Check
+	e, ok := errs.As(err)
+	if !ok || e.Kind != errs.Validation || len(e.Fields) != 1 || e.Fields[0].Field != "name" {
+		t.Errorf("err of u.RenameTag: Expected %s of name, but got %v", errs.Validation, err)
+	}
+}
+
+func TestMergeTags(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := withTestPrincipal(context.TODO())
+
+	// Prepare
+	mockTagRepository := mock_repo.NewMockTagRepository(mockCtrl)
+	mockTxManager := mock_transaction.NewMockTxManager(mockCtrl)
+
+	// Expected & Mock
+	mockTxManager.EXPECT().RunInTx(ctx, gomock.Any()).DoAndReturn(runInTxWith(&transaction.Repositories{TagRepository: mockTagRepository}))
+	mockTagRepository.EXPECT().FindOneByName(ctx, "golang").Return(&model.TagUsage{Name: "golang", ArticleCount: 2}, nil)
+	mockTagRepository.EXPECT().FindOneByName(ctx, "Golang").Return(&model.TagUsage{Name: "Golang", ArticleCount: 1}, nil)
+	gomock.InOrder(
+		mockTagRepository.EXPECT().Merge(ctx, "golang", "Go").Return(nil),
+		mockTagRepository.EXPECT().Merge(ctx, "Golang", "Go").Return(nil),
+	)
+	mockTagRepository.EXPECT().FindOneByName(ctx, "Go").Return(&model.TagUsage{Name: "Go", ArticleCount: 4}, nil)
+
+	// Execute
+	u := NewTagUseCase(mockTagRepository, mockTxManager, model.NewTagNameRules(nil, nil))
+	tag, err := u.MergeTags(ctx, []string{"golang", "Golang"}, "Go")
+
+	// Check
+	if err != nil {
+		t.Fatalf("err of u.MergeTags: Expected %v, but got %v", nil, err)
+	}
+	if tag.Name != "Go" || tag.ArticleCount != 4 {
+		t.Errorf("tag: Expected %s %d, but got %s %d", "Go", 4, tag.Name, tag.ArticleCount)
+	}
+}
+
+func TestMergeTagsNotFoundError(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := withTestPrincipal(context.TODO())
+
+	// Prepare
+	mockTagRepository := mock_repo.NewMockTagRepository(mockCtrl)
+	mockTxManager := mock_transaction.NewMockTxManager(mockCtrl)
+
+	// Expected & Mock: 1つでも見つからなければ何も統合しない
+	mockTxManager.EXPECT().RunInTx(ctx, gomock.Any()).DoAndReturn(runInTxWith(&transaction.Repositories{TagRepository: mockTagRepository}))
+	mockTagRepository.EXPECT().FindOneByName(ctx, "golang").Return(&model.TagUsage{Name: "golang", ArticleCount: 2}, nil)
+	mockTagRepository.EXPECT().FindOneByName(ctx, "Golang").Return(nil, nil)
+
+	// Execute
+	u := NewTagUseCase(mockTagRepository, mockTxManager, model.NewTagNameRules(nil, nil))
+	_, err := u.MergeTags(ctx, []string{"golang", "Golang"}, "Go")
+
+	// Check
+	e, ok := errs.As(err)
+	if !ok || e.Kind != errs.NotFound || e.Code != errs.CodeTagNotFound {
+		t.Errorf("err of u.MergeTags: Expected %s, but got %v", errs.CodeTagNotFound, err)
+	}
+}
+
+func TestNormalizeTags(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := withTestPrincipal(context.TODO())
+
+	// Prepare
+	mockTagRepository := mock_repo.NewMockTagRepository(mockCtrl)
+	mockTagAliasRepository := mock_repo.NewMockTagAliasRepository(mockCtrl)
+	mockTxManager := mock_transaction.NewMockTxManager(mockCtrl)
+	mockTagAliasRepository.EXPECT().Find(ctx).Return([]*model.TagAlias{{Alias: "golang", TagName: "Go"}}, nil)
+	rules, err := LoadTagNameRules(ctx, mockTagAliasRepository, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	// Expected & Mock: 正規化しても変わらないタグは付け替えない
+	mockTxManager.EXPECT().RunInTx(ctx, gomock.Any()).DoAndReturn(runInTxWith(&transaction.Repositories{TagRepository: mockTagRepository}))
+	mockTagRepository.EXPECT().Find(ctx, repository.SortTagsByName).Return([]*model.TagUsage{{Name: "Go"}, {Name: "Golang"}, {Name: "ＲＵＳＴ"}, {Name: "rust"}}, nil)
+	gomock.InOrder(
+		mockTagRepository.EXPECT().Merge(ctx, "Golang", "Go").Return(nil),
+		mockTagRepository.EXPECT().Merge(ctx, "ＲＵＳＴ", "rust").Return(nil),
+	)
+
+	// Execute
+	u := NewTagUseCase(mockTagRepository, mockTxManager, rules)
+	changes, err := u.NormalizeTags(ctx, false)
+
+	// Check
+	if err != nil {
+		t.Fatalf("err of u.NormalizeTags: Expected %v, but got %v", nil, err)
+	}
+	if len(changes) != 2 || changes[0].To != "Go" || changes[1].To != "rust" {
+		t.Errorf("changes: Expected %s, but got %v", "Golang -> Go, ＲＵＳＴ -> rust", changes)
+	}
+}
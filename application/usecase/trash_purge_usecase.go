@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/momonoki1990/tech-blog-api/application/transaction"
+)
+
+// 保持期間を過ぎたゴミ箱の記事・カテゴリの完全削除（定期実行される）
+type TrashPurgeUseCase interface {
+	PurgeTrash(ctx context.Context) (articles int64, categories int64, err error)
+}
+
+type trashPurgeUseCase struct {
+	transaction.TxManager
+	retention time.Duration
+	// テストでは固定の時刻を返す関数を渡す
+	now func() time.Time
+}
+
+func NewTrashPurgeUseCase(tm transaction.TxManager, retention time.Duration, now func() time.Time) TrashPurgeUseCase {
+	return &trashPurgeUseCase{tm, retention, now}
+}
+
+func (u *trashPurgeUseCase) PurgeTrash(ctx context.Context) (int64, int64, error) {
+	before := u.now().Add(-u.retention)
+	var articles, categories int64
+	err := u.TxManager.RunInTx(ctx, func(r *transaction.Repositories) error {
+		var err error
+		// 記事を先に消して、記事がなくなったカテゴリも同じ回で消せるようにする
+		articles, err = r.ArticleRepository.Purge(ctx, before)
+		if err != nil {
+			return err
+		}
+		categories, err = r.CategoryRepository.Purge(ctx, before)
+		return err
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	return articles, categories, nil
+}
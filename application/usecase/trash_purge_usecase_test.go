@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/momonoki1990/tech-blog-api/application/transaction"
+	mock_transaction "github.com/momonoki1990/tech-blog-api/application/transaction/mock"
+	mock_repo "github.com/momonoki1990/tech-blog-api/infra/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPurgeTrash(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := context.TODO()
+
+	// Prepare
+	mockArticleRepository := mock_repo.NewMockArticleRepository(mockCtrl)
+	mockCategoryRepository := mock_repo.NewMockCategoryRepository(mockCtrl)
+	mockTxManager := mock_transaction.NewMockTxManager(mockCtrl)
+	now := time.Date(2023, 10, 31, 12, 0, 0, 0, time.UTC)
+	before := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	// Expected & Mock: 記事を消してからカテゴリを消す
+	mockTxManager.EXPECT().RunInTx(ctx, gomock.Any()).DoAndReturn(runInTxWith(&transaction.Repositories{ArticleRepository: mockArticleRepository, CategoryRepository: mockCategoryRepository}))
+	gomock.InOrder(
+		mockArticleRepository.EXPECT().Purge(ctx, before).Return(int64(3), nil),
+		mockCategoryRepository.EXPECT().Purge(ctx, before).Return(int64(1), nil),
+	)
+
+	// Execute
+	u := NewTrashPurgeUseCase(mockTxManager, 30*24*time.Hour, func() time.Time { return now })
+	articles, categories, err := u.PurgeTrash(ctx)
+
+	// Check
+	if err != nil {
+		t.Errorf("err of u.PurgeTrash(ctx): Expected %v, but got %v", nil, err)
+	}
+	if articles != 3 {
+		t.Errorf("articles: Expected %d, but got %d", 3, articles)
+	}
+	if categories != 1 {
+		t.Errorf("categories: Expected %d, but got %d", 1, categories)
+	}
+}
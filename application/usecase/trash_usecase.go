@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/momonoki1990/tech-blog-api/application/auth"
+	"github.com/momonoki1990/tech-blog-api/application/transaction"
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+	"github.com/momonoki1990/tech-blog-api/domain/repository"
+	"github.com/momonoki1990/tech-blog-api/domain/service"
+)
+
+// ゴミ箱（削除した記事・カテゴリ）の参照と復元
+type TrashUseCase interface {
+	GetTrash(ctx context.Context) ([]*model.Article, []*model.Category, error)
+	RestoreArticle(ctx context.Context, id uuid.UUID) (error)
+	RestoreCategory(ctx context.Context, id uuid.UUID) (error)
+}
+
+type trashUseCase struct {
+	repository.ArticleRepository
+	repository.CategoryRepository
+	transaction.TxManager
+	service.ArticleValidator
+}
+
+func NewTrashUseCase(ar repository.ArticleRepository, cr repository.CategoryRepository, tm transaction.TxManager, av service.ArticleValidator) TrashUseCase {
+	return &trashUseCase{ar, cr, tm, av}
+}
+
+func (u *trashUseCase) GetTrash(ctx context.Context) ([]*model.Article, []*model.Category, error) {
+	if err := auth.Require(ctx, model.PermTrashManage); err != nil {
+		return nil, nil, err
+	}
+	articles, err := u.ArticleRepository.FindDeleted(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	categories, err := u.CategoryRepository.FindDeleted(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return articles, categories, nil
+}
+
+func (u *trashUseCase) RestoreArticle(ctx context.Context, id uuid.UUID) (error) {
+	if err := auth.Require(ctx, model.PermTrashManage); err != nil {
+		return err
+	}
+	return u.TxManager.RunInTx(ctx, func(r *transaction.Repositories) error {
+		if err := r.ArticleRepository.Restore(ctx, id); err != nil {
+			return err
+		}
+		article, err := r.ArticleRepository.FindOneById(ctx, id)
+		if err != nil {
+			return err
+		}
+		// カテゴリもゴミ箱にある場合は、先にカテゴリを復元してもらう
+		return u.ArticleValidator.Validate(ctx, r.CategoryRepository, article)
+	})
+}
+
+func (u *trashUseCase) RestoreCategory(ctx context.Context, id uuid.UUID) (error) {
+	if err := auth.Require(ctx, model.PermCategoriesManage); err != nil {
+		return err
+	}
+	return u.TxManager.RunInTx(ctx, func(r *transaction.Repositories) error {
+		return r.CategoryRepository.Restore(ctx, id)
+	})
+}
@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/momonoki1990/tech-blog-api/application/transaction"
+	mock_transaction "github.com/momonoki1990/tech-blog-api/application/transaction/mock"
+	"github.com/momonoki1990/tech-blog-api/domain/errs"
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+	mock_service "github.com/momonoki1990/tech-blog-api/domain/service/mock"
+	mock_repo "github.com/momonoki1990/tech-blog-api/infra/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRestoreArticle(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := withTestPrincipal(context.TODO())
+
+	// Prepare
+	mockArticleRepository := mock_repo.NewMockArticleRepository(mockCtrl)
+	mockCategoryRepository := mock_repo.NewMockCategoryRepository(mockCtrl)
+	mockTxManager := mock_transaction.NewMockTxManager(mockCtrl)
+	mockArticleValidator := mock_service.NewMockArticleValidator(mockCtrl)
+	article, err := model.NewArticle("Title1", "Content1", uuid.New(), []string{"Tag1"}, false)
+	if err != nil {
+		panic(err)
+	}
+
+	// Expected & Mock
+	mockTxManager.EXPECT().RunInTx(ctx, gomock.Any()).DoAndReturn(runInTxWith(&transaction.Repositories{ArticleRepository: mockArticleRepository, CategoryRepository: mockCategoryRepository}))
+	mockArticleRepository.EXPECT().Restore(ctx, article.Id).Return(nil)
+	mockArticleRepository.EXPECT().FindOneById(ctx, article.Id).Return(article, nil)
+	mockArticleValidator.EXPECT().Validate(ctx, mockCategoryRepository, article).Return(nil)
+
+	// Execute
+	u := NewTrashUseCase(mockArticleRepository, mockCategoryRepository, mockTxManager, mockArticleValidator)
+	err = u.RestoreArticle(ctx, article.Id)
+
+	// Check
+	if err != nil {
+		t.Errorf("err of u.RestoreArticle: Expected %v, but got %v", nil, err)
+	}
+}
+
+func TestRestoreArticleInDeletedCategory(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := withTestPrincipal(context.TODO())
+
+	// Prepare
+	mockArticleRepository := mock_repo.NewMockArticleRepository(mockCtrl)
+	mockCategoryRepository := mock_repo.NewMockCategoryRepository(mockCtrl)
+	mockTxManager := mock_transaction.NewMockTxManager(mockCtrl)
+	mockArticleValidator := mock_service.NewMockArticleValidator(mockCtrl)
+	article, err := model.NewArticle("Title1", "Content1", uuid.New(), []string{}, false)
+	if err != nil {
+		panic(err)
+	}
+	validationErr := errs.NewValidation(errs.CodeValidationFailed, "category does not exist", errs.FieldError{Field: "categoryId", Message: "category does not exist"})
+
+	// Expected & Mock: 検証エラーならロールバックされてゴミ箱に残る
+	mockTxManager.EXPECT().RunInTx(ctx, gomock.Any()).DoAndReturn(runInTxWith(&transaction.Repositories{ArticleRepository: mockArticleRepository, CategoryRepository: mockCategoryRepository}))
+	mockArticleRepository.EXPECT().Restore(ctx, article.Id).Return(nil)
+	mockArticleRepository.EXPECT().FindOneById(ctx, article.Id).Return(article, nil)
+	mockArticleValidator.EXPECT().Validate(ctx, mockCategoryRepository, article).Return(validationErr)
+
+	// Execute
+	u := NewTrashUseCase(mockArticleRepository, mockCategoryRepository, mockTxManager, mockArticleValidator)
+	err = u.RestoreArticle(ctx, article.Id)
+
+	// Check
+	if err != validationErr {
+		t.Errorf("err of u.RestoreArticle: Expected %v, but got %v", validationErr, err)
+	}
+}
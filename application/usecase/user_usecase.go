@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+	"github.com/momonoki1990/tech-blog-api/domain/repository"
+)
+
+type UserUseCase interface {
+	// 同じ名前のユーザーがいればConflict
+	RegisterUser(ctx context.Context, name string, password string, role model.Role) (*model.User, error)
+}
+
+type userUseCase struct {
+	repository.UserRepository
+}
+
+func NewUserUseCase(r repository.UserRepository) UserUseCase {
+	return &userUseCase{r}
+}
+
+func (u *userUseCase) RegisterUser(ctx context.Context, name string, password string, role model.Role) (*model.User, error) {
+	user, err := model.NewUser(name, password, role)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.UserRepository.Insert(ctx, user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
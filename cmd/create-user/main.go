@@ -0,0 +1,43 @@
+// 管理画面にログインするユーザーを作るコマンド（パスワードは引数に残らないよう環境変数で渡す）
+//
+//	$ USER_PASSWORD='...' go run ./cmd/create-user -name admin -role admin
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	_ "github.com/go-sql-driver/mysql"
+
+	"github.com/momonoki1990/tech-blog-api/application/usecase"
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+	"github.com/momonoki1990/tech-blog-api/infra/database"
+)
+
+func main() {
+	name := flag.String("name", "", "Name used to log in")
+	role := flag.String("role", string(model.RoleWriter), "writer, editor or admin")
+	flag.Parse()
+	password := os.Getenv("USER_PASSWORD")
+	if *name == "" || password == "" {
+		log.Fatal("-name and USER_PASSWORD are required")
+	}
+
+	dataSource := os.ExpandEnv("${DB_USER}:${DB_PASSWORD}@tcp(${DB_HOST}:${DB_PORT})/${DB_DATABASE}?parseTime=true")
+	db, err := sql.Open("mysql", dataSource)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer db.Close()
+
+	u := usecase.NewUserUseCase(database.NewUserRepository(db))
+	user, err := u.RegisterUser(context.Background(), *name, password, model.Role(*role))
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("User %q created (id: %s, role: %s)\n", user.Name, user.Id, user.Role)
+}
@@ -0,0 +1,57 @@
+// 既存のタグを現在の規則（TAG_NORMALIZERS・tag_aliases）で正規化する一度きりのコマンド
+//
+//	$ go run ./cmd/normalize-tags -dry-run
+//	$ go run ./cmd/normalize-tags
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	_ "github.com/go-sql-driver/mysql"
+
+	"github.com/momonoki1990/tech-blog-api/application/usecase"
+	"github.com/momonoki1990/tech-blog-api/infra/database"
+)
+
+func main() {
+	dryRun := flag.Bool("dry-run", false, "Print changes without applying them")
+	flag.Parse()
+
+	dataSource := os.ExpandEnv("${DB_USER}:${DB_PASSWORD}@tcp(${DB_HOST}:${DB_PORT})/${DB_DATABASE}?parseTime=true")
+	db, err := sql.Open("mysql", dataSource)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer db.Close()
+
+	ctx := context.Background()
+	var names []string
+	if v := os.Getenv("TAG_NORMALIZERS"); v != "" {
+		names = strings.Split(v, ",")
+	}
+	rules, err := usecase.LoadTagNameRules(ctx, database.NewTagAliasRepository(db), names)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// すべての付け替えを1つのトランザクションで行う（途中で失敗すれば何も変わらない）
+	u := usecase.NewTagUseCase(database.NewTagRepository(db), database.NewTxManager(db), rules)
+	changes, err := u.NormalizeTags(ctx, *dryRun)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, v := range changes {
+		fmt.Printf("%q -> %q\n", v.From, v.To)
+	}
+	if *dryRun {
+		fmt.Printf("%d tags would be changed (dry run)\n", len(changes))
+		return
+	}
+	fmt.Printf("%d tags changed\n", len(changes))
+}
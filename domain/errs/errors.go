@@ -0,0 +1,140 @@
+package errs
+
+import (
+	"errors"
+	"strings"
+)
+
+type Kind int
+
+const (
+	NotFound Kind = iota + 1
+	Conflict
+	Validation
+	// 更新対象が読み込んだ時点から変更されている（楽観的排他制御）
+	PreconditionFailed
+	// 認証情報がない、または正しくない
+	Unauthenticated
+	// 認証しているが、操作に必要な権限がない
+	Forbidden
+)
+
+func (k Kind) String() string {
+	switch k {
+	case NotFound:
+		return "NotFound"
+	case Conflict:
+		return "Conflict"
+	case Validation:
+		return "Validation"
+	case PreconditionFailed:
+		return "PreconditionFailed"
+	case Unauthenticated:
+		return "Unauthenticated"
+	case Forbidden:
+		return "Forbidden"
+	default:
+		return "Unknown"
+	}
+}
+
+// クライアントに返すエラーコード
+const (
+	CodeValidationFailed = "validation_failed"
+	CodeArticleNotFound = "article_not_found"
+	CodeArticleSlugConflict = "article_slug_conflict"
+	CodeArticleRevisionNotFound = "article_revision_not_found"
+	CodeArticleVersionMismatch = "article_version_mismatch"
+	CodeCategoryNotFound = "category_not_found"
+	CodeCategoryNameConflict = "category_name_conflict"
+	CodeCategorySlugConflict = "category_slug_conflict"
+	CodeCategoryVersionMismatch = "category_version_mismatch"
+	CodeCategoryInUse = "category_in_use"
+	CodeCategoryHasChildren = "category_has_children"
+	CodeTagNotFound = "tag_not_found"
+	CodeTagNameConflict = "tag_name_conflict"
+	CodeUserNameConflict = "user_name_conflict"
+	CodeAuthenticationRequired = "authentication_required"
+	CodeInvalidCredentials = "invalid_credentials"
+	CodeInvalidToken = "invalid_token"
+	CodeTokenExpired = "token_expired"
+	CodePermissionDenied = "permission_denied"
+	CodeApiKeyNotFound = "api_key_not_found"
+	CodeAuthorNotFound = "author_not_found"
+	CodeAuthorSlugConflict = "author_slug_conflict"
+	// ログインしたユーザーにしか許さない操作をAPIキーで行った
+	CodeApiKeyNotAllowed = "api_key_not_allowed"
+)
+
+// 入力値のどの項目がなぜ不正か
+type FieldError struct {
+	Field string `json:"field"`
+	Message string `json:"message"`
+}
+
+// ドメインのエラー。Codeはクライアントが分岐に使うので変更しないこと
+type Error struct {
+	Kind Kind
+	Code string
+	Message string
+	Fields []FieldError
+	// クライアントに返す補足情報（例: 削除できないカテゴリの記事の件数）
+	Details interface{}
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func NewNotFound(code string, message string) *Error {
+	return &Error{Kind: NotFound, Code: code, Message: message}
+}
+
+func NewConflict(code string, message string) *Error {
+	return &Error{Kind: Conflict, Code: code, Message: message}
+}
+
+func NewPreconditionFailed(code string, message string) *Error {
+	return &Error{Kind: PreconditionFailed, Code: code, Message: message}
+}
+
+func NewUnauthenticated(code string, message string) *Error {
+	return &Error{Kind: Unauthenticated, Code: code, Message: message}
+}
+
+func NewForbidden(code string, message string, details interface{}) *Error {
+	return &Error{Kind: Forbidden, Code: code, Message: message, Details: details}
+}
+
+func NewConflictWithDetails(code string, message string, details interface{}) *Error {
+	return &Error{Kind: Conflict, Code: code, Message: message, Details: details}
+}
+
+func NewValidation(code string, message string, fields ...FieldError) *Error {
+	return &Error{Kind: Validation, Code: code, Message: message, Fields: fields}
+}
+
+// 複数項目の違反をまとめて1つのエラーにする（違反がなければnil）
+func NewValidationFromFields(code string, fields []FieldError) error {
+	if len(fields) == 0 {
+		return nil
+	}
+	var messages []string
+	for _, v := range fields {
+		messages = append(messages, v.Message)
+	}
+	return NewValidation(code, strings.Join(messages, ", "), fields...)
+}
+
+func As(err error) (*Error, bool) {
+	var e *Error
+	if errors.As(err, &e) {
+		return e, true
+	}
+	return nil, false
+}
+
+func IsKind(err error, kind Kind) bool {
+	e, ok := As(err)
+	return ok && e.Kind == kind
+}
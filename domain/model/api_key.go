@@ -0,0 +1,109 @@
+package model
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/momonoki1990/tech-blog-api/domain/errs"
+)
+
+// CIやcronなど、人のログインを使わないクライアントのためのキー
+// 使える権限は、スコープと作成したユーザーの役割の権限の両方にあるものだけ
+type ApiKey struct {
+	Id uuid.UUID `json:"id"`
+	// キーを作成したユーザー
+	UserId uuid.UUID `json:"userId"`
+	// 用途のメモ（"CI" など）
+	Name string `json:"name"`
+	// 一覧でキーを見分けるための先頭部分
+	Prefix string `json:"prefix"`
+	// キーのSHA-256。キー自体は作成時に1度だけ返し、保存しない
+	KeyHash string `json:"-"`
+	Scopes []Permission `json:"scopes"`
+	// nilなら無期限
+	ExpiresAt *time.Time `json:"expiresAt"`
+	LastUsedAt *time.Time `json:"lastUsedAt"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+const (
+	// JWTと見分けるためのキーの接頭辞
+	ApiKeyPrefix = "tbk_"
+	ApiKeyNameMaxLength = 100
+	apiKeySecretBytes = 32
+	apiKeyDisplayPrefixLength = 12
+)
+
+// キーを生成する。戻り値のキーはこの時しか分からない
+func NewApiKey(userId uuid.UUID, name string, scopes []string, expiresAt *time.Time, now time.Time) (*ApiKey, string, error) {
+	var fields []errs.FieldError
+	if name == "" || utf8.RuneCountInString(name) > ApiKeyNameMaxLength {
+		fields = append(fields, errs.FieldError{Field: "name", Message: fmt.Sprintf("name should be from %d to %d characters", 1, ApiKeyNameMaxLength)})
+	}
+	permissions, err := parseScopes(scopes)
+	if err != nil {
+		fields = append(fields, errs.FieldError{Field: "scopes", Message: err.Error()})
+	}
+	if expiresAt != nil && !expiresAt.After(now) {
+		fields = append(fields, errs.FieldError{Field: "expiresAt", Message: "expiresAt should be in the future"})
+	}
+	if err := errs.NewValidationFromFields(errs.CodeValidationFailed, fields); err != nil {
+		return nil, "", err
+	}
+
+	secret := make([]byte, apiKeySecretBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, "", err
+	}
+	key := ApiKeyPrefix + base64.RawURLEncoding.EncodeToString(secret)
+	return &ApiKey{
+		Id: uuid.New(),
+		UserId: userId,
+		Name: name,
+		Prefix: key[:apiKeyDisplayPrefixLength],
+		KeyHash: HashApiKey(key),
+		Scopes: permissions,
+		ExpiresAt: expiresAt,
+		CreatedAt: now,
+	}, key, nil
+}
+
+// キーは推測できない長さの乱数なので、bcryptではなくSHA-256で十分（照合もハッシュでの検索で済む）
+func HashApiKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+func IsApiKey(token string) bool {
+	return strings.HasPrefix(token, ApiKeyPrefix)
+}
+
+func (k *ApiKey) IsExpired(now time.Time) bool {
+	return k.ExpiresAt != nil && !now.Before(*k.ExpiresAt)
+}
+
+func parseScopes(scopes []string) ([]Permission, error) {
+	if len(scopes) == 0 {
+		return nil, fmt.Errorf("scopes should not be empty")
+	}
+	permissions := make([]Permission, 0, len(scopes))
+	seen := map[Permission]bool{}
+	for _, v := range scopes {
+		p, err := ParsePermission(v)
+		if err != nil {
+			return nil, err
+		}
+		if !seen[p] {
+			seen[p] = true
+			permissions = append(permissions, p)
+		}
+	}
+	return permissions, nil
+}
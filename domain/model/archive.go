@@ -0,0 +1,8 @@
+package model
+
+// 公開年月ごとの記事数（月別アーカイブ）
+type ArchiveMonth struct {
+	Year int `json:"year"`
+	Month int `json:"month"`
+	Count int `json:"count"`
+}
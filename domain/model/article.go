@@ -1,10 +1,14 @@
 package model
 
 import (
-	"errors"
+	"fmt"
+	"strings"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
+	"github.com/momonoki1990/tech-blog-api/domain/errs"
 )
 
 type Tag struct {
@@ -16,6 +20,8 @@ type Status int
 const (
 	Draft Status = iota
     Published 
+    // 公開日時（PublishedAt）になったら公開される
+    Scheduled
 )
 
 func (s Status) String() string {
@@ -24,21 +30,43 @@ func (s Status) String() string {
         return "Draft"
     case Published :
         return "Published"
+    case Scheduled:
+        return "Scheduled"
     default:
         return "Unknown"
     }
 }
 
+const (
+	TitleMaxLength = 255
+	// TEXT型の上限
+	ContentMaxBytes = 65535
+	TagsMax = 10
+	TagNameMaxLength = 50
+)
+
+// 英数字・かな漢字以外でタグ名に使える文字（例: C#, C++, Vue.js, Node-RED）
+const tagNameSymbols = "-_.+#・ "
+
 type Article struct {
 	Id uuid.UUID `json:"id"`
 	Title string `json:"title"`
+	Slug string `json:"slug"`
 	Content string `json:"content"`
 	CategoryId uuid.UUID `json:"categoryId"`
 	Tags []Tag `json:"tags"`
 	PublishedAt *time.Time `json:"publishedAt"`
 	Status Status `json:"status"`
+	// 記事を作ったユーザー（認証を入れる前の記事はnil）
+	CreatedBy *uuid.UUID `json:"createdBy"`
+	// 表示順
+	Authors []AuthorSummary `json:"authors"`
 	CreatedAt time.Time `json:"createdAt"`
 	UpdatedAt time.Time `json:"updatedAt"`
+	// 更新のたびに1増える（ETagとして返し、If-Matchで照合する）
+	Version int `json:"version"`
+	// ゴミ箱に移した日時（ゴミ箱の一覧でのみ値がある）
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
 }
 
 func NewArticle (title string, content string, categoryId uuid.UUID, tagNames []string, shouldPublish bool) (*Article, error) {
@@ -51,9 +79,11 @@ func NewArticle (title string, content string, categoryId uuid.UUID, tagNames []
 		status = Published
 	}
 
+	id := uuid.New()
 	article := &Article{
-		Id: uuid.New(),
+		Id: id,
 		Title: title,
+		Slug: GenerateSlug(title, id),
 		Content: content,
 		CategoryId: categoryId,
 		Tags: tags,
@@ -61,10 +91,74 @@ func NewArticle (title string, content string, categoryId uuid.UUID, tagNames []
 		PublishedAt: publishedAt,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
+		Version: 1,
+	}
+	if err := article.Validate(); err != nil {
+		return nil, err
 	}
 	return article, nil
 }
 
+// 不変条件の違反をすべてまとめて返す（違反がなければnil）
+func (a *Article) Validate() error {
+	return errs.NewValidationFromFields(errs.CodeValidationFailed, a.Violations())
+}
+
+func (a *Article) Violations() []errs.FieldError {
+	var fields []errs.FieldError
+	violate := func(field string, message string) {
+		fields = append(fields, errs.FieldError{Field: field, Message: message})
+	}
+
+	if strings.TrimSpace(a.Title) == "" {
+		violate("title", "title is required")
+	} else if utf8.RuneCountInString(a.Title) > TitleMaxLength {
+		violate("title", fmt.Sprintf("title should be at most %d characters", TitleMaxLength))
+	}
+
+	if message := slugViolation(a.Slug); message != "" {
+		violate("slug", message)
+	}
+
+	if len(a.Content) > ContentMaxBytes {
+		violate("content", fmt.Sprintf("content should be at most %d bytes", ContentMaxBytes))
+	}
+	if (a.Status == Published || a.Status == Scheduled) && strings.TrimSpace(a.Content) == "" {
+		violate("content", "content is required to publish")
+	}
+	if a.Status == Scheduled && a.PublishedAt == nil {
+		violate("publishedAt", "publishedAt is required to schedule")
+	}
+
+	if len(a.Tags) > TagsMax {
+		violate("tagNames", fmt.Sprintf("tags should be at most %d", TagsMax))
+	}
+	for _, v := range a.Tags {
+		if message := tagNameViolation(v.Name); message != "" {
+			violate("tagNames", message)
+		}
+	}
+	return fields
+}
+
+func tagNameViolation(name string) string {
+	if strings.TrimSpace(name) == "" {
+		return "tag name is required"
+	}
+	if utf8.RuneCountInString(name) > TagNameMaxLength {
+		return fmt.Sprintf("tag %q should be at most %d characters", name, TagNameMaxLength)
+	}
+	if strings.TrimSpace(name) != name {
+		return fmt.Sprintf("tag %q should not start or end with spaces", name)
+	}
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsNumber(r) && !unicode.IsMark(r) && !strings.ContainsRune(tagNameSymbols, r) {
+			return fmt.Sprintf("tag %q contains invalid character %q", name, r)
+		}
+	}
+	return ""
+}
+
 func (a *Article) Equals(compared *Article) bool {
 	return a.Id == compared.Id
 }
@@ -74,6 +168,7 @@ func (a *Article) SetTags (tagNames []string) {
 	a.Tags = tags
 }
 
+// 同じ名前のタグは1つにする（正規化・別名の置き換えは呼び出し側でTagNameRulesを使って行う）
 func generateTags(tagNames []string) []Tag {
 	var tags []Tag
 	tagMap := make(map[string]bool)
@@ -89,18 +184,42 @@ func generateTags(tagNames []string) []Tag {
 	return tags
 }
 
+func (a *Article) SetSlug (slug string) error {
+	if message := slugViolation(slug); message != "" {
+		return errs.NewValidation(errs.CodeValidationFailed, message, errs.FieldError{Field: "slug", Message: message})
+	}
+	a.Slug = slug
+	return nil
+}
+
+// atが未来なら予約投稿にし、過去ならその日時で公開済みにする
+func (a *Article) PublishAt (at time.Time, now time.Time) {
+	if at.After(now) {
+		a.Status = Scheduled
+	} else {
+		a.Status = Published
+	}
+	a.PublishedAt = &at
+}
+
 func (a *Article) SetStatus (s Status) error {
 	switch s {
 		case Draft:
+			// 予約を取り消した記事は一度も公開されていない
+			if a.Status == Scheduled {
+				a.PublishedAt = nil
+			}
 			a.Status = Draft
 		case Published:
-			a.Status = Published
-			if a.PublishedAt == nil {
+			// 予約中の記事はすぐに公開する
+			if a.PublishedAt == nil || a.Status == Scheduled {
 				now := time.Now()
 				a.PublishedAt = &now
 			}
+			a.Status = Published
 		default:
-			return errors.New("Invalid status")
+			// 予約はPublishAtで行う
+			return errs.NewValidation(errs.CodeValidationFailed, "Invalid status", errs.FieldError{Field: "status", Message: "Invalid status"})
 	}
 	return nil
 }
\ No newline at end of file
@@ -0,0 +1,107 @@
+package model
+
+import (
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// 記事の保存ごとのスナップショット（番号は記事ごとに1から）
+type ArticleRevision struct {
+	Id uuid.UUID `json:"id"`
+	ArticleId uuid.UUID `json:"articleId"`
+	Number int `json:"number"`
+	Title string `json:"title"`
+	Content string `json:"content"`
+	CategoryId uuid.UUID `json:"categoryId"`
+	TagNames []string `json:"tagNames"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func NewArticleRevision(a *Article, number int) *ArticleRevision {
+	tagNames := []string{}
+	for _, v := range a.Tags {
+		tagNames = append(tagNames, v.Name)
+	}
+	return &ArticleRevision{
+		Id: uuid.New(),
+		ArticleId: a.Id,
+		Number: number,
+		Title: a.Title,
+		Content: a.Content,
+		CategoryId: a.CategoryId,
+		TagNames: tagNames,
+		CreatedAt: time.Now(),
+	}
+}
+
+// 版として残す項目（タイトル・本文・カテゴリ・タグ）が記事と同じか
+// タグは順番を問わない（並べ替えただけの保存では版を増やさない）
+func (r *ArticleRevision) Matches(a *Article) bool {
+	if r.Title != a.Title || r.Content != a.Content || r.CategoryId != a.CategoryId || len(r.TagNames) != len(a.Tags) {
+		return false
+	}
+	revisionTagNames := append([]string{}, r.TagNames...)
+	articleTagNames := make([]string, 0, len(a.Tags))
+	for _, v := range a.Tags {
+		articleTagNames = append(articleTagNames, v.Name)
+	}
+	sort.Strings(revisionTagNames)
+	sort.Strings(articleTagNames)
+	for i, v := range articleTagNames {
+		if revisionTagNames[i] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// 記事をこの版の内容に戻す（ステータスやスラッグは変えない）
+func (r *ArticleRevision) ApplyTo(a *Article) {
+	a.Title = r.Title
+	a.Content = r.Content
+	a.CategoryId = r.CategoryId
+	a.SetTags(r.TagNames)
+}
+
+type RevisionDiff struct {
+	From int `json:"from"`
+	To int `json:"to"`
+	Title []DiffLine `json:"title"`
+	Content []DiffLine `json:"content"`
+	FromCategoryId uuid.UUID `json:"fromCategoryId"`
+	ToCategoryId uuid.UUID `json:"toCategoryId"`
+	AddedTagNames []string `json:"addedTagNames"`
+	RemovedTagNames []string `json:"removedTagNames"`
+}
+
+// fromからtoへの差分
+func (r *ArticleRevision) Diff(to *ArticleRevision) *RevisionDiff {
+	return &RevisionDiff{
+		From: r.Number,
+		To: to.Number,
+		Title: DiffLines(r.Title, to.Title),
+		Content: DiffLines(r.Content, to.Content),
+		FromCategoryId: r.CategoryId,
+		ToCategoryId: to.CategoryId,
+		AddedTagNames: subtract(to.TagNames, r.TagNames),
+		RemovedTagNames: subtract(r.TagNames, to.TagNames),
+	}
+}
+
+func subtract(a []string, b []string) []string {
+	result := []string{}
+	for _, v := range a {
+		found := false
+		for _, v2 := range b {
+			if v == v2 {
+				found = true
+			}
+		}
+		if !found {
+			result = append(result, v)
+		}
+	}
+	return result
+}
@@ -0,0 +1,41 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestArticleRevisionMatches(t *testing.T) {
+	// Prepare data
+	categoryId := uuid.New()
+	revision := &ArticleRevision{Title: "Title1", Content: "Content1", CategoryId: categoryId, TagNames: []string{"Tag1", "Tag2"}}
+	tests := []struct {
+		name string
+		tagNames []string
+		expected bool
+	}{
+		{name: "同じタグ", tagNames: []string{"Tag1", "Tag2"}, expected: true},
+		{name: "並べ替えただけのタグは同じとする", tagNames: []string{"Tag2", "Tag1"}, expected: true},
+		{name: "タグを入れ替えた", tagNames: []string{"Tag1", "Tag3"}, expected: false},
+		{name: "タグを外した", tagNames: []string{"Tag1"}, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tags := make([]Tag, 0, len(tt.tagNames))
+			for _, v := range tt.tagNames {
+				tags = append(tags, Tag{Name: v})
+			}
+			article := &Article{Title: "Title1", Content: "Content1", CategoryId: categoryId, Tags: tags}
+
+			// Execute
+			actual := revision.Matches(article)
+
+			// Check
+			if actual != tt.expected {
+				t.Errorf("revision.Matches(%v): Expected %v, but got %v", tt.tagNames, tt.expected, actual)
+			}
+		})
+	}
+}
@@ -1,9 +1,12 @@
 package model
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/momonoki1990/tech-blog-api/domain/errs"
 )
 
 func TestNewArticle(t *testing.T) {
@@ -158,4 +161,145 @@ func TestSetStatus(t *testing.T) {
 	if !article1.PublishedAt.Equal(firstPublishedAt) {
 		t.Errorf("article1.PublishedAt: Expected %s, but got %v", firstPublishedAt, *article1.PublishedAt)
 	}
-}
\ No newline at end of file
+}
+
+func TestPublishAt(t *testing.T) {
+	// Prepare
+	now := time.Now()
+	future := now.Add(time.Hour)
+	past := now.Add(-time.Hour)
+	article1, err := NewArticle("Title1", "Content1", uuid.New(), []string{}, false)
+	if err != nil {
+		panic(err)
+	}
+
+	// Execute1
+	article1.PublishAt(future, now)
+
+	// Check1
+	if article1.Status != Scheduled {
+		t.Errorf("article1.Status: Expected %s, but got %s", Scheduled, article1.Status)
+	}
+	if article1.PublishedAt == nil || !article1.PublishedAt.Equal(future) {
+		t.Errorf("article1.PublishedAt: Expected %s, but got %v", future, article1.PublishedAt)
+	}
+	if err := article1.Validate(); err != nil {
+		t.Errorf("err of article1.Validate(): Expected %v, but got %v", nil, err)
+	}
+
+	// Execute2: 予約を取り消す
+	article1.SetStatus(Draft)
+
+	// Check2
+	if article1.PublishedAt != nil {
+		t.Errorf("article1.PublishedAt: Expected %v, but got %v", nil, article1.PublishedAt)
+	}
+
+	// Execute3
+	article1.PublishAt(past, now)
+
+	// Check3
+	if article1.Status != Published {
+		t.Errorf("article1.Status: Expected %s, but got %s", Published, article1.Status)
+	}
+	if article1.PublishedAt == nil || !article1.PublishedAt.Equal(past) {
+		t.Errorf("article1.PublishedAt: Expected %s, but got %v", past, article1.PublishedAt)
+	}
+
+	// Execute4: 予約中の記事をすぐに公開する
+	article1.PublishAt(future, now)
+	article1.SetStatus(Published)
+
+	// Check4
+	if article1.Status != Published {
+		t.Errorf("article1.Status: Expected %s, but got %s", Published, article1.Status)
+	}
+	if article1.PublishedAt == nil || !article1.PublishedAt.Before(future) {
+		t.Errorf("article1.PublishedAt: Expected before %s, but got %v", future, article1.PublishedAt)
+	}
+}
+
+func TestScheduledArticleValidationError(t *testing.T) {
+	// Prepare
+	now := time.Now()
+	article1, err := NewArticle("Title1", "", uuid.New(), []string{}, false)
+	if err != nil {
+		panic(err)
+	}
+
+	// Execute
+	article1.PublishAt(now.Add(time.Hour), now)
+	err = article1.Validate()
+
+	// Check
+	e, ok := errs.As(err)
+	if !ok || len(e.Fields) != 1 || e.Fields[0].Field != "content" {
+		t.Errorf("err of article1.Validate(): Expected content violation, but got %v", err)
+	}
+}
+
+func TestNewArticleValidationError(t *testing.T) {
+	// Prepare data
+	categoryId1, err := uuid.Parse("11111111-1111-1111-1111-111111111111")
+	if err != nil {
+		panic(err)
+	}
+	tagNames := []string{"Tag1", "Tag,2", strings.Repeat("a", TagNameMaxLength+1)}
+
+	// Execute
+	article, err := NewArticle(" ", "", categoryId1, tagNames, true)
+
+	// Check
+	if article != nil {
+		t.Errorf("article: Expected %v, but got %v", nil, article)
+	}
+	e, ok := errs.As(err)
+	if !ok {
+		t.Fatalf("err: Expected %s, but got %v", "*errs.Error", err)
+	}
+	if e.Kind != errs.Validation {
+		t.Errorf("e.Kind: Expected %s, but got %s", errs.Validation, e.Kind)
+	}
+	// title, content(公開時は必須), tagNames x2 の違反がまとめて返ること
+	fields := []string{}
+	for _, v := range e.Fields {
+		fields = append(fields, v.Field)
+	}
+	if strings.Join(fields, ",") != "title,content,tagNames,tagNames" {
+		t.Errorf("e.Fields: Expected %s, but got %v", "title,content,tagNames,tagNames", fields)
+	}
+}
+
+func TestArticleValidate(t *testing.T) {
+	// Prepare
+	categoryId1, err := uuid.Parse("11111111-1111-1111-1111-111111111111")
+	if err != nil {
+		panic(err)
+	}
+	article1, err := NewArticle("タイトル", "本文", categoryId1, []string{"Go", "C#", "Vue.js", "ドメイン駆動設計"}, true)
+	if err != nil {
+		t.Fatalf("err of NewArticle: Expected %v, but got %v", nil, err)
+	}
+
+	// Execute1
+	err = article1.Validate()
+
+	// Check1
+	if err != nil {
+		t.Errorf("err of article1.Validate(): Expected %v, but got %v", nil, err)
+	}
+
+	// Execute2
+	article1.Title = strings.Repeat("あ", TitleMaxLength+1)
+	article1.SetTags([]string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11"})
+	err = article1.Validate()
+
+	// Check2
+	e, ok := errs.As(err)
+	if !ok {
+		t.Fatalf("err: Expected %s, but got %v", "*errs.Error", err)
+	}
+	if len(e.Fields) != 2 || e.Fields[0].Field != "title" || e.Fields[1].Field != "tagNames" {
+		t.Errorf("e.Fields: Expected %s, but got %v", "title,tagNames", e.Fields)
+	}
+}
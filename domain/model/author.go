@@ -0,0 +1,97 @@
+package model
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/momonoki1990/tech-blog-api/domain/errs"
+)
+
+// 記事の著者のプロフィール。ログインするユーザーとは別に管理する
+type Author struct {
+	Id uuid.UUID `json:"id"`
+	// 記事を書いたときに既定の著者にするユーザー（ユーザーでない寄稿者はnil）。公開しない
+	UserId *uuid.UUID `json:"-"`
+	DisplayName string `json:"displayName"`
+	// プロフィールのURL（/authors/:slug）に使う
+	Slug string `json:"slug"`
+	Bio string `json:"bio"`
+	AvatarUrl string `json:"avatarUrl"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// 記事のJSONに含める著者の概要
+type AuthorSummary struct {
+	Id uuid.UUID `json:"id"`
+	DisplayName string `json:"displayName"`
+	Slug string `json:"slug"`
+	AvatarUrl string `json:"avatarUrl"`
+}
+
+const (
+	AuthorDisplayNameMaxLength = 100
+	AuthorBioMaxLength = 1000
+	AuthorAvatarUrlMaxLength = 255
+)
+
+// slugが空なら表示名から生成する（重複の解消は呼び出し側で行う）
+func NewAuthor(userId *uuid.UUID, displayName string, slug string, bio string, avatarUrl string) (*Author, error) {
+	id := uuid.New()
+	if slug == "" {
+		slug = GenerateSlug(displayName, id)
+	}
+	a := &Author{
+		Id: id,
+		UserId: userId,
+		DisplayName: displayName,
+		Slug: slug,
+		Bio: bio,
+		AvatarUrl: avatarUrl,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	if err := errs.NewValidationFromFields(errs.CodeValidationFailed, a.Violations()); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+func (a *Author) Violations() []errs.FieldError {
+	var fields []errs.FieldError
+	if a.DisplayName == "" || utf8.RuneCountInString(a.DisplayName) > AuthorDisplayNameMaxLength {
+		fields = append(fields, errs.FieldError{Field: "displayName", Message: fmt.Sprintf("displayName should be from %d to %d characters", 1, AuthorDisplayNameMaxLength)})
+	}
+	if message := slugViolation(a.Slug); message != "" {
+		fields = append(fields, errs.FieldError{Field: "slug", Message: message})
+	}
+	if utf8.RuneCountInString(a.Bio) > AuthorBioMaxLength {
+		fields = append(fields, errs.FieldError{Field: "bio", Message: fmt.Sprintf("bio should be at most %d characters", AuthorBioMaxLength)})
+	}
+	if message := avatarUrlViolation(a.AvatarUrl); message != "" {
+		fields = append(fields, errs.FieldError{Field: "avatarUrl", Message: message})
+	}
+	return fields
+}
+
+func (a *Author) Summary() AuthorSummary {
+	return AuthorSummary{Id: a.Id, DisplayName: a.DisplayName, Slug: a.Slug, AvatarUrl: a.AvatarUrl}
+}
+
+// 空（アバターなし）か、httpsまたはhttpの絶対URL
+func avatarUrlViolation(v string) string {
+	if v == "" {
+		return ""
+	}
+	if len(v) > AuthorAvatarUrlMaxLength {
+		return fmt.Sprintf("avatarUrl should be at most %d characters", AuthorAvatarUrlMaxLength)
+	}
+	u, err := url.Parse(v)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return "avatarUrl should be an absolute http(s) URL"
+	}
+	return ""
+}
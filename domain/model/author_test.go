@@ -0,0 +1,51 @@
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/momonoki1990/tech-blog-api/domain/errs"
+)
+
+func TestNewAuthor(t *testing.T) {
+	// Execute
+	author, err := NewAuthor(nil, "山田 Taro", "", "", "")
+
+	// Check
+	if err != nil {
+		t.Fatalf("err of NewAuthor: Expected %v, but got %v", nil, err)
+	}
+	// 漢字は読みが決まらないので英数字だけでスラッグを作る
+	if author.Slug != "taro" {
+		t.Errorf("author.Slug: Expected %s, but got %s", "taro", author.Slug)
+	}
+}
+
+func TestNewAuthorValidationError(t *testing.T) {
+	tests := []struct {
+		name string
+		displayName string
+		slug string
+		bio string
+		avatarUrl string
+		field string
+	}{
+		{"表示名が空", "", "alice", "", "", "displayName"},
+		{"スラッグに大文字", "Alice", "Alice", "", "", "slug"},
+		{"自己紹介が長すぎる", "Alice", "alice", strings.Repeat("a", AuthorBioMaxLength+1), "", "bio"},
+		{"アバターが相対URL", "Alice", "alice", "", "/alice.png", "avatarUrl"},
+		{"アバターがhttp(s)以外", "Alice", "alice", "", "javascript:alert(1)", "avatarUrl"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Execute
+			_, err := NewAuthor(nil, tt.displayName, tt.slug, tt.bio, tt.avatarUrl)
+
+			// Check
+			e, ok := errs.As(err)
+			if !ok || e.Kind != errs.Validation || len(e.Fields) != 1 || e.Fields[0].Field != tt.field {
+				t.Errorf("err of NewAuthor: Expected %v of %s, but got %v", errs.Validation, tt.field, err)
+			}
+		})
+	}
+}
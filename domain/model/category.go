@@ -1,32 +1,73 @@
 package model
 
 import (
-	"errors"
 	"fmt"
+	"time"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
+	"github.com/momonoki1990/tech-blog-api/domain/errs"
 )
 
 type Category struct {
 	Id uuid.UUID `json:"id"`
 	Name string `json:"name"`
+	// URLに使う識別子（例: backend）。名前を変えても変わらない
+	Slug string `json:"slug"`
+	Description string `json:"description"`
 	DisplayOrder int `json:"displayOrder"`
+	// 親カテゴリ（nilなら最上位）
+	ParentId *uuid.UUID `json:"parentId"`
+	// 更新のたびに1増える（If-Matchで照合する）
+	Version int `json:"version"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+	// ゴミ箱に移した日時（ゴミ箱の一覧でのみ値がある）
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
+	// 記事の集計（取得時に指定した場合だけ値がある）
+	Stats *CategoryStats `json:"stats,omitempty"`
 }
 
-func NewCategory(name string, displayOrder int) (*Category, error) {
-	const (
-		displayOrderMin = 1
-		displayOrderMax = 999
-	)
+// カテゴリに属する記事の件数（ゴミ箱の記事はTrashedにだけ数える）
+type CategoryArticleCounts struct {
+	Published int `json:"published"`
+	Scheduled int `json:"scheduled"`
+	Draft int `json:"draft"`
+	Trashed int `json:"trashed"`
+}
 
-	if (displayOrder < displayOrderMin || displayOrder > displayOrderMax) {
-		return nil, errors.New(fmt.Sprintf("displayOrder should be from %d to %d", displayOrderMin, displayOrderMax))
+// ゴミ箱にない記事の件数
+func (c *CategoryArticleCounts) Active() int {
+	return c.Published + c.Scheduled + c.Draft
+}
+
+// カテゴリに直接属する記事の集計（子孫のカテゴリの記事は含まない）
+type CategoryStats struct {
+	ArticleCounts CategoryArticleCounts `json:"articleCounts"`
+	// ゴミ箱にない公開済みの記事の最新の公開日時（なければnil）
+	LatestPublishedAt *time.Time `json:"latestPublishedAt"`
+}
+
+const (
+	DisplayOrderMin = 1
+	DisplayOrderMax = 999
+	CategoryDescriptionMaxLength = 500
+)
+
+func NewCategory(name string, displayOrder int) (*Category, error) {
+	if err := validateDisplayOrder(displayOrder); err != nil {
+		return nil, err
 	}
 
+	id := uuid.New()
 	c := &Category{
-		Id: uuid.New(),
+		Id: id,
 		Name: name,
+		Slug: GenerateSlug(name, id),
 		DisplayOrder: displayOrder,
+		Version: 1,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
 	}
 
 	return c, nil
@@ -34,4 +75,90 @@ func NewCategory(name string, displayOrder int) (*Category, error) {
 
 func (c *Category) Equals(compared *Category) bool {
 	return c.Id == compared.Id
-}
\ No newline at end of file
+}
+
+func (c *Category) SetDisplayOrder(displayOrder int) error {
+	if err := validateDisplayOrder(displayOrder); err != nil {
+		return err
+	}
+	c.DisplayOrder = displayOrder
+	return nil
+}
+
+func (c *Category) SetSlug(slug string) error {
+	if message := slugViolation(slug); message != "" {
+		return errs.NewValidation(errs.CodeValidationFailed, message, errs.FieldError{Field: "slug", Message: message})
+	}
+	c.Slug = slug
+	return nil
+}
+
+func (c *Category) SetDescription(description string) error {
+	if utf8.RuneCountInString(description) > CategoryDescriptionMaxLength {
+		message := fmt.Sprintf("description should be at most %d characters", CategoryDescriptionMaxLength)
+		return errs.NewValidation(errs.CodeValidationFailed, message, errs.FieldError{Field: "description", Message: message})
+	}
+	c.Description = description
+	return nil
+}
+
+// treeは全カテゴリから作ったもの。親が存在しない・自分自身や子孫を親にする場合はValidation
+func (c *Category) SetParent(parentId *uuid.UUID, tree *CategoryTree) error {
+	if err := tree.ValidateParent(c.Id, parentId); err != nil {
+		return err
+	}
+	c.ParentId = parentId
+	return nil
+}
+
+// idsの順にDisplayOrderを1から振り直し、変わったカテゴリを返す
+// idsはcategoriesのIdを過不足・重複なく含むこと（他の誰かの追加・削除を見落とした並びで振り直さない）
+func ReorderCategories(categories []*Category, ids []uuid.UUID) ([]*Category, error) {
+	if len(ids) > DisplayOrderMax {
+		return nil, reorderViolation(fmt.Sprintf("categoryIds should have at most %d categories", DisplayOrderMax))
+	}
+	byId := make(map[uuid.UUID]*Category)
+	for _, v := range categories {
+		byId[v.Id] = v
+	}
+	ordered := make([]*Category, 0, len(ids))
+	seen := make(map[uuid.UUID]bool)
+	for _, v := range ids {
+		if seen[v] {
+			return nil, reorderViolation(fmt.Sprintf("categoryIds has duplicate category %s", v))
+		}
+		seen[v] = true
+		c, ok := byId[v]
+		if !ok {
+			return nil, reorderViolation(fmt.Sprintf("category %s was not found", v))
+		}
+		ordered = append(ordered, c)
+	}
+	if len(ordered) != len(categories) {
+		return nil, reorderViolation("categoryIds should have all categories")
+	}
+	changed := []*Category{}
+	for i, v := range ordered {
+		displayOrder := DisplayOrderMin + i
+		if v.DisplayOrder == displayOrder {
+			continue
+		}
+		if err := v.SetDisplayOrder(displayOrder); err != nil {
+			return nil, err
+		}
+		changed = append(changed, v)
+	}
+	return changed, nil
+}
+
+func reorderViolation(message string) error {
+	return errs.NewValidation(errs.CodeValidationFailed, message, errs.FieldError{Field: "categoryIds", Message: message})
+}
+
+func validateDisplayOrder(displayOrder int) error {
+	if (displayOrder < DisplayOrderMin || displayOrder > DisplayOrderMax) {
+		message := fmt.Sprintf("displayOrder should be from %d to %d", DisplayOrderMin, DisplayOrderMax)
+		return errs.NewValidation(errs.CodeValidationFailed, message, errs.FieldError{Field: "displayOrder", Message: message})
+	}
+	return nil
+}
@@ -1,6 +1,12 @@
 package model
 
-import "testing"
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/momonoki1990/tech-blog-api/domain/errs"
+)
 
 func TestNewCategory(t *testing.T) {
 	// Execute
@@ -19,4 +25,155 @@ func TestNewCategory(t *testing.T) {
 	if category.DisplayOrder != 1 {
 		t.Errorf("category.DisplayOrder: Expected %d, but got %d", 1, category.DisplayOrder)
 	}
-}
\ No newline at end of file
+	if category.Slug != "name1" {
+		t.Errorf("category.Slug: Expected %s, but got %s", "name1", category.Slug)
+	}
+}
+
+func TestNewCategoryDisplayOrderError(t *testing.T) {
+	// Execute
+	category, err := NewCategory("Name1", 1000)
+
+	// Check
+	if category != nil {
+		t.Errorf("category: Expected %v, but got %v", nil, category)
+	}
+	e, ok := errs.As(err)
+	if !ok {
+		t.Fatalf("err: Expected %s, but got %v", "*errs.Error", err)
+	}
+	if e.Kind != errs.Validation {
+		t.Errorf("e.Kind: Expected %s, but got %s", errs.Validation, e.Kind)
+	}
+	if len(e.Fields) != 1 || e.Fields[0].Field != "displayOrder" {
+		t.Errorf("e.Fields: Expected %s, but got %v", "displayOrder", e.Fields)
+	}
+}
+
+func TestSetDisplayOrder(t *testing.T) {
+	// Prepare
+	category, err := NewCategory("Name1", 1)
+	if err != nil {
+		panic(err)
+	}
+
+	// Execute1
+	err = category.SetDisplayOrder(999)
+
+	// Check1
+	if err != nil {
+		t.Errorf("err of category.SetDisplayOrder(999): Expected %v, but got %v", nil, err)
+	}
+	if category.DisplayOrder != 999 {
+		t.Errorf("category.DisplayOrder: Expected %d, but got %d", 999, category.DisplayOrder)
+	}
+
+	// Execute2
+	err = category.SetDisplayOrder(0)
+
+	// Check2
+	if !errs.IsKind(err, errs.Validation) {
+		t.Errorf("err of category.SetDisplayOrder(0): Expected %s, but got %v", errs.Validation, err)
+	}
+	if category.DisplayOrder != 999 {
+		t.Errorf("category.DisplayOrder: Expected %d, but got %d", 999, category.DisplayOrder)
+	}
+}
+
+func TestReorderCategories(t *testing.T) {
+	// Prepare
+	category1, err := NewCategory("Name1", 1)
+	if err != nil {
+		panic(err)
+	}
+	category2, err := NewCategory("Name2", 2)
+	if err != nil {
+		panic(err)
+	}
+	category3, err := NewCategory("Name3", 3)
+	if err != nil {
+		panic(err)
+	}
+
+	// Execute
+	changed, err := ReorderCategories([]*Category{category1, category2, category3}, []uuid.UUID{category3.Id, category2.Id, category1.Id})
+	if err != nil {
+		panic(err)
+	}
+
+	// Check
+	if category3.DisplayOrder != 1 || category2.DisplayOrder != 2 || category1.DisplayOrder != 3 {
+		t.Errorf("DisplayOrder: Expected %v, but got %v", []int{3, 2, 1}, []int{category1.DisplayOrder, category2.DisplayOrder, category3.DisplayOrder})
+	}
+	// 表示順が変わらないカテゴリは更新しない
+	if len(changed) != 2 || changed[0] != category3 || changed[1] != category1 {
+		t.Errorf("changed: Expected %v, but got %v", []string{"Name3", "Name1"}, changed)
+	}
+}
+
+func TestReorderCategoriesError(t *testing.T) {
+	// Prepare
+	category1, err := NewCategory("Name1", 1)
+	if err != nil {
+		panic(err)
+	}
+	category2, err := NewCategory("Name2", 2)
+	if err != nil {
+		panic(err)
+	}
+	tooMany := make([]uuid.UUID, DisplayOrderMax + 1)
+	for i := range tooMany {
+		tooMany[i] = uuid.New()
+	}
+	cases := map[string][]uuid.UUID{
+		"missing": {category1.Id},
+		"duplicate": {category1.Id, category1.Id},
+		"unknown": {category1.Id, category2.Id, uuid.New()},
+		"too many": tooMany,
+	}
+
+	for name, ids := range cases {
+		// Execute
+		changed, err := ReorderCategories([]*Category{category1, category2}, ids)
+
+		// Check
+		e, ok := errs.As(err)
+		if !ok || e.Kind != errs.Validation || len(e.Fields) != 1 || e.Fields[0].Field != "categoryIds" {
+			t.Errorf("err of %s: Expected %s of categoryIds, but got %v", name, errs.Validation, err)
+		}
+		if changed != nil {
+			t.Errorf("changed of %s: Expected %v, but got %v", name, nil, changed)
+		}
+		if category1.DisplayOrder != 1 || category2.DisplayOrder != 2 {
+			t.Errorf("DisplayOrder of %s: Expected %v, but got %v", name, []int{1, 2}, []int{category1.DisplayOrder, category2.DisplayOrder})
+		}
+	}
+}
+
+func TestSetDescription(t *testing.T) {
+	// Prepare
+	category, err := NewCategory("Name1", 1)
+	if err != nil {
+		panic(err)
+	}
+	description := strings.Repeat("説", CategoryDescriptionMaxLength)
+
+	// Execute1
+	err = category.SetDescription(description)
+
+	// Check1
+	if err != nil {
+		t.Errorf("err of category.SetDescription: Expected %v, but got %v", nil, err)
+	}
+
+	// Execute2
+	err = category.SetDescription(description + "明")
+
+	// Check2
+	if !errs.IsKind(err, errs.Validation) {
+		t.Errorf("err of category.SetDescription: Expected %s, but got %v", errs.Validation, err)
+	}
+	if category.Description != description {
+		t.Errorf("category.Description: Expected %s, but got %s", "unchanged", category.Description)
+	}
+}
@@ -0,0 +1,117 @@
+package model
+
+import (
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/momonoki1990/tech-blog-api/domain/errs"
+)
+
+// 子カテゴリを持つカテゴリ（JSONではカテゴリの項目にchildrenが加わる）
+type CategoryNode struct {
+	*Category
+	Children []*CategoryNode `json:"children"`
+}
+
+// カテゴリの親子関係。親がゴミ箱にあるなど見つからないカテゴリは最上位として扱う
+type CategoryTree struct {
+	byId map[uuid.UUID]*Category
+	children map[uuid.UUID][]*Category
+	roots []*Category
+}
+
+func NewCategoryTree(categories []*Category) *CategoryTree {
+	t := &CategoryTree{
+		byId: make(map[uuid.UUID]*Category),
+		children: make(map[uuid.UUID][]*Category),
+	}
+	for _, v := range categories {
+		t.byId[v.Id] = v
+	}
+	for _, v := range categories {
+		if v.ParentId != nil && t.byId[*v.ParentId] != nil {
+			t.children[*v.ParentId] = append(t.children[*v.ParentId], v)
+		} else {
+			t.roots = append(t.roots, v)
+		}
+	}
+	sortCategories(t.roots)
+	for _, v := range t.children {
+		sortCategories(v)
+	}
+	return t
+}
+
+// 表示順（同じなら名前順）
+func sortCategories(categories []*Category) {
+	sort.SliceStable(categories, func(i, j int) bool {
+		if categories[i].DisplayOrder != categories[j].DisplayOrder {
+			return categories[i].DisplayOrder < categories[j].DisplayOrder
+		}
+		return categories[i].Name < categories[j].Name
+	})
+}
+
+// 最上位のカテゴリから順に、子を表示順に並べて返す
+func (t *CategoryTree) Nodes() []*CategoryNode {
+	return t.nodes(t.roots)
+}
+
+func (t *CategoryTree) nodes(categories []*Category) []*CategoryNode {
+	nodes := make([]*CategoryNode, 0, len(categories))
+	for _, v := range categories {
+		nodes = append(nodes, &CategoryNode{Category: v, Children: t.nodes(t.children[v.Id])})
+	}
+	return nodes
+}
+
+// idのカテゴリとその子孫すべてのId（idが見つからなければidだけ）
+func (t *CategoryTree) Descendants(id uuid.UUID) []uuid.UUID {
+	ids := []uuid.UUID{}
+	visited := make(map[uuid.UUID]bool)
+	queue := []uuid.UUID{id}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		// 同時に親を付け替えて循環した場合でも止まるように
+		if visited[current] {
+			continue
+		}
+		visited[current] = true
+		ids = append(ids, current)
+		for _, v := range t.children[current] {
+			queue = append(queue, v.Id)
+		}
+	}
+	return ids
+}
+
+// idのカテゴリの親をparentIdにできるか（nilなら最上位にする）
+func (t *CategoryTree) ValidateParent(id uuid.UUID, parentId *uuid.UUID) error {
+	if parentId == nil {
+		return nil
+	}
+	if t.byId[*parentId] == nil {
+		return parentViolation("parent category was not found")
+	}
+	// 親から最上位まで辿って自分が現れれば循環する
+	visited := make(map[uuid.UUID]bool)
+	for current := t.byId[*parentId]; current != nil; {
+		if current.Id == id {
+			return parentViolation("category cannot be a descendant of itself")
+		}
+		if visited[current.Id] {
+			break
+		}
+		visited[current.Id] = true
+		if current.ParentId == nil {
+			break
+		}
+		current = t.byId[*current.ParentId]
+	}
+	return nil
+}
+
+func parentViolation(message string) error {
+	return errs.NewValidation(errs.CodeValidationFailed, message, errs.FieldError{Field: "parentId", Message: message})
+}
@@ -0,0 +1,119 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/momonoki1990/tech-blog-api/domain/errs"
+)
+
+// Backend > Go > Concurrency, Backend > Rust, Frontend
+func newTestCategories() (backend *Category, golang *Category, concurrency *Category, rust *Category, frontend *Category) {
+	newCategory := func(name string, displayOrder int, parent *Category) *Category {
+		c, err := NewCategory(name, displayOrder)
+		if err != nil {
+			panic(err)
+		}
+		if parent != nil {
+			c.ParentId = &parent.Id
+		}
+		return c
+	}
+	backend = newCategory("Backend", 1, nil)
+	golang = newCategory("Go", 2, backend)
+	concurrency = newCategory("Concurrency", 1, golang)
+	rust = newCategory("Rust", 1, backend)
+	frontend = newCategory("Frontend", 2, nil)
+	return
+}
+
+func TestCategoryTreeNodes(t *testing.T) {
+	// Prepare
+	backend, golang, concurrency, rust, frontend := newTestCategories()
+	// 親がゴミ箱にある（見つからない）カテゴリは最上位として扱う
+	orphan, err := NewCategory("Orphan", 3)
+	if err != nil {
+		panic(err)
+	}
+	missingParentId := uuid.New()
+	orphan.ParentId = &missingParentId
+
+	// Execute
+	nodes := NewCategoryTree([]*Category{frontend, concurrency, orphan, golang, rust, backend}).Nodes()
+
+	// Check
+	if len(nodes) != 3 || nodes[0].Id != backend.Id || nodes[1].Id != frontend.Id || nodes[2].Id != orphan.Id {
+		t.Fatalf("nodes: Expected %s, but got %v", "Backend, Frontend, Orphan", nodes)
+	}
+	children := nodes[0].Children
+	if len(children) != 2 || children[0].Id != rust.Id || children[1].Id != golang.Id {
+		t.Fatalf("nodes[0].Children: Expected %s, but got %v", "Rust, Go", children)
+	}
+	if len(children[1].Children) != 1 || children[1].Children[0].Id != concurrency.Id {
+		t.Errorf("children[1].Children: Expected %s, but got %v", "Concurrency", children[1].Children)
+	}
+	if nodes[1].Children == nil || len(nodes[1].Children) != 0 {
+		t.Errorf("nodes[1].Children: Expected %s, but got %v", "empty", nodes[1].Children)
+	}
+}
+
+func TestCategoryTreeDescendants(t *testing.T) {
+	// Prepare
+	backend, golang, concurrency, rust, frontend := newTestCategories()
+	tree := NewCategoryTree([]*Category{backend, golang, concurrency, rust, frontend})
+
+	// Execute
+	ids := tree.Descendants(backend.Id)
+
+	// Check
+	found := make(map[uuid.UUID]bool)
+	for _, v := range ids {
+		found[v] = true
+	}
+	if len(ids) != 4 || !found[backend.Id] || !found[golang.Id] || !found[concurrency.Id] || !found[rust.Id] {
+		t.Errorf("ids: Expected %s, but got %v", "Backend, Go, Concurrency, Rust", ids)
+	}
+}
+
+func TestSetParent(t *testing.T) {
+	// Prepare
+	backend, golang, concurrency, rust, frontend := newTestCategories()
+	tree := NewCategoryTree([]*Category{backend, golang, concurrency, rust, frontend})
+	missingParentId := uuid.New()
+	cases := []struct {
+		category *Category
+		parentId *uuid.UUID
+		ok bool
+	}{
+		{golang, &frontend.Id, true},
+		{golang, nil, true},
+		{rust, &golang.Id, true},
+		// 自分自身・子孫を親にすると循環する
+		{golang, &golang.Id, false},
+		{backend, &concurrency.Id, false},
+		{golang, &missingParentId, false},
+	}
+
+	for _, v := range cases {
+		previous := v.category.ParentId
+
+		// Execute
+		err := v.category.SetParent(v.parentId, tree)
+
+		// Check
+		if v.ok {
+			if err != nil || v.category.ParentId != v.parentId {
+				t.Errorf("SetParent(%v) of %s: Expected %v, but got %v", v.parentId, v.category.Name, nil, err)
+			}
+			v.category.ParentId = previous
+			continue
+		}
+		e, ok := errs.As(err)
+		if !ok || e.Kind != errs.Validation || len(e.Fields) != 1 || e.Fields[0].Field != "parentId" {
+			t.Errorf("SetParent(%v) of %s: Expected %s of parentId, but got %v", v.parentId, v.category.Name, errs.Validation, err)
+		}
+		if v.category.ParentId != previous {
+			t.Errorf("ParentId of %s: Expected %v, but got %v", v.category.Name, previous, v.category.ParentId)
+		}
+	}
+}
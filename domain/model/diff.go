@@ -0,0 +1,140 @@
+package model
+
+import "strings"
+
+type DiffOp string
+
+const (
+	DiffEqual DiffOp = "equal"
+	DiffInsert DiffOp = "insert"
+	DiffDelete DiffOp = "delete"
+)
+
+// 行番号は1から。追加行にOldLine、削除行にNewLineはない（0）
+type DiffLine struct {
+	Op DiffOp `json:"op"`
+	Text string `json:"text"`
+	OldLine int `json:"oldLine,omitempty"`
+	NewLine int `json:"newLine,omitempty"`
+}
+
+// これを超える編集が必要な場合は、全行の削除と追加として扱う（計算量とメモリを抑える）
+const diffMaxEdits = 1000
+
+// 行単位の差分（Myersのアルゴリズム）
+func DiffLines(from string, to string) []DiffLine {
+	a, b := splitLines(from), splitLines(to)
+	var lines []DiffLine
+	trace, ok := shortestEdit(a, b)
+	if ok {
+		lines = backtrack(trace, a, b)
+	} else {
+		for _, v := range a {
+			lines = append(lines, DiffLine{Op: DiffDelete, Text: v})
+		}
+		for _, v := range b {
+			lines = append(lines, DiffLine{Op: DiffInsert, Text: v})
+		}
+	}
+
+	oldLine, newLine := 0, 0
+	for i := range lines {
+		switch lines[i].Op {
+		case DiffEqual:
+			oldLine++
+			newLine++
+			lines[i].OldLine, lines[i].NewLine = oldLine, newLine
+		case DiffDelete:
+			oldLine++
+			lines[i].OldLine = oldLine
+		case DiffInsert:
+			newLine++
+			lines[i].NewLine = newLine
+		}
+	}
+	if lines == nil {
+		return []DiffLine{}
+	}
+	return lines
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return []string{}
+	}
+	return strings.Split(strings.ReplaceAll(s, "\r\n", "\n"), "\n")
+}
+
+// trace[d][k+d]は、編集回数dで対角線k（x-y）上に進める最大のx
+func shortestEdit(a []string, b []string) ([][]int, bool) {
+	n, m := len(a), len(b)
+	var trace [][]int
+	for d := 0; d <= n+m; d++ {
+		if d > diffMaxEdits {
+			return nil, false
+		}
+		v := make([]int, 2*d+1)
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if d > 0 {
+				prev := trace[d-1]
+				if k == -d || (k != d && prev[k-1+d-1] < prev[k+1+d-1]) {
+					x = prev[k+1+d-1]
+				} else {
+					x = prev[k-1+d-1] + 1
+				}
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[k+d] = x
+			if x >= n && y >= m {
+				return append(trace, v), true
+			}
+		}
+		trace = append(trace, v)
+	}
+	return trace, true
+}
+
+func backtrack(trace [][]int, a []string, b []string) []DiffLine {
+	var reversed []DiffLine
+	x, y := len(a), len(b)
+	for d := len(trace) - 1; d > 0; d-- {
+		prev := trace[d-1]
+		k := x - y
+		var prevK int
+		if k == -d || (k != d && prev[k-1+d-1] < prev[k+1+d-1]) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := prev[prevK+d-1]
+		prevY := prevX - prevK
+		for x > prevX && y > prevY {
+			reversed = append(reversed, DiffLine{Op: DiffEqual, Text: a[x-1]})
+			x--
+			y--
+		}
+		if x == prevX {
+			reversed = append(reversed, DiffLine{Op: DiffInsert, Text: b[y-1]})
+			y--
+		} else {
+			reversed = append(reversed, DiffLine{Op: DiffDelete, Text: a[x-1]})
+			x--
+		}
+	}
+	for x > 0 && y > 0 {
+		reversed = append(reversed, DiffLine{Op: DiffEqual, Text: a[x-1]})
+		x--
+		y--
+	}
+
+	lines := make([]DiffLine, 0, len(reversed))
+	for i := len(reversed) - 1; i >= 0; i-- {
+		lines = append(lines, reversed[i])
+	}
+	return lines
+}
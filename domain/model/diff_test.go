@@ -0,0 +1,74 @@
+package model
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDiffLines(t *testing.T) {
+	// Prepare
+	from := "a\nb\nc\nd"
+	to := "a\nc\nd\ne"
+
+	// Execute
+	actual := DiffLines(from, to)
+
+	// Check
+	expected := []DiffLine{
+		{Op: DiffEqual, Text: "a", OldLine: 1, NewLine: 1},
+		{Op: DiffDelete, Text: "b", OldLine: 2},
+		{Op: DiffEqual, Text: "c", OldLine: 3, NewLine: 2},
+		{Op: DiffEqual, Text: "d", OldLine: 4, NewLine: 3},
+		{Op: DiffInsert, Text: "e", NewLine: 4},
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("DiffLines: Expected %v, but got %v", expected, actual)
+	}
+}
+
+func TestDiffLinesEdgeCases(t *testing.T) {
+	cases := []struct {
+		from string
+		to string
+		expected []DiffOp
+	}{
+		{"", "", []DiffOp{}},
+		{"", "a\nb", []DiffOp{DiffInsert, DiffInsert}},
+		{"a\nb", "", []DiffOp{DiffDelete, DiffDelete}},
+		{"a\r\nb", "a\nb", []DiffOp{DiffEqual, DiffEqual}},
+		{"x\na\nb", "a\nb\ny", []DiffOp{DiffDelete, DiffEqual, DiffEqual, DiffInsert}},
+	}
+	for _, c := range cases {
+		// Execute
+		actual := []DiffOp{}
+		for _, v := range DiffLines(c.from, c.to) {
+			actual = append(actual, v.Op)
+		}
+
+		// Check
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("DiffLines(%q, %q): Expected %v, but got %v", c.from, c.to, c.expected, actual)
+		}
+	}
+}
+
+func TestDiffLinesTooManyEdits(t *testing.T) {
+	// Prepare
+	var from, to []string
+	for i := 0; i < diffMaxEdits; i++ {
+		from = append(from, "a")
+		to = append(to, "b")
+	}
+
+	// Execute
+	actual := DiffLines(strings.Join(from, "\n"), strings.Join(to, "\n"))
+
+	// Check
+	if len(actual) != 2*diffMaxEdits {
+		t.Errorf("len(actual): Expected %d, but got %d", 2*diffMaxEdits, len(actual))
+	}
+	if actual[0].Op != DiffDelete || actual[len(actual)-1].Op != DiffInsert {
+		t.Errorf("actual: Expected deletes then inserts, but got %v ... %v", actual[0], actual[len(actual)-1])
+	}
+}
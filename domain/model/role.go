@@ -0,0 +1,84 @@
+package model
+
+import (
+	"fmt"
+)
+
+// ユーザーの役割。役割ごとに使える権限が決まっている
+type Role string
+
+const (
+	// 自分の記事の下書きを書く（公開にはeditorのレビューが要る）
+	RoleWriter Role = "writer"
+	// 誰の記事でも編集・公開でき、タグとゴミ箱を管理する
+	RoleEditor Role = "editor"
+	// editorの権限に加え、カテゴリとユーザーを管理する
+	RoleAdmin Role = "admin"
+)
+
+// 操作に必要な権限（"リソース:操作"）
+type Permission string
+
+const (
+	PermArticlesReadDrafts Permission = "articles:read_drafts"
+	// カテゴリの集計のうち、公開前・ゴミ箱の記事の件数を見る
+	PermCategoriesRead Permission = "categories:read"
+	// 記事を作り、自分の下書きを編集・削除する
+	PermArticlesWrite Permission = "articles:write"
+	// 他のユーザーの記事を編集・削除する
+	PermArticlesEditAny Permission = "articles:edit_any"
+	// 公開状態を変える、または公開済み・予約中の記事を変更する
+	PermArticlesPublish Permission = "articles:publish"
+	PermTagsManage Permission = "tags:manage"
+	// ゴミ箱の一覧と、記事の復元
+	PermTrashManage Permission = "trash:manage"
+	PermCategoriesManage Permission = "categories:manage"
+	PermUsersManage Permission = "users:manage"
+)
+
+var writerPermissions = []Permission{PermArticlesReadDrafts, PermCategoriesRead, PermArticlesWrite}
+
+var editorPermissions = append(append([]Permission{}, writerPermissions...),
+	PermArticlesEditAny, PermArticlesPublish, PermTagsManage, PermTrashManage,
+)
+
+var adminPermissions = append(append([]Permission{}, editorPermissions...),
+	PermCategoriesManage, PermUsersManage,
+)
+
+var rolePermissions = map[Role][]Permission{
+	RoleWriter: writerPermissions,
+	RoleEditor: editorPermissions,
+	RoleAdmin: adminPermissions,
+}
+
+func ParseRole(v string) (Role, error) {
+	role := Role(v)
+	if _, ok := rolePermissions[role]; !ok {
+		return "", fmt.Errorf("Unknown role %q", v)
+	}
+	return role, nil
+}
+
+// APIキーのスコープに使う。adminはすべての権限を持つ
+func ParsePermission(v string) (Permission, error) {
+	for _, p := range adminPermissions {
+		if string(p) == v {
+			return p, nil
+		}
+	}
+	return "", fmt.Errorf("Unknown permission %q", v)
+}
+
+func (r Role) Permissions() []Permission {
+	return rolePermissions[r]
+}
+
+func (r Role) Can(p Permission) bool {
+	for _, v := range rolePermissions[r] {
+		if v == p {
+			return true
+		}
+	}
+	return false
+}
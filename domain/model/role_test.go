@@ -0,0 +1,48 @@
+package model
+
+import (
+	"testing"
+)
+
+func TestRoleCan(t *testing.T) {
+	tests := []struct {
+		role Role
+		permission Permission
+		expected bool
+	}{
+		{RoleWriter, PermArticlesWrite, true},
+		{RoleWriter, PermCategoriesRead, true},
+		{RoleWriter, PermArticlesPublish, false},
+		{RoleWriter, PermArticlesEditAny, false},
+		{RoleEditor, PermArticlesPublish, true},
+		{RoleEditor, PermCategoriesManage, false},
+		{RoleAdmin, PermCategoriesManage, true},
+		{RoleAdmin, PermArticlesWrite, true},
+		{Role("unknown"), PermArticlesReadDrafts, false},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.role)+" "+string(tt.permission), func(t *testing.T) {
+			// Execute
+			actual := tt.role.Can(tt.permission)
+
+			// Check
+			if actual != tt.expected {
+				t.Errorf("%s.Can(%s): Expected %v, but got %v", tt.role, tt.permission, tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestParseRole(t *testing.T) {
+	// Execute
+	role, err := ParseRole("editor")
+	_, unknownErr := ParseRole("owner")
+
+	// Check
+	if err != nil || role != RoleEditor {
+		t.Errorf("ParseRole(\"editor\"): Expected %v, but got %v %v", RoleEditor, role, err)
+	}
+	if unknownErr == nil {
+		t.Errorf("err of ParseRole(\"owner\"): Expected an error, but got nil")
+	}
+}
@@ -0,0 +1,181 @@
+package model
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/google/uuid"
+	"golang.org/x/text/unicode/norm"
+)
+
+const SlugMaxLength = 100
+
+var slugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// タイトルからスラッグを作る
+// かなはヘボン式でローマ字にする。漢字は読みが決まらないため、漢字を含むタイトルは英数字のみを使う
+// 使える文字が残らない場合はIdの先頭8文字にする
+func GenerateSlug(title string, id uuid.UUID) string {
+	rs := []rune(strings.ToLower(norm.NFKC.String(title)))
+	hasHan := false
+	for _, r := range rs {
+		if unicode.Is(unicode.Han, r) {
+			hasHan = true
+			break
+		}
+	}
+
+	var b strings.Builder
+	const (
+		none = iota
+		ascii
+		kana
+	)
+	prev := none
+	write := func(kind int, s string) {
+		if s == "" {
+			return
+		}
+		if kind != prev && b.Len() > 0 {
+			b.WriteByte('-')
+		}
+		b.WriteString(s)
+		prev = kind
+	}
+	for i := 0; i < len(rs); {
+		r := rs[i]
+		switch {
+		case r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+			write(ascii, string(r))
+			i++
+		case !hasHan && isKana(rs[i]):
+			romaji, n := romanize(rs[i:])
+			write(kana, romaji)
+			i += n
+		default:
+			prev = none
+			i++
+		}
+	}
+
+	slug := truncateSlug(b.String(), SlugMaxLength)
+	if slug == "" {
+		return id.String()[:8]
+	}
+	return slug
+}
+
+// 連番を付けても長さの上限を超えないよう、ハイフンの位置で切り詰める
+func truncateSlug(slug string, max int) string {
+	if len(slug) <= max {
+		return slug
+	}
+	slug = slug[:max]
+	if i := strings.LastIndexByte(slug, '-'); i > 0 {
+		slug = slug[:i]
+	}
+	return strings.Trim(slug, "-")
+}
+
+// 重複した場合の候補（例: go-intro-2）
+func SlugWithSuffix(slug string, n int) string {
+	suffix := fmt.Sprintf("-%d", n)
+	return truncateSlug(slug, SlugMaxLength-len(suffix)) + suffix
+}
+
+func slugViolation(slug string) string {
+	if slug == "" {
+		return "slug is required"
+	}
+	if len(slug) > SlugMaxLength {
+		return fmt.Sprintf("slug should be at most %d characters", SlugMaxLength)
+	}
+	if !slugPattern.MatchString(slug) {
+		return "slug should consist of lowercase letters, numbers and single hyphens"
+	}
+	return ""
+}
+
+func isKana(r rune) bool {
+	return unicode.Is(unicode.Hiragana, r) || unicode.Is(unicode.Katakana, r) || r == 'ー'
+}
+
+// カタカナはひらがなにそろえてから変換する
+func toHiragana(r rune) rune {
+	if r >= 'ァ' && r <= 'ヶ' {
+		return r - ('ァ' - 'ぁ')
+	}
+	return r
+}
+
+// 先頭のかな（拗音は2文字）をローマ字にし、消費した文字数を返す
+func romanize(rs []rune) (string, int) {
+	first := toHiragana(rs[0])
+	switch first {
+	case 'ー':
+		// 長音は書かない（例: ラーメン -> ramen）
+		return "", 1
+	case 'っ':
+		// 促音は次の子音を重ねる（例: きっと -> kitto）
+		if len(rs) > 1 {
+			next, n := romanize(rs[1:])
+			if next != "" && !strings.ContainsRune("aiueon", rune(next[0])) {
+				if strings.HasPrefix(next, "ch") {
+					return "t" + next, n + 1
+				}
+				return next[:1] + next, n + 1
+			}
+		}
+		return "", 1
+	}
+	if len(rs) > 1 {
+		if romaji, ok := kanaDigraphs[string([]rune{first, toHiragana(rs[1])})]; ok {
+			return romaji, 2
+		}
+	}
+	if romaji, ok := kanaRomaji[first]; ok {
+		return romaji, 1
+	}
+	return "", 1
+}
+
+var kanaRomaji = map[rune]string{
+	'あ': "a", 'い': "i", 'う': "u", 'え': "e", 'お': "o",
+	'か': "ka", 'き': "ki", 'く': "ku", 'け': "ke", 'こ': "ko",
+	'さ': "sa", 'し': "shi", 'す': "su", 'せ': "se", 'そ': "so",
+	'た': "ta", 'ち': "chi", 'つ': "tsu", 'て': "te", 'と': "to",
+	'な': "na", 'に': "ni", 'ぬ': "nu", 'ね': "ne", 'の': "no",
+	'は': "ha", 'ひ': "hi", 'ふ': "fu", 'へ': "he", 'ほ': "ho",
+	'ま': "ma", 'み': "mi", 'む': "mu", 'め': "me", 'も': "mo",
+	'や': "ya", 'ゆ': "yu", 'よ': "yo",
+	'ら': "ra", 'り': "ri", 'る': "ru", 'れ': "re", 'ろ': "ro",
+	'わ': "wa", 'ゐ': "i", 'ゑ': "e", 'を': "o", 'ん': "n",
+	'が': "ga", 'ぎ': "gi", 'ぐ': "gu", 'げ': "ge", 'ご': "go",
+	'ざ': "za", 'じ': "ji", 'ず': "zu", 'ぜ': "ze", 'ぞ': "zo",
+	'だ': "da", 'ぢ': "ji", 'づ': "zu", 'で': "de", 'ど': "do",
+	'ば': "ba", 'び': "bi", 'ぶ': "bu", 'べ': "be", 'ぼ': "bo",
+	'ぱ': "pa", 'ぴ': "pi", 'ぷ': "pu", 'ぺ': "pe", 'ぽ': "po",
+	'ぁ': "a", 'ぃ': "i", 'ぅ': "u", 'ぇ': "e", 'ぉ': "o",
+	'ゃ': "ya", 'ゅ': "yu", 'ょ': "yo", 'ゎ': "wa",
+	'ゔ': "vu", 'ゕ': "ka", 'ゖ': "ke",
+}
+
+var kanaDigraphs = map[string]string{
+	"きゃ": "kya", "きゅ": "kyu", "きょ": "kyo",
+	"しゃ": "sha", "しゅ": "shu", "しょ": "sho", "しぇ": "she",
+	"ちゃ": "cha", "ちゅ": "chu", "ちょ": "cho", "ちぇ": "che",
+	"にゃ": "nya", "にゅ": "nyu", "にょ": "nyo",
+	"ひゃ": "hya", "ひゅ": "hyu", "ひょ": "hyo",
+	"みゃ": "mya", "みゅ": "myu", "みょ": "myo",
+	"りゃ": "rya", "りゅ": "ryu", "りょ": "ryo",
+	"ぎゃ": "gya", "ぎゅ": "gyu", "ぎょ": "gyo",
+	"じゃ": "ja", "じゅ": "ju", "じょ": "jo", "じぇ": "je",
+	"びゃ": "bya", "びゅ": "byu", "びょ": "byo",
+	"ぴゃ": "pya", "ぴゅ": "pyu", "ぴょ": "pyo",
+	"ふぁ": "fa", "ふぃ": "fi", "ふぇ": "fe", "ふぉ": "fo",
+	"てぃ": "ti", "でぃ": "di", "とぅ": "tu", "どぅ": "du",
+	"うぃ": "wi", "うぇ": "we", "うぉ": "wo",
+	"ゔぁ": "va", "ゔぃ": "vi", "ゔぇ": "ve", "ゔぉ": "vo",
+}
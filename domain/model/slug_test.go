@@ -0,0 +1,85 @@
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestGenerateSlug(t *testing.T) {
+	id, err := uuid.Parse("1a2b3c4d-1111-1111-1111-111111111111")
+	if err != nil {
+		panic(err)
+	}
+	cases := []struct {
+		title string
+		expected string
+	}{
+		{"Hello, World!", "hello-world"},
+		{"Ｇｏ　ｔｉｐｓ", "go-tips"},
+		{"はじめてのGo", "hajimeteno-go"},
+		{"ラーメンとチャーハン", "ramentochahan"},
+		{"きっとうまくいく", "kittoumakuiku"},
+		{"ちょっと待って", "1a2b3c4d"},
+		{"Go言語でAPIを作る", "go-api"},
+		{"東京", "1a2b3c4d"},
+		{"!!!", "1a2b3c4d"},
+	}
+	for _, c := range cases {
+		// Execute
+		actual := GenerateSlug(c.title, id)
+
+		// Check
+		if actual != c.expected {
+			t.Errorf("GenerateSlug(%q): Expected %s, but got %s", c.title, c.expected, actual)
+		}
+	}
+}
+
+func TestGenerateSlugTruncate(t *testing.T) {
+	// Execute
+	actual := GenerateSlug(strings.Repeat("abcdefghi ", 20), uuid.New())
+
+	// Check
+	if len(actual) > SlugMaxLength {
+		t.Errorf("len(actual): Expected at most %d, but got %d", SlugMaxLength, len(actual))
+	}
+	if strings.HasSuffix(actual, "-") {
+		t.Errorf("actual: Expected not to end with '-', but got %s", actual)
+	}
+}
+
+func TestSlugWithSuffix(t *testing.T) {
+	// Execute
+	actual := SlugWithSuffix("go-tips", 2)
+
+	// Check
+	if actual != "go-tips-2" {
+		t.Errorf("SlugWithSuffix: Expected %s, but got %s", "go-tips-2", actual)
+	}
+}
+
+func TestSetSlug(t *testing.T) {
+	// Prepare
+	article, err := NewArticle("Title1", "Content1", uuid.New(), []string{}, false)
+	if err != nil {
+		panic(err)
+	}
+
+	// Execute & Check
+	if err := article.SetSlug("my-first-post"); err != nil {
+		t.Errorf("err of SetSlug: Expected %v, but got %v", nil, err)
+	}
+	if article.Slug != "my-first-post" {
+		t.Errorf("article.Slug: Expected %s, but got %s", "my-first-post", article.Slug)
+	}
+	for _, invalid := range []string{"", "My-Post", "my--post", "-my-post", "my_post", "記事", strings.Repeat("a", SlugMaxLength+1)} {
+		if err := article.SetSlug(invalid); err == nil {
+			t.Errorf("err of SetSlug(%q): Expected validation error, but got nil", invalid)
+		}
+	}
+	if article.Slug != "my-first-post" {
+		t.Errorf("article.Slug: Expected %s, but got %s", "my-first-post", article.Slug)
+	}
+}
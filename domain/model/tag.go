@@ -0,0 +1,21 @@
+package model
+
+import (
+	"github.com/momonoki1990/tech-blog-api/domain/errs"
+)
+
+// タグと、そのタグを付けている記事の数（ゴミ箱の記事は数えない）
+type TagUsage struct {
+	Name string `json:"name"`
+	ArticleCount int `json:"articleCount"`
+	// 公開前の記事を読めない送り手には返さない（nil）
+	PublishedCount *int `json:"publishedCount,omitempty"`
+}
+
+// 改名・統合先のタグ名も、記事に付けるときと同じ規則で検証する
+func ValidateTagName(field string, name string) error {
+	if message := tagNameViolation(name); message != "" {
+		return errs.NewValidation(errs.CodeValidationFailed, message, errs.FieldError{Field: field, Message: message})
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"golang.org/x/text/unicode/norm"
+	"golang.org/x/text/width"
+)
+
+// タグ名の正規化の1段階
+type TagNormalizer func(name string) string
+
+// 互換文字を統一する（例: ＧＯ -> GO, ｺﾞ -> ゴ, ㈱ -> (株)）
+func NormalizeNFKC(name string) string {
+	return norm.NFKC.String(name)
+}
+
+// 全角英数字・記号を半角に、半角カナを全角にする
+func FoldWidth(name string) string {
+	return width.Fold.String(name)
+}
+
+// 前後の空白を除き、途中の連続する空白（全角を含む）を半角1つにする
+func TrimTagName(name string) string {
+	return strings.Join(strings.Fields(name), " ")
+}
+
+// ASCIIの英字だけ小文字にする（言語によって結果が変わる文字は変換しない）
+func FoldASCIICase(name string) string {
+	return strings.Map(func(r rune) rune {
+		if 'A' <= r && r <= 'Z' {
+			return r + ('a' - 'A')
+		}
+		return r
+	}, name)
+}
+
+// 設定で指定する正規化の名前
+var tagNormalizers = map[string]TagNormalizer{
+	"nfkc": NormalizeNFKC,
+	"width": FoldWidth,
+	"trim": TrimTagName,
+	"ascii-case": FoldASCIICase,
+}
+
+// 設定がない場合に使う正規化（この順に適用する）
+var DefaultTagNormalizerNames = []string{"nfkc", "width", "trim", "ascii-case"}
+
+func TagNormalizersByNames(names []string) ([]TagNormalizer, error) {
+	var normalizers []TagNormalizer
+	for _, v := range names {
+		normalizer, ok := tagNormalizers[v]
+		if !ok {
+			return nil, fmt.Errorf("Unknown tag normalizer %q", v)
+		}
+		normalizers = append(normalizers, normalizer)
+	}
+	return normalizers, nil
+}
+
+// 別名（例: golang）と正式なタグ名（例: Go）
+type TagAlias struct {
+	Alias string `json:"alias"`
+	TagName string `json:"tagName"`
+}
+
+// タグ名を正規化し、別名なら正式なタグ名に置き換える
+// 別名はSetAliasesで置き換えられる（使用中のリクエストと同時に呼んでよい）
+type TagNameRules struct {
+	normalizers []TagNormalizer
+	mu sync.RWMutex
+	aliases map[string]string
+}
+
+func NewTagNameRules(normalizers []TagNormalizer, aliases []*TagAlias) *TagNameRules {
+	r := &TagNameRules{normalizers: normalizers}
+	r.SetAliases(aliases)
+	return r
+}
+
+// 別名を読み込み直したものに置き換える（再起動せずに別名の追加・変更を反映する）
+func (r *TagNameRules) SetAliases(aliases []*TagAlias) {
+	m := make(map[string]string)
+	// 正式なタグ名が正規化で変わらないようにする（Go -> go にしない）
+	for _, v := range aliases {
+		m[r.normalize(v.TagName)] = v.TagName
+	}
+	// 別名も正規化しておく（Golang, ｇｏｌａｎｇ なども同じ別名として扱う）
+	for _, v := range aliases {
+		m[r.normalize(v.Alias)] = v.TagName
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.aliases = m
+}
+
+// 正式なタグ名はそのまま使う（大文字を含むGoなども指定できる）
+func (r *TagNameRules) Canonical(name string) string {
+	normalized := r.normalize(name)
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if tagName, ok := r.aliases[normalized]; ok {
+		return tagName
+	}
+	return normalized
+}
+
+func (r *TagNameRules) normalize(name string) string {
+	for _, v := range r.normalizers {
+		name = v(name)
+	}
+	return name
+}
+
+// 記事に付ける前のタグ名をまとめて変換する（重複はSetTags・NewArticleで除く）
+func (r *TagNameRules) CanonicalNames(names []string) []string {
+	canonical := make([]string, len(names))
+	for i, v := range names {
+		canonical[i] = r.Canonical(v)
+	}
+	return canonical
+}
@@ -0,0 +1,88 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func newDefaultTagNameRules(aliases []*TagAlias) *TagNameRules {
+	normalizers, err := TagNormalizersByNames(DefaultTagNormalizerNames)
+	if err != nil {
+		panic(err)
+	}
+	return NewTagNameRules(normalizers, aliases)
+}
+
+func TestTagNameRulesCanonical(t *testing.T) {
+	// Prepare
+	rules := newDefaultTagNameRules([]*TagAlias{{Alias: "Golang", TagName: "Go"}})
+	cases := []struct {
+		name string
+		expected string
+	}{
+		{"Go", "Go"},
+		{"go ", "Go"},
+		{"golang", "Go"},
+		{"ｇｏｌａｎｇ", "Go"},
+		{"ＲＵＳＴ", "rust"},
+		{"ｺﾞﾗﾝｸﾞ", "ゴラング"},
+		{"　Node   RED ", "node red"},
+		{"Vue.js", "vue.js"},
+		{"C#", "c#"},
+		{"日本語", "日本語"},
+	}
+
+	for _, v := range cases {
+		// Execute
+		canonical := rules.Canonical(v.name)
+
+		// Check
+		if canonical != v.expected {
+			t.Errorf("rules.Canonical(%q): Expected %q, but got %q", v.name, v.expected, canonical)
+		}
+	}
+}
+
+func TestTagNormalizersByNamesUnknown(t *testing.T) {
+	// Execute
+	_, err := TagNormalizersByNames([]string{"nfkc", "lower"})
+
+	// Check
+	if err == nil {
+		t.Errorf("err of TagNormalizersByNames: Expected %s, but got %v", "unknown normalizer error", err)
+	}
+}
+
+func TestSetTagsCanonical(t *testing.T) {
+	// Prepare
+	rules := newDefaultTagNameRules([]*TagAlias{{Alias: "golang", TagName: "Go"}})
+	article1, err := NewArticle("Title1", "Content1", uuid.New(), []string{"Go", "Rust"}, false)
+	if err != nil {
+		panic(err)
+	}
+
+	// Execute
+	article1.SetTags(rules.CanonicalNames([]string{"golang", "Go ", "ＲＵＳＴ", "rust"}))
+
+	// Check: 正規化・別名の置き換えをした後の名前で重複を除く
+	if len(article1.Tags) != 2 || article1.Tags[0].Name != "Go" || article1.Tags[1].Name != "rust" {
+		t.Errorf("article1.Tags: Expected %s, but got %v", "[Go rust]", article1.Tags)
+	}
+}
+
+func TestTagNameRulesSetAliases(t *testing.T) {
+	// Prepare
+	rules := newDefaultTagNameRules([]*TagAlias{{Alias: "golang", TagName: "Go"}})
+
+	// Execute
+	rules.SetAliases([]*TagAlias{{Alias: "js", TagName: "JavaScript"}})
+
+	// Check: 読み込み直した別名だけを使う
+	if got := rules.Canonical("JS"); got != "JavaScript" {
+		t.Errorf("Canonical(JS): Expected %s, but got %s", "JavaScript", got)
+	}
+	if got := rules.Canonical("golang"); got != "golang" {
+		t.Errorf("Canonical(golang): Expected %s, but got %s", "golang", got)
+	}
+}
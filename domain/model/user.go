@@ -0,0 +1,77 @@
+package model
+
+import (
+	"fmt"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/momonoki1990/tech-blog-api/domain/errs"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// 管理画面にログインするユーザー
+type User struct {
+	Id uuid.UUID `json:"id"`
+	// ログインに使う名前
+	Name string `json:"name"`
+	// bcryptのハッシュ。レスポンスには含めない
+	PasswordHash string `json:"-"`
+	Role Role `json:"role"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+const (
+	UserNameMaxLength = 50
+	PasswordMinLength = 12
+	// bcryptは72バイトを超える部分を無視するので、それより長いパスワードは受け付けない
+	PasswordMaxBytes = 72
+)
+
+func NewUser(name string, password string, role Role) (*User, error) {
+	var fields []errs.FieldError
+	if _, err := ParseRole(string(role)); err != nil {
+		fields = append(fields, errs.FieldError{Field: "role", Message: err.Error()})
+	}
+	if name == "" || utf8.RuneCountInString(name) > UserNameMaxLength {
+		fields = append(fields, errs.FieldError{Field: "name", Message: fmt.Sprintf("name should be from %d to %d characters", 1, UserNameMaxLength)})
+	}
+	if utf8.RuneCountInString(password) < PasswordMinLength || len(password) > PasswordMaxBytes {
+		fields = append(fields, errs.FieldError{Field: "password", Message: fmt.Sprintf("password should be at least %d characters and at most %d bytes", PasswordMinLength, PasswordMaxBytes)})
+	}
+	if err := errs.NewValidationFromFields(errs.CodeValidationFailed, fields); err != nil {
+		return nil, err
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+	return &User{
+		Id: uuid.New(),
+		Name: name,
+		PasswordHash: string(hash),
+		Role: role,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}, nil
+}
+
+func (u *User) VerifyPassword(password string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(password)) == nil
+}
+
+// 存在しないユーザー名でも照合にかかる時間を揃えるためのハッシュ（どのパスワードとも一致しない）
+// パッケージを読み込むだけで（テストやコマンドでも）bcryptが走らないよう、初めて使うときに作る
+var (
+	dummyPasswordHash []byte
+	dummyPasswordHashOnce sync.Once
+)
+
+func VerifyDummyPassword(password string) {
+	dummyPasswordHashOnce.Do(func() {
+		dummyPasswordHash, _ = bcrypt.GenerateFromPassword([]byte("dummy password for timing"), bcrypt.DefaultCost)
+	})
+	bcrypt.CompareHashAndPassword(dummyPasswordHash, []byte(password))
+}
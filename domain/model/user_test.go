@@ -0,0 +1,79 @@
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/momonoki1990/tech-blog-api/domain/errs"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestNewUser(t *testing.T) {
+	// Execute
+	user, err := NewUser("admin", "correct horse battery", RoleAdmin)
+	if err != nil {
+		panic(err)
+	}
+
+	// Check
+	if user.Name != "admin" {
+		t.Errorf("user.Name: Expected %v, but got %v", "admin", user.Name)
+	}
+	// 平文のパスワードは保存しない
+	if user.PasswordHash == "correct horse battery" {
+		t.Errorf("user.PasswordHash: Expected a hash, but got the password")
+	}
+	if !user.VerifyPassword("correct horse battery") {
+		t.Errorf("user.VerifyPassword(password): Expected %v, but got %v", true, false)
+	}
+	if user.VerifyPassword("correct horse batterY") {
+		t.Errorf("user.VerifyPassword(wrong password): Expected %v, but got %v", false, true)
+	}
+}
+
+func TestNewUserValidationError(t *testing.T) {
+	tests := []struct {
+		name string
+		userName string
+		password string
+		field string
+	}{
+		{"名前が空", "", "correct horse battery", "name"},
+		{"名前が長すぎる", strings.Repeat("a", UserNameMaxLength+1), "correct horse battery", "name"},
+		{"パスワードが短すぎる", "admin", "short", "password"},
+		{"パスワードが72バイトを超える", "admin", strings.Repeat("a", PasswordMaxBytes+1), "password"},
+	}
+	// 役割の検証
+	if _, err := NewUser("admin", "correct horse battery", Role("owner")); !errs.IsKind(err, errs.Validation) {
+		t.Errorf("err of NewUser(role owner): Expected %v, but got %v", errs.Validation, err)
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Execute
+			user, err := NewUser(tt.userName, tt.password, RoleAdmin)
+
+			// Check
+			if user != nil {
+				t.Errorf("user: Expected %v, but got %v", nil, user)
+			}
+			e, ok := errs.As(err)
+			if !ok || e.Kind != errs.Validation || len(e.Fields) != 1 || e.Fields[0].Field != tt.field {
+				t.Errorf("err: Expected a validation error of %s, but got %v", tt.field, err)
+			}
+		})
+	}
+}
+
+func TestVerifyDummyPassword(t *testing.T) {
+	// Execute
+	VerifyDummyPassword("correct horse battery")
+
+	// Check: 実際のユーザーと同じコストで照合する
+	cost, err := bcrypt.Cost(dummyPasswordHash)
+	if err != nil {
+		t.Fatalf("err of bcrypt.Cost: Expected %v, but got %v", nil, err)
+	}
+	if cost != bcrypt.DefaultCost {
+		t.Errorf("cost: Expected %d, but got %d", bcrypt.DefaultCost, cost)
+	}
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+)
+
+type ApiKeyRepository interface {
+	// 見つからなければnil
+	FindOneByHash(ctx context.Context, keyHash string) (*model.ApiKey, error)
+	FindOneById(ctx context.Context, id uuid.UUID) (*model.ApiKey, error)
+	// 作成日時の新しい順
+	FindAllByUserId(ctx context.Context, userId uuid.UUID) ([]*model.ApiKey, error)
+	Insert(ctx context.Context, k *model.ApiKey) (error)
+	UpdateLastUsedAt(ctx context.Context, id uuid.UUID, lastUsedAt time.Time) (error)
+	Delete(ctx context.Context, id uuid.UUID) (error)
+}
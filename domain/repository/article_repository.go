@@ -1,14 +1,67 @@
 package repository
 
 import (
+	"context"
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/momonoki1990/tech-blog-api/domain/model"
 )
 
+type ArticleSortKey int
+
+const (
+	SortByPublishedAt ArticleSortKey = iota
+	SortByCreatedAt
+)
+
+// 一覧の絞り込み・並び順・ページングの条件（nilのフィールドは絞り込みなし）
+type ArticleCriteria struct {
+	CategoryId *uuid.UUID
+	// trueならCategoryIdの子孫のカテゴリの記事も含める
+	IncludeDescendantCategories bool
+	TagName *string
+	AuthorId *uuid.UUID
+	Status *model.Status
+	PublishedFrom *time.Time
+	PublishedTo *time.Time
+	SortKey ArticleSortKey
+	Ascending bool
+	Limit int
+	After *ArticleCursor
+}
+
+// 前ページ最後の記事の並び替えキーとId（キーセットページング用）
+type ArticleCursor struct {
+	SortValue *time.Time
+	Id uuid.UUID
+}
+
 type ArticleRepository interface {
-	FindOneById(id uuid.UUID) (*model.Article, error)
-	Find() ([]*model.Article, error)
-	Insert(*model.Article) (error)
-	Update(*model.Article) (error)
-	Delete(id uuid.UUID) (error)
-}
\ No newline at end of file
+	FindOneById(ctx context.Context, id uuid.UUID) (*model.Article, error)
+	// 過去のスラッグでも見つかる（返却する記事のSlugは現在のもの）
+	FindOneBySlug(ctx context.Context, slug string) (*model.Article, error)
+	// ゴミ箱の記事も含めて、slugを現在のスラッグにしている記事のIdを返す（なければnil）
+	FindIdBySlug(ctx context.Context, slug string) (*uuid.UUID, error)
+	// 次ページがない場合、返却するカーソルはnil
+	Find(ctx context.Context, criteria *ArticleCriteria) ([]*model.Article, *ArticleCursor, error)
+	Insert(ctx context.Context, a *model.Article) (error)
+	Update(ctx context.Context, a *model.Article) (error)
+	// ゴミ箱に移す（以降はFind系で見つからない）
+	// versionが一致しない場合はPreconditionFailed（Updateも同様）
+	Delete(ctx context.Context, id uuid.UUID, version int) (error)
+	// ゴミ箱の記事（削除した日時の新しい順）
+	FindDeleted(ctx context.Context) ([]*model.Article, error)
+	// ゴミ箱から戻し、削除時のタグを付け直す
+	Restore(ctx context.Context, id uuid.UUID) (error)
+	// beforeより前にゴミ箱に移した記事を完全に削除し、その件数を返す
+	Purge(ctx context.Context, before time.Time) (int64, error)
+	// カテゴリに属する記事の件数を状態別に数える
+	CountByCategory(ctx context.Context, categoryId uuid.UUID) (*model.CategoryArticleCounts, error)
+	// fromのカテゴリの記事（ゴミ箱の記事を含む）をtoに移し、その件数を返す
+	ReassignCategory(ctx context.Context, from uuid.UUID, to uuid.UUID) (int64, error)
+	// 公開済みの記事（ゴミ箱を除く）をlocでの公開年月ごとに数える（新しい月から）
+	CountPublishedByMonth(ctx context.Context, loc *time.Location) ([]*model.ArchiveMonth, error)
+	// 公開日時がnow以前の予約投稿を公開済みにし、その件数を返す
+	PublishScheduled(ctx context.Context, now time.Time) (int64, error)
+}
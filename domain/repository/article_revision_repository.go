@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+)
+
+type ArticleRevisionRepository interface {
+	// 新しい版から順に返す
+	FindByArticleId(ctx context.Context, articleId uuid.UUID) ([]*model.ArticleRevision, error)
+	FindOne(ctx context.Context, articleId uuid.UUID, number int) (*model.ArticleRevision, error)
+	// 版がなければnil
+	FindLatest(ctx context.Context, articleId uuid.UUID) (*model.ArticleRevision, error)
+	Insert(ctx context.Context, r *model.ArticleRevision) (error)
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+)
+
+// 検索結果1件（Scoreの降順で返す）
+type ArticleHit struct {
+	Article *model.Article
+	Score float64
+	// HTMLエスケープ済み、一致箇所を<mark>で囲む
+	TitleHighlight string
+	ContentSnippet string
+}
+
+// 公開済みの記事のみを対象に全文検索する
+type ArticleSearcher interface {
+	Search(ctx context.Context, query string, limit int) ([]*ArticleHit, error)
+}
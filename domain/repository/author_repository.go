@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+)
+
+type AuthorRepository interface {
+	// 表示名の順
+	Find(ctx context.Context) ([]*model.Author, error)
+	// 見つからなければnil
+	FindOneBySlug(ctx context.Context, slug string) (*model.Author, error)
+	FindOneByUserId(ctx context.Context, userId uuid.UUID) (*model.Author, error)
+	// 見つからないスラッグは無視する
+	FindBySlugs(ctx context.Context, slugs []string) ([]*model.Author, error)
+	// スラッグが重複するか、ユーザーに既に著者がいればConflict
+	Insert(ctx context.Context, a *model.Author) (error)
+}
@@ -1,15 +1,36 @@
 package repository
 
 import (
+	"context"
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/momonoki1990/tech-blog-api/domain/model"
 )
 
 type CategoryRepository interface {
-	FindOneByName(name string) (*model.Category, error)
-	FindOneById(id uuid.UUID) (*model.Category, error)
-	Find() ([]*model.Category, error)
-	Insert(*model.Category) (error)
-	Update(*model.Category) (error)
-	Delete(id uuid.UUID) (error)
+	// ゴミ箱のカテゴリも含めて、nameを使っているカテゴリのIdを返す（なければnil）
+	FindIdByName(ctx context.Context, name string) (*uuid.UUID, error)
+	FindOneById(ctx context.Context, id uuid.UUID) (*model.Category, error)
+	// FindOneByIdと同じカテゴリを行ロックして読む（記事をカテゴリに入れる処理と、カテゴリの削除を順に行わせる）
+	FindOneByIdForUpdate(ctx context.Context, id uuid.UUID) (*model.Category, error)
+	// ゴミ箱のカテゴリも含めて、slugを使っているカテゴリのIdを返す（なければnil）
+	FindIdBySlug(ctx context.Context, slug string) (*uuid.UUID, error)
+	// 表示順・名前の順
+	Find(ctx context.Context) ([]*model.Category, error)
+	// Findと同じカテゴリを行ロックして読む（トランザクションの終わりまで他の親子関係の変更を待たせる）
+	FindForUpdate(ctx context.Context) ([]*model.Category, error)
+	// idsのカテゴリの記事の集計（記事のないカテゴリも含む）
+	FindStats(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*model.CategoryStats, error)
+	Insert(ctx context.Context, c *model.Category) (error)
+	Update(ctx context.Context, c *model.Category) (error)
+	// ゴミ箱に移す（以降はFind系で見つからない）
+	// versionが一致しない場合はPreconditionFailed（Updateも同様）
+	Delete(ctx context.Context, id uuid.UUID, version int) (error)
+	// ゴミ箱のカテゴリ（削除した日時の新しい順）
+	FindDeleted(ctx context.Context) ([]*model.Category, error)
+	Restore(ctx context.Context, id uuid.UUID) (error)
+	// beforeより前にゴミ箱に移したカテゴリを完全に削除し、その件数を返す
+	// 記事（ゴミ箱の記事を含む）が残っているカテゴリは削除しない
+	Purge(ctx context.Context, before time.Time) (int64, error)
 }
\ No newline at end of file
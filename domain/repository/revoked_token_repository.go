@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+// 有効期限より前に失効させたトークンのId
+type RevokedTokenRepository interface {
+	// 既に失効させていたIdならfalseを返す（同時に失効させても、trueになるのは1つだけ）
+	Insert(ctx context.Context, tokenId string, expiresAt time.Time) (bool, error)
+	Exists(ctx context.Context, tokenId string) (bool, error)
+	// 有効期限がbeforeより前の行を削除し、その件数を返す（期限切れのトークンはどのみち使えない）
+	DeleteExpired(ctx context.Context, before time.Time) (int64, error)
+}
@@ -0,0 +1,11 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+)
+
+type TagAliasRepository interface {
+	Find(ctx context.Context) ([]*model.TagAlias, error)
+}
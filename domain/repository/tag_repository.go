@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+)
+
+type TagSortKey int
+
+const (
+	// 記事の多い順（同数なら名前順）
+	SortTagsByCount TagSortKey = iota
+	SortTagsByName
+)
+
+type TagRepository interface {
+	Find(ctx context.Context, sortKey TagSortKey) ([]*model.TagUsage, error)
+	FindOneByName(ctx context.Context, name string) (*model.TagUsage, error)
+	// fromを付けた記事（ゴミ箱の記事を含む）のタグをtoに付け替え、fromを削除する
+	// toがなければ作る。既にtoが付いている記事からはfromを外すだけ
+	Merge(ctx context.Context, from string, to string) (error)
+}
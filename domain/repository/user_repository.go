@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+)
+
+type UserRepository interface {
+	// 見つからなければnil
+	FindOneByName(ctx context.Context, name string) (*model.User, error)
+	FindOneById(ctx context.Context, id uuid.UUID) (*model.User, error)
+	// 同じ名前のユーザーがいればConflict
+	Insert(ctx context.Context, u *model.User) (error)
+}
@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/momonoki1990/tech-blog-api/domain/errs"
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+	"github.com/momonoki1990/tech-blog-api/domain/repository"
+)
+
+// 記事の保存と同じトランザクションでカテゴリを確かめるため、rにはトランザクションのリポジトリを渡す（ArticleValidatorも同様）
+type ArticleCreator interface {
+	Create(ctx context.Context, r repository.CategoryRepository, title string, content string, categoryId uuid.UUID, tagNames []string, shouldPublish bool) (*model.Article, error)
+}
+
+// 変更後の記事の不変条件とカテゴリの存在をまとめて検証する
+type ArticleValidator interface {
+	Validate(ctx context.Context, r repository.CategoryRepository, a *model.Article) error
+}
+
+// 記事のスラッグが他の記事と重ならないようにする
+// 重複の確認と保存を同じトランザクションで行うため、rにはトランザクションのリポジトリを渡す
+type ArticleSlugAssigner interface {
+	// slugが空ならタイトルから生成したスラッグに、重複があれば連番を付ける
+	// 指定したslugを他の記事が使用中の場合はConflict（他の記事の過去のスラッグは引き継げる）
+	Assign(ctx context.Context, r repository.ArticleRepository, a *model.Article, slug string) error
+}
+
+type articleCreator struct {
+	tagNameRules *model.TagNameRules
+}
+
+func NewArticleCreator(rules *model.TagNameRules) ArticleCreator {
+	return &articleCreator{rules}
+}
+
+func (s *articleCreator) Create(ctx context.Context, r repository.CategoryRepository, title string, content string, categoryId uuid.UUID, tagNames []string, shouldPublish bool) (*model.Article, error) {
+	fields, err := categoryViolations(ctx, r, categoryId)
+	if err != nil {
+		return nil, err
+	}
+	a, err := model.NewArticle(title, content, categoryId, s.tagNameRules.CanonicalNames(tagNames), shouldPublish)
+	if err != nil {
+		e, ok := errs.As(err)
+		if !ok || e.Kind != errs.Validation {
+			return nil, err
+		}
+		fields = append(e.Fields, fields...)
+	}
+	if err := errs.NewValidationFromFields(errs.CodeValidationFailed, fields); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+type articleValidator struct {}
+
+func NewArticleValidator() ArticleValidator {
+	return &articleValidator{}
+}
+
+func (s *articleValidator) Validate(ctx context.Context, r repository.CategoryRepository, a *model.Article) error {
+	fields, err := categoryViolations(ctx, r, a.CategoryId)
+	if err != nil {
+		return err
+	}
+	fields = append(a.Violations(), fields...)
+	return errs.NewValidationFromFields(errs.CodeValidationFailed, fields)
+}
+
+// カテゴリを行ロックし、保存を終えるまで同時に行われるカテゴリの削除を待たせる
+func categoryViolations(ctx context.Context, r repository.CategoryRepository, categoryId uuid.UUID) ([]errs.FieldError, error) {
+	c, err := r.FindOneByIdForUpdate(ctx, categoryId)
+	if err != nil {
+		return nil, err
+	}
+	if c == nil {
+		return []errs.FieldError{{Field: "categoryId", Message: "category does not exist"}}, nil
+	}
+	return nil, nil
+}
+
+type articleSlugAssigner struct {}
+
+func NewArticleSlugAssigner() ArticleSlugAssigner {
+	return &articleSlugAssigner{}
+}
+
+func (s *articleSlugAssigner) Assign(ctx context.Context, r repository.ArticleRepository, a *model.Article, slug string) error {
+	if slug != "" {
+		if err := a.SetSlug(slug); err != nil {
+			return err
+		}
+		// ゴミ箱の記事もスラッグを使ったままなので重複とする
+		id, err := r.FindIdBySlug(ctx, slug)
+		if err != nil {
+			return err
+		}
+		if id != nil && *id != a.Id {
+			return errs.NewConflict(errs.CodeArticleSlugConflict, "Article slug is already in use")
+		}
+		return nil
+	}
+
+	base := a.Slug
+	for n := 2; ; n++ {
+		taken, err := isSlugTaken(ctx, r, a)
+		if err != nil {
+			return err
+		}
+		if !taken {
+			return nil
+		}
+		a.Slug = model.SlugWithSuffix(base, n)
+	}
+}
+
+// 生成したスラッグは、ゴミ箱の記事のスラッグや他の記事の過去のスラッグとも重ならないようにする
+func isSlugTaken(ctx context.Context, r repository.ArticleRepository, a *model.Article) (bool, error) {
+	id, err := r.FindIdBySlug(ctx, a.Slug)
+	if err != nil {
+		return false, err
+	}
+	if id != nil {
+		return *id != a.Id, nil
+	}
+	found, err := r.FindOneBySlug(ctx, a.Slug)
+	if err != nil {
+		return false, err
+	}
+	return found != nil && found.Id != a.Id, nil
+}
@@ -0,0 +1,225 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/momonoki1990/tech-blog-api/domain/errs"
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+	mock_repo "github.com/momonoki1990/tech-blog-api/infra/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestArticleCreatorCreate(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := context.TODO()
+
+	// Prepare1
+	mockCategoryRepository := mock_repo.NewMockCategoryRepository(mockCtrl)
+	creator := NewArticleCreator(model.NewTagNameRules(nil, nil))
+	category, err := model.NewCategory("Name1", 1)
+	if err != nil {
+		panic(err)
+	}
+
+	// Expected & Mock
+	mockCategoryRepository.EXPECT().FindOneByIdForUpdate(ctx, category.Id).Return(category, nil)
+
+	// Execute1
+	article1, err := creator.Create(ctx, mockCategoryRepository, "Title1", "Content1", category.Id, []string{"Tag1"}, true)
+
+	// Check1
+	if err != nil {
+		t.Errorf("err of creator.Create: Expected %v, but got %v", nil, err)
+	}
+	if article1 == nil {
+		t.Errorf("article1: Expected %s, but got %v", "not nil", article1)
+	}
+}
+
+func TestArticleCreatorCreateCanonicalTags(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := context.TODO()
+
+	// Prepare
+	mockCategoryRepository := mock_repo.NewMockCategoryRepository(mockCtrl)
+	normalizers, err := model.TagNormalizersByNames(model.DefaultTagNormalizerNames)
+	if err != nil {
+		panic(err)
+	}
+	creator := NewArticleCreator(model.NewTagNameRules(normalizers, []*model.TagAlias{{Alias: "golang", TagName: "Go"}}))
+	category, err := model.NewCategory("Name1", 1)
+	if err != nil {
+		panic(err)
+	}
+
+	// Expected & Mock
+	mockCategoryRepository.EXPECT().FindOneByIdForUpdate(ctx, category.Id).Return(category, nil)
+
+	// Execute
+	article1, err := creator.Create(ctx, mockCategoryRepository, "Title1", "Content1", category.Id, []string{"golang", "Go", "ＲＵＳＴ"}, true)
+
+	// Check: 渡した規則で正規化・別名の置き換えをしてから重複を除く
+	if err != nil {
+		t.Fatalf("err of creator.Create: Expected %v, but got %v", nil, err)
+	}
+	if len(article1.Tags) != 2 || article1.Tags[0].Name != "Go" || article1.Tags[1].Name != "rust" {
+		t.Errorf("article1.Tags: Expected %s, but got %v", "[Go rust]", article1.Tags)
+	}
+}
+
+func TestArticleCreatorCreateValidationError(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := context.TODO()
+
+	// Prepare1
+	mockCategoryRepository := mock_repo.NewMockCategoryRepository(mockCtrl)
+	creator := NewArticleCreator(model.NewTagNameRules(nil, nil))
+	categoryId, err := uuid.Parse("11111111-1111-1111-1111-111111111111")
+	if err != nil {
+		panic(err)
+	}
+
+	// Expected & Mock
+	mockCategoryRepository.EXPECT().FindOneByIdForUpdate(ctx, categoryId).Return(nil, nil)
+
+	// Execute1
+	article1, err := creator.Create(ctx, mockCategoryRepository, "", "Content1", categoryId, []string{"Tag1"}, false)
+
+	// Check1
+	if article1 != nil {
+		t.Errorf("article1: Expected %v, but got %v", nil, article1)
+	}
+	e, ok := errs.As(err)
+	if !ok {
+		t.Fatalf("err: Expected %s, but got %v", "*errs.Error", err)
+	}
+	// タイトルとカテゴリの違反がまとめて返ること
+	if len(e.Fields) != 2 || e.Fields[0].Field != "title" || e.Fields[1].Field != "categoryId" {
+		t.Errorf("e.Fields: Expected %s, but got %v", "title,categoryId", e.Fields)
+	}
+}
+
+func TestArticleValidatorValidate(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := context.TODO()
+
+	// Prepare1
+	mockCategoryRepository := mock_repo.NewMockCategoryRepository(mockCtrl)
+	validator := NewArticleValidator()
+	categoryId, err := uuid.Parse("11111111-1111-1111-1111-111111111111")
+	if err != nil {
+		panic(err)
+	}
+	article1, err := model.NewArticle("Title1", "Content1", categoryId, []string{"Tag1"}, false)
+	if err != nil {
+		panic(err)
+	}
+	article1.Content = ""
+	article1.SetStatus(model.Published)
+
+	// Expected & Mock
+	mockCategoryRepository.EXPECT().FindOneByIdForUpdate(ctx, categoryId).Return(nil, nil)
+
+	// Execute1
+	err = validator.Validate(ctx, mockCategoryRepository, article1)
+
+	// Check1
+	e, ok := errs.As(err)
+	if !ok {
+		t.Fatalf("err: Expected %s, but got %v", "*errs.Error", err)
+	}
+	if len(e.Fields) != 2 || e.Fields[0].Field != "content" || e.Fields[1].Field != "categoryId" {
+		t.Errorf("e.Fields: Expected %s, but got %v", "content,categoryId", e.Fields)
+	}
+}
+
+func TestArticleSlugAssignerAssignGenerated(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := context.TODO()
+
+	// Prepare
+	mockArticleRepository := mock_repo.NewMockArticleRepository(mockCtrl)
+	assigner := NewArticleSlugAssigner()
+	article, err := model.NewArticle("Go tips", "Content1", uuid.New(), []string{}, false)
+	if err != nil {
+		panic(err)
+	}
+	// ゴミ箱にある"go-tips"の記事（FindOneBySlugでは見つからない）
+	other1, err := model.NewArticle("Go tips", "Content1", uuid.New(), []string{}, false)
+	if err != nil {
+		panic(err)
+	}
+	// 過去に"go-tips-2"を使っていた記事
+	other2, err := model.NewArticle("Go tips 2", "Content1", uuid.New(), []string{}, false)
+	if err != nil {
+		panic(err)
+	}
+	other2.Slug = "go-tips-renamed"
+
+	// Expected & Mock
+	mockArticleRepository.EXPECT().FindIdBySlug(ctx, "go-tips").Return(&other1.Id, nil)
+	mockArticleRepository.EXPECT().FindIdBySlug(ctx, "go-tips-2").Return(nil, nil)
+	mockArticleRepository.EXPECT().FindOneBySlug(ctx, "go-tips-2").Return(other2, nil)
+	mockArticleRepository.EXPECT().FindIdBySlug(ctx, "go-tips-3").Return(nil, nil)
+	mockArticleRepository.EXPECT().FindOneBySlug(ctx, "go-tips-3").Return(nil, nil)
+
+	// Execute
+	err = assigner.Assign(ctx, mockArticleRepository, article, "")
+
+	// Check
+	if err != nil {
+		t.Errorf("err of assigner.Assign: Expected %v, but got %v", nil, err)
+	}
+	if article.Slug != "go-tips-3" {
+		t.Errorf("article.Slug: Expected %s, but got %s", "go-tips-3", article.Slug)
+	}
+}
+
+func TestArticleSlugAssignerAssignSpecified(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := context.TODO()
+
+	// Prepare
+	mockArticleRepository := mock_repo.NewMockArticleRepository(mockCtrl)
+	assigner := NewArticleSlugAssigner()
+	article, err := model.NewArticle("Title1", "Content1", uuid.New(), []string{}, false)
+	if err != nil {
+		panic(err)
+	}
+	other, err := model.NewArticle("Title2", "Content2", uuid.New(), []string{}, false)
+	if err != nil {
+		panic(err)
+	}
+	other.Slug = "taken"
+
+	// Expected & Mock
+	// ゴミ箱の記事のスラッグも使用中とする
+	mockArticleRepository.EXPECT().FindIdBySlug(ctx, "taken").Return(&other.Id, nil)
+	// 他の記事の過去のスラッグは引き継げる
+	mockArticleRepository.EXPECT().FindIdBySlug(ctx, "old-slug").Return(nil, nil)
+
+	// Execute & Check
+	err = assigner.Assign(ctx, mockArticleRepository, article, "taken")
+	if !errs.IsKind(err, errs.Conflict) {
+		t.Errorf("err of assigner.Assign(taken): Expected conflict error, but got %v", err)
+	}
+	err = assigner.Assign(ctx, mockArticleRepository, article, "old-slug")
+	if err != nil {
+		t.Errorf("err of assigner.Assign(old-slug): Expected %v, but got %v", nil, err)
+	}
+	if article.Slug != "old-slug" {
+		t.Errorf("article.Slug: Expected %s, but got %s", "old-slug", article.Slug)
+	}
+	err = assigner.Assign(ctx, mockArticleRepository, article, "Invalid Slug")
+	if !errs.IsKind(err, errs.Validation) {
+		t.Errorf("err of assigner.Assign(Invalid Slug): Expected validation error, but got %v", err)
+	}
+}
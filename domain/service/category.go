@@ -1,14 +1,25 @@
 package service
 
 import (
-	"errors"
+	"context"
 
+	"github.com/google/uuid"
+	"github.com/momonoki1990/tech-blog-api/domain/errs"
 	"github.com/momonoki1990/tech-blog-api/domain/model"
 	"github.com/momonoki1990/tech-blog-api/domain/repository"
 )
 
 type CategoryCreator interface {
-	Create(name string, displayOrder int) (*model.Category, error)
+	// parentIdがnilなら最上位のカテゴリにする
+	Create(ctx context.Context, name string, displayOrder int, parentId *uuid.UUID) (*model.Category, error)
+}
+
+// カテゴリのスラッグが他のカテゴリ（ゴミ箱のカテゴリを含む）と重ならないようにする
+// 重複の確認と保存を同じトランザクションで行うため、rにはトランザクションのリポジトリを渡す
+type CategorySlugAssigner interface {
+	// slugが空なら名前から生成したスラッグ（c.Slug）に、重複があれば連番を付ける
+	// 指定したslugを他のカテゴリが使用中の場合はConflict
+	Assign(ctx context.Context, r repository.CategoryRepository, c *model.Category, slug string) error
 }
 
 type categoryCreator struct {
@@ -19,15 +30,66 @@ func NewCategoryCreator(r repository.CategoryRepository) CategoryCreator {
 	return &categoryCreator{r}
 }
 
-func (s *categoryCreator) Create(name string, displayOrder int) (*model.Category, error) {
-	c, err := s.CategoryRepository.FindOneByName(name)
+func (s *categoryCreator) Create(ctx context.Context, name string, displayOrder int, parentId *uuid.UUID) (*model.Category, error) {
+	// ゴミ箱のカテゴリも名前を使ったままなので重複とする
+	id, err := s.CategoryRepository.FindIdByName(ctx, name)
 	if err != nil {
 		return nil, err
 	}
-	if c != nil {
-		return nil, errors.New("Category name is already registered")
+	if id != nil {
+		return nil, errs.NewConflict(errs.CodeCategoryNameConflict, "Category name is already registered")
 	}
-	c, err = model.NewCategory(name, displayOrder)
-	return c, err
+	c, err := model.NewCategory(name, displayOrder)
+	if err != nil {
+		return nil, err
+	}
+	if parentId != nil {
+		parent, err := s.CategoryRepository.FindOneById(ctx, *parentId)
+		if err != nil {
+			return nil, err
+		}
+		// 新しいカテゴリには子がないので、親が存在すれば循環しない
+		var categories []*model.Category
+		if parent != nil {
+			categories = []*model.Category{parent}
+		}
+		if err := c.SetParent(parentId, model.NewCategoryTree(categories)); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
 }
 
+type categorySlugAssigner struct {}
+
+func NewCategorySlugAssigner() CategorySlugAssigner {
+	return &categorySlugAssigner{}
+}
+
+func (s *categorySlugAssigner) Assign(ctx context.Context, r repository.CategoryRepository, c *model.Category, slug string) error {
+	if slug != "" {
+		if err := c.SetSlug(slug); err != nil {
+			return err
+		}
+		id, err := r.FindIdBySlug(ctx, slug)
+		if err != nil {
+			return err
+		}
+		if id != nil && *id != c.Id {
+			return errs.NewConflict(errs.CodeCategorySlugConflict, "Category slug is already in use")
+		}
+		return nil
+	}
+
+	base := c.Slug
+	for n := 2; ; n++ {
+		id, err := r.FindIdBySlug(ctx, c.Slug)
+		if err != nil {
+			return err
+		}
+		if id == nil || *id == c.Id {
+			return nil
+		}
+		c.Slug = model.SlugWithSuffix(base, n)
+	}
+}
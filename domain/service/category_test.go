@@ -1,8 +1,12 @@
 package service
 
 import (
+	"context"
 	"testing"
 
+	"github.com/google/uuid"
+	"github.com/momonoki1990/tech-blog-api/domain/errs"
+	"github.com/momonoki1990/tech-blog-api/domain/model"
 	mock_repo "github.com/momonoki1990/tech-blog-api/infra/mock"
 	"go.uber.org/mock/gomock"
 )
@@ -10,16 +14,17 @@ import (
 func TestCategoryCreatorCreate (t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
+	ctx := context.TODO()
 
 	// Prepare1
 	mockCategoryRepository := mock_repo.NewMockCategoryRepository(mockCtrl)
 	creator := NewCategoryCreator(mockCategoryRepository)
 
 	// Expected & Mock
-	mockCategoryRepository.EXPECT().FindOneByName("Name1").Return(nil, nil)
+	mockCategoryRepository.EXPECT().FindIdByName(ctx, "Name1").Return(nil, nil)
 
 	// Execute1
-	category1, err := creator.Create("Name1", 1)
+	category1, err := creator.Create(ctx, "Name1", 1, nil)
 	if err != nil {
 		panic(err)
 	}
@@ -33,27 +38,114 @@ func TestCategoryCreatorCreate (t *testing.T) {
 func TestCategoryCreatorCreateDuplicationError (t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
+	ctx := context.TODO()
 
 	// Prepare1
 	mockCategoryRepository := mock_repo.NewMockCategoryRepository(mockCtrl)
 	creator := NewCategoryCreator(mockCategoryRepository)
-	mockCategoryRepository.EXPECT().FindOneByName("Name1").Return(nil, nil)
-	category1, err := creator.Create("Name1", 1)
+	mockCategoryRepository.EXPECT().FindIdByName(ctx, "Name1").Return(nil, nil)
+	category1, err := creator.Create(ctx, "Name1", 1, nil)
 	if err != nil {
 		panic(err)
 	}
 
-	// Mock
-	mockCategoryRepository.EXPECT().FindOneByName("Name1").Return(category1, nil)
+	// Mock（category1はゴミ箱にあっても名前を使ったまま）
+	mockCategoryRepository.EXPECT().FindIdByName(ctx, "Name1").Return(&category1.Id, nil)
 
 	// Execute1
-	category2, err := creator.Create("Name1", 2)
+	category2, err := creator.Create(ctx, "Name1", 2, nil)
 	
 	// Check1
+	if !errs.IsKind(err, errs.Conflict) {
+		t.Errorf("err of creator.Create(ctx, 'Name1', 2): Expected %s, but got %v", errs.Conflict, err)
+	}
 	if err.Error() != "Category name is already registered" {
-		t.Errorf("err of creator.Create('Name1', 2): Expected %s, but got %s", "Category name is already registered", err.Error())
+		t.Errorf("err of creator.Create(ctx, 'Name1', 2): Expected %s, but got %s", "Category name is already registered", err.Error())
 	}
 	if category2 != nil {
 		t.Errorf("category2: Expected %v, but got %v", nil, category2)
 	}
-}
\ No newline at end of file
+}
+func TestCategoryCreatorCreateParentNotFound (t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := context.TODO()
+
+	// Prepare
+	mockCategoryRepository := mock_repo.NewMockCategoryRepository(mockCtrl)
+	creator := NewCategoryCreator(mockCategoryRepository)
+	parentId := uuid.New()
+
+	// Expected & Mock
+	mockCategoryRepository.EXPECT().FindIdByName(ctx, "Name1").Return(nil, nil)
+	mockCategoryRepository.EXPECT().FindOneById(ctx, parentId).Return(nil, nil)
+
+	// Execute
+	category, err := creator.Create(ctx, "Name1", 1, &parentId)
+
+	// Check
+	e, ok := errs.As(err)
+	if !ok || e.Kind != errs.Validation || len(e.Fields) != 1 || e.Fields[0].Field != "parentId" {
+		t.Errorf("err of creator.Create(ctx, 'Name1', 1, &parentId): Expected %s of parentId, but got %v", errs.Validation, err)
+	}
+	if category != nil {
+		t.Errorf("category: Expected %v, but got %v", nil, category)
+	}
+}
+
+func TestCategorySlugAssignerAssign (t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := context.TODO()
+
+	// Prepare
+	mockCategoryRepository := mock_repo.NewMockCategoryRepository(mockCtrl)
+	assigner := NewCategorySlugAssigner()
+	category, err := model.NewCategory("Backend", 1)
+	if err != nil {
+		panic(err)
+	}
+	otherId := uuid.New()
+
+	// Expected & Mock
+	mockCategoryRepository.EXPECT().FindIdBySlug(ctx, "backend").Return(&otherId, nil)
+	mockCategoryRepository.EXPECT().FindIdBySlug(ctx, "backend-2").Return(nil, nil)
+
+	// Execute
+	err = assigner.Assign(ctx, mockCategoryRepository, category, "")
+
+	// Check
+	if err != nil {
+		t.Errorf("err of assigner.Assign(ctx, category, ''): Expected %v, but got %v", nil, err)
+	}
+	if category.Slug != "backend-2" {
+		t.Errorf("category.Slug: Expected %s, but got %s", "backend-2", category.Slug)
+	}
+}
+
+func TestCategorySlugAssignerAssignConflict (t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := context.TODO()
+
+	// Prepare
+	mockCategoryRepository := mock_repo.NewMockCategoryRepository(mockCtrl)
+	assigner := NewCategorySlugAssigner()
+	category, err := model.NewCategory("Backend", 1)
+	if err != nil {
+		panic(err)
+	}
+	otherId := uuid.New()
+
+	// Expected & Mock
+	mockCategoryRepository.EXPECT().FindIdBySlug(ctx, "server-side").Return(&otherId, nil)
+
+	// Execute
+	err = assigner.Assign(ctx, mockCategoryRepository, category, "server-side")
+
+	// Check
+	e, ok := errs.As(err)
+	if !ok || e.Code != errs.CodeCategorySlugConflict {
+		t.Errorf("err of assigner.Assign(ctx, category, 'server-side'): Expected %s, but got %v", errs.CodeCategorySlugConflict, err)
+	}
+}
@@ -0,0 +1,131 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./domain/service/article.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./domain/service/article.go -destination=./domain/service/mock/article.go
+//
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	model "github.com/momonoki1990/tech-blog-api/domain/model"
+	repository "github.com/momonoki1990/tech-blog-api/domain/repository"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockArticleCreator is a mock of ArticleCreator interface.
+type MockArticleCreator struct {
+	ctrl     *gomock.Controller
+	recorder *MockArticleCreatorMockRecorder
+}
+
+// MockArticleCreatorMockRecorder is the mock recorder for MockArticleCreator.
+type MockArticleCreatorMockRecorder struct {
+	mock *MockArticleCreator
+}
+
+// NewMockArticleCreator creates a new mock instance.
+func NewMockArticleCreator(ctrl *gomock.Controller) *MockArticleCreator {
+	mock := &MockArticleCreator{ctrl: ctrl}
+	mock.recorder = &MockArticleCreatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockArticleCreator) EXPECT() *MockArticleCreatorMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockArticleCreator) Create(ctx context.Context, r repository.CategoryRepository, title, content string, categoryId uuid.UUID, tagNames []string, shouldPublish bool) (*model.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, r, title, content, categoryId, tagNames, shouldPublish)
+	ret0, _ := ret[0].(*model.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockArticleCreatorMockRecorder) Create(ctx, r, title, content, categoryId, tagNames, shouldPublish any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockArticleCreator)(nil).Create), ctx, r, title, content, categoryId, tagNames, shouldPublish)
+}
+
+// MockArticleValidator is a mock of ArticleValidator interface.
+type MockArticleValidator struct {
+	ctrl     *gomock.Controller
+	recorder *MockArticleValidatorMockRecorder
+}
+
+// MockArticleValidatorMockRecorder is the mock recorder for MockArticleValidator.
+type MockArticleValidatorMockRecorder struct {
+	mock *MockArticleValidator
+}
+
+// NewMockArticleValidator creates a new mock instance.
+func NewMockArticleValidator(ctrl *gomock.Controller) *MockArticleValidator {
+	mock := &MockArticleValidator{ctrl: ctrl}
+	mock.recorder = &MockArticleValidatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockArticleValidator) EXPECT() *MockArticleValidatorMockRecorder {
+	return m.recorder
+}
+
+// Validate mocks base method.
+func (m *MockArticleValidator) Validate(ctx context.Context, r repository.CategoryRepository, a *model.Article) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Validate", ctx, r, a)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Validate indicates an expected call of Validate.
+func (mr *MockArticleValidatorMockRecorder) Validate(ctx, r, a any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MockArticleValidator)(nil).Validate), ctx, r, a)
+}
+
+// MockArticleSlugAssigner is a mock of ArticleSlugAssigner interface.
+type MockArticleSlugAssigner struct {
+	ctrl     *gomock.Controller
+	recorder *MockArticleSlugAssignerMockRecorder
+}
+
+// MockArticleSlugAssignerMockRecorder is the mock recorder for MockArticleSlugAssigner.
+type MockArticleSlugAssignerMockRecorder struct {
+	mock *MockArticleSlugAssigner
+}
+
+// NewMockArticleSlugAssigner creates a new mock instance.
+func NewMockArticleSlugAssigner(ctrl *gomock.Controller) *MockArticleSlugAssigner {
+	mock := &MockArticleSlugAssigner{ctrl: ctrl}
+	mock.recorder = &MockArticleSlugAssignerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockArticleSlugAssigner) EXPECT() *MockArticleSlugAssignerMockRecorder {
+	return m.recorder
+}
+
+// Assign mocks base method.
+func (m *MockArticleSlugAssigner) Assign(ctx context.Context, r repository.ArticleRepository, a *model.Article, slug string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Assign", ctx, r, a, slug)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Assign indicates an expected call of Assign.
+func (mr *MockArticleSlugAssignerMockRecorder) Assign(ctx, r, a, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Assign", reflect.TypeOf((*MockArticleSlugAssigner)(nil).Assign), ctx, r, a, slug)
+}
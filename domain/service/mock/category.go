@@ -9,9 +9,12 @@
 package mock_service
 
 import (
+	context "context"
 	reflect "reflect"
 
+	uuid "github.com/google/uuid"
 	model "github.com/momonoki1990/tech-blog-api/domain/model"
+	repository "github.com/momonoki1990/tech-blog-api/domain/repository"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -39,16 +42,53 @@ func (m *MockCategoryCreator) EXPECT() *MockCategoryCreatorMockRecorder {
 }
 
 // Create mocks base method.
-func (m *MockCategoryCreator) Create(name string, displayOrder int) (*model.Category, error) {
+func (m *MockCategoryCreator) Create(ctx context.Context, name string, displayOrder int, parentId *uuid.UUID) (*model.Category, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", name, displayOrder)
+	ret := m.ctrl.Call(m, "Create", ctx, name, displayOrder, parentId)
 	ret0, _ := ret[0].(*model.Category)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockCategoryCreatorMockRecorder) Create(name, displayOrder any) *gomock.Call {
+func (mr *MockCategoryCreatorMockRecorder) Create(ctx, name, displayOrder, parentId any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCategoryCreator)(nil).Create), name, displayOrder)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCategoryCreator)(nil).Create), ctx, name, displayOrder, parentId)
+}
+
+// MockCategorySlugAssigner is a mock of CategorySlugAssigner interface.
+type MockCategorySlugAssigner struct {
+	ctrl     *gomock.Controller
+	recorder *MockCategorySlugAssignerMockRecorder
+}
+
+// MockCategorySlugAssignerMockRecorder is the mock recorder for MockCategorySlugAssigner.
+type MockCategorySlugAssignerMockRecorder struct {
+	mock *MockCategorySlugAssigner
+}
+
+// NewMockCategorySlugAssigner creates a new mock instance.
+func NewMockCategorySlugAssigner(ctrl *gomock.Controller) *MockCategorySlugAssigner {
+	mock := &MockCategorySlugAssigner{ctrl: ctrl}
+	mock.recorder = &MockCategorySlugAssignerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCategorySlugAssigner) EXPECT() *MockCategorySlugAssignerMockRecorder {
+	return m.recorder
+}
+
+// Assign mocks base method.
+func (m *MockCategorySlugAssigner) Assign(ctx context.Context, r repository.CategoryRepository, c *model.Category, slug string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Assign", ctx, r, c, slug)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Assign indicates an expected call of Assign.
+func (mr *MockCategorySlugAssignerMockRecorder) Assign(ctx, r, c, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Assign", reflect.TypeOf((*MockCategorySlugAssigner)(nil).Assign), ctx, r, c, slug)
 }
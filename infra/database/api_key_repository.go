@@ -0,0 +1,125 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+	"github.com/momonoki1990/tech-blog-api/domain/repository"
+	dbModel "github.com/momonoki1990/tech-blog-api/infra/database/model"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type ApiKeyRepository struct {
+	exec boil.ContextExecutor
+}
+
+func NewApiKeyRepository(exec boil.ContextExecutor) repository.ApiKeyRepository {
+	return &ApiKeyRepository{exec}
+}
+
+func (r *ApiKeyRepository) FindOneByHash(ctx context.Context, keyHash string) (*model.ApiKey, error) {
+	dbApiKey, err := dbModel.APIKeys(dbModel.APIKeyWhere.KeyHash.EQ(keyHash)).One(ctx, r.exec)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toApiKey(dbApiKey)
+}
+
+func (r *ApiKeyRepository) FindOneById(ctx context.Context, id uuid.UUID) (*model.ApiKey, error) {
+	dbApiKey, err := dbModel.FindAPIKey(ctx, r.exec, id.String())
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toApiKey(dbApiKey)
+}
+
+func (r *ApiKeyRepository) FindAllByUserId(ctx context.Context, userId uuid.UUID) ([]*model.ApiKey, error) {
+	dbApiKeys, err := dbModel.APIKeys(
+		dbModel.APIKeyWhere.UserID.EQ(userId.String()),
+		qm.OrderBy(dbModel.APIKeyColumns.CreatedAt+" DESC"),
+	).All(ctx, r.exec)
+	if err != nil {
+		return nil, err
+	}
+	apiKeys := make([]*model.ApiKey, 0, len(dbApiKeys))
+	for _, d := range dbApiKeys {
+		k, err := toApiKey(d)
+		if err != nil {
+			return nil, err
+		}
+		apiKeys = append(apiKeys, k)
+	}
+	return apiKeys, nil
+}
+
+func (r *ApiKeyRepository) Insert(ctx context.Context, k *model.ApiKey) (error) {
+	scopes := make([]string, len(k.Scopes))
+	for i, v := range k.Scopes {
+		scopes[i] = string(v)
+	}
+	dbApiKey := &dbModel.APIKey{
+		ID: k.Id.String(),
+		UserID: k.UserId.String(),
+		Name: k.Name,
+		Prefix: k.Prefix,
+		KeyHash: k.KeyHash,
+		Scopes: strings.Join(scopes, ","),
+		ExpiresAt: null.TimeFromPtr(k.ExpiresAt),
+		LastUsedAt: null.TimeFromPtr(k.LastUsedAt),
+		CreatedAt: k.CreatedAt,
+	}
+	return dbApiKey.Insert(ctx, r.exec, boil.Infer())
+}
+
+func (r *ApiKeyRepository) UpdateLastUsedAt(ctx context.Context, id uuid.UUID, lastUsedAt time.Time) (error) {
+	_, err := dbModel.APIKeys(dbModel.APIKeyWhere.ID.EQ(id.String())).UpdateAll(ctx, r.exec, dbModel.M{
+		dbModel.APIKeyColumns.LastUsedAt: lastUsedAt,
+	})
+	return err
+}
+
+func (r *ApiKeyRepository) Delete(ctx context.Context, id uuid.UUID) (error) {
+	_, err := dbModel.APIKeys(dbModel.APIKeyWhere.ID.EQ(id.String())).DeleteAll(ctx, r.exec)
+	return err
+}
+
+func toApiKey(d *dbModel.APIKey) (*model.ApiKey, error) {
+	id, err := uuid.Parse(d.ID)
+	if err != nil {
+		return nil, err
+	}
+	userId, err := uuid.Parse(d.UserID)
+	if err != nil {
+		return nil, err
+	}
+	// 権限が減った場合に備え、今は存在しないスコープは無視する
+	var scopes []model.Permission
+	for _, v := range strings.Split(d.Scopes, ",") {
+		if p, err := model.ParsePermission(v); err == nil {
+			scopes = append(scopes, p)
+		}
+	}
+	return &model.ApiKey{
+		Id: id,
+		UserId: userId,
+		Name: d.Name,
+		Prefix: d.Prefix,
+		KeyHash: d.KeyHash,
+		Scopes: scopes,
+		ExpiresAt: d.ExpiresAt.Ptr(),
+		LastUsedAt: d.LastUsedAt.Ptr(),
+		CreatedAt: d.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,76 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+)
+
+func TestApiKeyInsertFindAndDelete(t *testing.T) {
+	db := GetTestConnection()
+	ctx := context.TODO()
+	tx := GetTestTransaction(db, ctx)
+	defer tx.Rollback()
+
+	// Prepare
+	user, err := model.NewUser("ci-owner", "correct horse battery", model.RoleEditor)
+	if err != nil {
+		panic(err)
+	}
+	if err := NewUserRepository(tx).Insert(ctx, user); err != nil {
+		panic(err)
+	}
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	expiresAt := now.Add(24 * time.Hour)
+	apiKey, key, err := model.NewApiKey(user.Id, "CI", []string{"articles:write", "articles:publish"}, &expiresAt, now)
+	if err != nil {
+		panic(err)
+	}
+
+	// Execute
+	r := NewApiKeyRepository(tx)
+	if err := r.Insert(ctx, apiKey); err != nil {
+		panic(err)
+	}
+	if err := r.UpdateLastUsedAt(ctx, apiKey.Id, now.Add(time.Hour)); err != nil {
+		panic(err)
+	}
+	byHash, err := r.FindOneByHash(ctx, model.HashApiKey(key))
+	if err != nil {
+		panic(err)
+	}
+	byUser, err := r.FindAllByUserId(ctx, user.Id)
+	if err != nil {
+		panic(err)
+	}
+	if err := r.Delete(ctx, apiKey.Id); err != nil {
+		panic(err)
+	}
+	deleted, err := r.FindOneById(ctx, apiKey.Id)
+	if err != nil {
+		panic(err)
+	}
+
+	// Check
+	if byHash == nil || byHash.Id != apiKey.Id {
+		t.Fatalf("byHash: Expected %v, but got %v", apiKey, byHash)
+	}
+	if len(byHash.Scopes) != 2 || byHash.Scopes[1] != model.PermArticlesPublish {
+		t.Errorf("byHash.Scopes: Expected %v, but got %v", apiKey.Scopes, byHash.Scopes)
+	}
+	if byHash.ExpiresAt == nil || !byHash.ExpiresAt.Equal(expiresAt) {
+		t.Errorf("byHash.ExpiresAt: Expected %v, but got %v", expiresAt, byHash.ExpiresAt)
+	}
+	if byHash.LastUsedAt == nil || !byHash.LastUsedAt.Equal(now.Add(time.Hour)) {
+		t.Errorf("byHash.LastUsedAt: Expected %v, but got %v", now.Add(time.Hour), byHash.LastUsedAt)
+	}
+	if len(byUser) != 1 {
+		t.Errorf("len(byUser): Expected %d, but got %d", 1, len(byUser))
+	}
+	if deleted != nil {
+		t.Errorf("deleted: Expected %v, but got %v", nil, deleted)
+	}
+}
@@ -3,30 +3,32 @@ package database
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/momonoki1990/tech-blog-api/domain/errs"
 	"github.com/momonoki1990/tech-blog-api/domain/model"
 	"github.com/momonoki1990/tech-blog-api/domain/repository"
 	dbModel "github.com/momonoki1990/tech-blog-api/infra/database/model"
 	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
 type ArticleRepository struct {
-	ctx context.Context
 	exec boil.ContextExecutor
 }
 
-func NewArticleRepository(ctx context.Context,  exec boil.ContextExecutor) repository.ArticleRepository {
-    return &ArticleRepository{ctx, exec}
+func NewArticleRepository(exec boil.ContextExecutor) repository.ArticleRepository {
+    return &ArticleRepository{exec}
 }
 
-func (r *ArticleRepository)FindOneById(id uuid.UUID) (*model.Article, error) {
-	dbArticle, err := dbModel.Articles(dbModel.ArticleWhere.ID.EQ(id.String())).One(r.ctx, r.exec)
+func (r *ArticleRepository)FindOneById(ctx context.Context, id uuid.UUID) (*model.Article, error) {
+	dbArticle, err := dbModel.Articles(dbModel.ArticleWhere.ID.EQ(id.String()), dbModel.ArticleWhere.DeletedAt.IsNull()).One(ctx, r.exec)
 	if err != nil && err != sql.ErrNoRows {
 		return nil, err
 	}
@@ -34,41 +36,224 @@ func (r *ArticleRepository)FindOneById(id uuid.UUID) (*model.Article, error) {
 		return nil, nil
 	}
 	
-	article, err := toArticle(dbArticle, r)
+	articles, err := toArticles(ctx, []*dbModel.Article{dbArticle}, r)
 	if err != nil {
 		return nil, err
 	}
-	return article, nil
+	return articles[0], nil
+}
+
+func (r *ArticleRepository) FindOneBySlug(ctx context.Context, slug string) (*model.Article, error) {
+	dbArticle, err := dbModel.Articles(dbModel.ArticleWhere.Slug.EQ(slug), dbModel.ArticleWhere.DeletedAt.IsNull()).One(ctx, r.exec)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+	if dbArticle != nil {
+		articles, err := toArticles(ctx, []*dbModel.Article{dbArticle}, r)
+		if err != nil {
+			return nil, err
+		}
+		return articles[0], nil
+	}
+
+	// 変更前のスラッグ
+	history, err := dbModel.FindArticleSlugHistory(ctx, r.exec, slug)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+	if history == nil {
+		return nil, nil
+	}
+	id, err := uuid.Parse(history.ArticleID)
+	if err != nil {
+		return nil, err
+	}
+	return r.FindOneById(ctx, id)
 }
 
-func (r *ArticleRepository) Find() ([]*model.Article, error) {
-	dbArticles, err := dbModel.Articles().All(r.ctx, r.exec)
+func (r *ArticleRepository) FindIdBySlug(ctx context.Context, slug string) (*uuid.UUID, error) {
+	dbArticle, err := dbModel.Articles(qm.Select(dbModel.ArticleColumns.ID), dbModel.ArticleWhere.Slug.EQ(slug)).One(ctx, r.exec)
 	if err == sql.ErrNoRows {
-		return []*model.Article{}, nil
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
 	}
+	id, err := uuid.Parse(dbArticle.ID)
 	if err != nil {
 		return nil, err
 	}
+	return &id, nil
+}
+
+func (r *ArticleRepository) Find(ctx context.Context, criteria *repository.ArticleCriteria) ([]*model.Article, *repository.ArticleCursor, error) {
+	var categoryIds []string
+	if criteria.CategoryId != nil {
+		categoryIds = []string{criteria.CategoryId.String()}
+		if criteria.IncludeDescendantCategories {
+			var err error
+			categoryIds, err = findDescendantCategoryIds(ctx, r.exec, *criteria.CategoryId)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+	mods, err := toArticleQueryMods(criteria, categoryIds)
+	if err != nil {
+		return nil, nil, err
+	}
+	dbArticles, err := dbModel.Articles(mods...).All(ctx, r.exec)
+	if err == sql.ErrNoRows {
+		return []*model.Article{}, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
 	if dbArticles == nil {
-		return []*model.Article{}, nil
+		return []*model.Article{}, nil, nil
 	}
-	articles, err := toArticles(dbArticles, r)
-	return articles, nil
+
+	// 1件多く取得して、次ページの有無を判定する
+	var next *repository.ArticleCursor
+	if criteria.Limit > 0 && len(dbArticles) > criteria.Limit {
+		dbArticles = dbArticles[:criteria.Limit]
+		next, err = toArticleCursor(dbArticles[len(dbArticles)-1], criteria.SortKey)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	articles, err := toArticles(ctx, dbArticles, r)
+	if err != nil {
+		return nil, nil, err
+	}
+	return articles, next, nil
+}
+
+// 子孫を含めたカテゴリの絞り込みはcategoryIdsで渡す
+func toArticleQueryMods(c *repository.ArticleCriteria, categoryIds []string) ([]qm.QueryMod, error) {
+	// ゴミ箱の記事は一覧に出さない
+	mods := []qm.QueryMod{dbModel.ArticleWhere.DeletedAt.IsNull()}
+	if len(categoryIds) > 0 {
+		mods = append(mods, dbModel.ArticleWhere.CategoryID.IN(categoryIds))
+	}
+	if c.TagName != nil {
+		mods = append(mods,
+			qm.InnerJoin("taggings on taggings.article_id = articles.id"),
+			qm.Where("taggings.tag_name = ?", *c.TagName),
+		)
+	}
+	if c.AuthorId != nil {
+		mods = append(mods,
+			qm.InnerJoin("article_authors on article_authors.article_id = articles.id"),
+			qm.Where("article_authors.author_id = ?", c.AuthorId.String()),
+		)
+	}
+	if c.Status != nil {
+		status, err := toDbStatus(*c.Status)
+		if err != nil {
+			return nil, err
+		}
+		mods = append(mods, dbModel.ArticleWhere.Status.EQ(status))
+	}
+	if c.PublishedFrom != nil {
+		mods = append(mods, dbModel.ArticleWhere.PublishedAt.GTE(null.TimeFrom(*c.PublishedFrom)))
+	}
+	if c.PublishedTo != nil {
+		mods = append(mods, dbModel.ArticleWhere.PublishedAt.LT(null.TimeFrom(*c.PublishedTo)))
+	}
+
+	column := dbModel.ArticleTableColumns.PublishedAt
+	if c.SortKey == repository.SortByCreatedAt {
+		column = dbModel.ArticleTableColumns.CreatedAt
+	}
+	direction := "DESC"
+	if c.Ascending {
+		direction = "ASC"
+	}
+	if c.After != nil {
+		mods = append(mods, afterCursor(column, c.Ascending, c.After))
+	}
+	// NOTE: MySQLではNULLはASCで先頭、DESCで末尾に並ぶ（afterCursorもこの前提）
+	mods = append(mods, qm.OrderBy(fmt.Sprintf("%s %s, %s %s", column, direction, dbModel.ArticleTableColumns.ID, direction)))
+	if c.Limit > 0 {
+		mods = append(mods, qm.Limit(c.Limit+1))
+	}
+	return mods, nil
 }
 
-func (r *ArticleRepository) Insert(c *model.Article) (error) {
+// カテゴリの数は多くないので、すべて読み込んで辿る
+func findDescendantCategoryIds(ctx context.Context, exec boil.ContextExecutor, categoryId uuid.UUID) ([]string, error) {
+	categories, err := NewCategoryRepository(exec).Find(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var categoryIds []string
+	for _, v := range model.NewCategoryTree(categories).Descendants(categoryId) {
+		categoryIds = append(categoryIds, v.String())
+	}
+	return categoryIds, nil
+}
+
+// (並び替えキー, id)の組でカーソルより後ろの行に絞り込む
+func afterCursor(column string, ascending bool, cursor *repository.ArticleCursor) qm.QueryMod {
+	id := dbModel.ArticleTableColumns.ID
+	if cursor.SortValue == nil {
+		if ascending {
+			return qm.Expr(
+				qm.Where(fmt.Sprintf("%s IS NULL AND %s > ?", column, id), cursor.Id.String()),
+				qm.Or(fmt.Sprintf("%s IS NOT NULL", column)),
+			)
+		}
+		return qm.Where(fmt.Sprintf("%s IS NULL AND %s < ?", column, id), cursor.Id.String())
+	}
+	if ascending {
+		return qm.Where(
+			fmt.Sprintf("(%s > ? OR (%s = ? AND %s > ?))", column, column, id),
+			*cursor.SortValue, *cursor.SortValue, cursor.Id.String(),
+		)
+	}
+	return qm.Where(
+		fmt.Sprintf("(%s < ? OR (%s = ? AND %s < ?) OR %s IS NULL)", column, column, id, column),
+		*cursor.SortValue, *cursor.SortValue, cursor.Id.String(),
+	)
+}
+
+func toArticleCursor(d *dbModel.Article, sortKey repository.ArticleSortKey) (*repository.ArticleCursor, error) {
+	id, err := uuid.Parse(d.ID)
+	if err != nil {
+		return nil, err
+	}
+	var sortValue *time.Time
+	switch sortKey {
+	case repository.SortByCreatedAt:
+		createdAt := d.CreatedAt
+		sortValue = &createdAt
+	default:
+		sortValue = d.PublishedAt.Ptr()
+	}
+	return &repository.ArticleCursor{SortValue: sortValue, Id: id}, nil
+}
+
+func (r *ArticleRepository) Insert(ctx context.Context, c *model.Article) (error) {
 	dbArticle, err := toDbArticle(c)
 	if err != nil {
 		return err
 	}
-	err = dbArticle.Insert(r.ctx, r.exec, boil.Infer())
+	err = dbArticle.Insert(ctx, r.exec, boil.Infer())
+	if isDuplicateEntryError(err) {
+		return errs.NewConflict(errs.CodeArticleSlugConflict, "Article slug is already in use")
+	}
 	if err != nil {
 		return err
 	}
+	// 他の記事の過去のスラッグを引き継いだ場合は、そちらの履歴を消す
+	if err := deleteSlugHistory(ctx, r, c.Slug); err != nil {
+		return err
+	}
 
 	dbTags:= toDbTags(c)
 	for _, v := range dbTags {
-		err = v.Upsert(r.ctx, r.exec, boil.Infer(), boil.Infer())
+		err = v.Upsert(ctx, r.exec, boil.Infer(), boil.Infer())
 		if err != nil {
 			return err
 		}
@@ -76,21 +261,21 @@ func (r *ArticleRepository) Insert(c *model.Article) (error) {
 	
 	dbTaggings := toDbTaggings(c)
 	for _, v := range dbTaggings {
-		err = v.Insert(r.ctx, r.exec, boil.Infer())
+		err = v.Insert(ctx, r.exec, boil.Infer())
 		if err != nil {
 			return err
 		}
 	}
-	return nil
+	return insertArticleAuthors(ctx, r, c)
 }
 
-func (r *ArticleRepository) Update(a *model.Article) (error) {
-	dbArticle, err := dbModel.FindArticle(r.ctx, r.exec, a.Id.String())
+func (r *ArticleRepository) Update(ctx context.Context, a *model.Article) (error) {
+	dbArticle, err := dbModel.FindArticle(ctx, r.exec, a.Id.String())
 	if err != nil && err != sql.ErrNoRows {
 		return err
 	}
 	if dbArticle == nil {
-		return errors.New("Article to update was not found")
+		return errs.NewNotFound(errs.CodeArticleNotFound, "Article to update was not found")
 	}
 
 	var publishedAt null.Time
@@ -99,25 +284,52 @@ func (r *ArticleRepository) Update(a *model.Article) (error) {
 	} else {
 		publishedAt = null.TimeFromPtr(a.PublishedAt)
 	}
-	dbArticle.Title = a.Title
-	dbArticle.Content = a.Content
-	dbArticle.CategoryID = a.CategoryId.String()
-	dbArticle.Status = a.Status.String()
-	dbArticle.PublishedAt = publishedAt
-	dbArticle.CreatedAt = a.CreatedAt
-	dbArticle.UpdatedAt = a.UpdatedAt
-
-	rowsAff, err := dbArticle.Update(r.ctx, r.exec, boil.Infer())
+	previousSlug := dbArticle.Slug
+	updatedAt := time.Now()
+
+	// 読み込んだ時点のversionのままの場合だけ更新する（他の更新を上書きしない）
+	rowsAff, err := dbModel.Articles(
+		dbModel.ArticleWhere.ID.EQ(a.Id.String()),
+		dbModel.ArticleWhere.Version.EQ(a.Version),
+	).UpdateAll(ctx, r.exec, dbModel.M{
+		dbModel.ArticleColumns.Title: a.Title,
+		dbModel.ArticleColumns.Slug: a.Slug,
+		dbModel.ArticleColumns.Content: a.Content,
+		dbModel.ArticleColumns.CategoryID: a.CategoryId.String(),
+		dbModel.ArticleColumns.Status: a.Status.String(),
+		dbModel.ArticleColumns.PublishedAt: publishedAt,
+		dbModel.ArticleColumns.CreatedAt: a.CreatedAt,
+		dbModel.ArticleColumns.UpdatedAt: updatedAt,
+		dbModel.ArticleColumns.Version: a.Version + 1,
+	})
+	if isDuplicateEntryError(err) {
+		return errs.NewConflict(errs.CodeArticleSlugConflict, "Article slug is already in use")
+	}
 	if err != nil {
 		return err
 	}
-	// NOTE: タグだけ変更があった場合はdbArticle.UpdateのrowsAffは0になる
-	if rowsAff != 0 && rowsAff != 1  {
+	if rowsAff == 0 {
+		return errs.NewPreconditionFailed(errs.CodeArticleVersionMismatch, "Article has been modified by another request")
+	}
+	if rowsAff != 1 {
 		return errors.New(fmt.Sprintf("Number of rows affected by update is invalid %v", rowsAff))
 	}
+	a.UpdatedAt = updatedAt
+	a.Version++
+
+	// スラッグの変更は履歴に残し、古いURLでも引けるようにする
+	if previousSlug != a.Slug {
+		if err := deleteSlugHistory(ctx, r, a.Slug); err != nil {
+			return err
+		}
+		history := &dbModel.ArticleSlugHistory{Slug: previousSlug, ArticleID: a.Id.String()}
+		if err := history.Insert(ctx, r.exec, boil.Infer()); err != nil {
+			return err
+		}
+	}
 
 	// タグの処理
-	foundDbTaggings, err := dbModel.Taggings(dbModel.TaggingWhere.ArticleID.EQ(a.Id.String())).All(r.ctx, r.exec)
+	foundDbTaggings, err := dbModel.Taggings(dbModel.TaggingWhere.ArticleID.EQ(a.Id.String())).All(ctx, r.exec)
 	if err != nil {
 		return err
 	}
@@ -161,7 +373,7 @@ func (r *ArticleRepository) Update(a *model.Article) (error) {
 	}
 
 	for _, v := range addedtagNames {
-		foundTag, err := dbModel.Tags(dbModel.TagWhere.Name.EQ(v)).One(r.ctx, r.exec)
+		foundTag, err := dbModel.Tags(dbModel.TagWhere.Name.EQ(v)).One(ctx, r.exec)
 		if err != nil && err != sql.ErrNoRows {
 			return err
 		}
@@ -169,7 +381,7 @@ func (r *ArticleRepository) Update(a *model.Article) (error) {
 			tag := &dbModel.Tag{
 				Name: v,
 			}
-			err = tag.Insert(r.ctx, r.exec, boil.Infer())
+			err = tag.Insert(ctx, r.exec, boil.Infer())
 			if err != nil {
 				return err
 			}
@@ -178,7 +390,7 @@ func (r *ArticleRepository) Update(a *model.Article) (error) {
 
 	// タグ付けは洗い替え
 	for _, v := range foundDbTaggings {
-		v.Delete(r.ctx, r.exec)
+		v.Delete(ctx, r.exec)
 	}
 
 	for _, v := range a.Tags {
@@ -186,7 +398,7 @@ func (r *ArticleRepository) Update(a *model.Article) (error) {
 			ArticleID: a.Id.String(),
 			TagName: v.Name,
 		}
-		err := dbTagging.Insert(r.ctx, r.exec, boil.Infer())
+		err := dbTagging.Insert(ctx, r.exec, boil.Infer())
 		if err != nil {
 			return err
 		}
@@ -194,16 +406,16 @@ func (r *ArticleRepository) Update(a *model.Article) (error) {
 
 	// tagsの削除はtaggingsの処理の後で（外部キー制約に引っかかるので）
 	for _, v := range removedtagNames {
-		foundTagging, err := dbModel.Taggings(dbModel.TaggingWhere.TagName.EQ(v), dbModel.TaggingWhere.ArticleID.NEQ(a.Id.String())).One(r.ctx, r.exec)
+		foundTagging, err := dbModel.Taggings(dbModel.TaggingWhere.TagName.EQ(v), dbModel.TaggingWhere.ArticleID.NEQ(a.Id.String())).One(ctx, r.exec)
 		if err != nil && err != sql.ErrNoRows {
 			return err
 		}
 		if foundTagging == nil {
-			foundTag, err := dbModel.Tags(dbModel.TagWhere.Name.EQ(v)).One(r.ctx, r.exec)
+			foundTag, err := dbModel.Tags(dbModel.TagWhere.Name.EQ(v)).One(ctx, r.exec)
 			if err != nil {
 				return err
 			}
-			rowsAff, err := foundTag.Delete(r.ctx, r.exec)
+			rowsAff, err := foundTag.Delete(ctx, r.exec)
 			if err != nil {
 				return err
 			}
@@ -213,44 +425,73 @@ func (r *ArticleRepository) Update(a *model.Article) (error) {
 		}
 	}
 
-	return nil
+	return updateArticleAuthors(ctx, r, a)
 }
 
-// taggingも削除、tagもチェック
-func (r *ArticleRepository) Delete(id uuid.UUID) (error) {
-	dbArticle, err := dbModel.FindArticle(r.ctx, r.exec, id.String())
+// ゴミ箱に移す。taggingは外して（どの記事にも付かなくなったtagも削除）、タグ名は復元用に残しておく
+func (r *ArticleRepository) Delete(ctx context.Context, id uuid.UUID, version int) (error) {
+	dbArticle, err := dbModel.Articles(dbModel.ArticleWhere.ID.EQ(id.String()), dbModel.ArticleWhere.DeletedAt.IsNull()).One(ctx, r.exec)
 	if err != nil && err != sql.ErrNoRows {
 		return err
 	}
 	if dbArticle == nil {
-		return errors.New("Article to delete was not found")
+		return errs.NewNotFound(errs.CodeArticleNotFound, "Article to delete was not found")
 	}
 
-	// タグの処理
-	foundDbTaggings, err := dbModel.Taggings(dbModel.TaggingWhere.ArticleID.EQ(dbArticle.ID)).All(r.ctx, r.exec)
-	// TODO: タグが元々ない場合の処理も確認
+	foundDbTaggings, err := dbModel.Taggings(dbModel.TaggingWhere.ArticleID.EQ(dbArticle.ID)).All(ctx, r.exec)
 	if err != nil {
 		return err
 	}
+	tagNames := []string{}
+	for _, v := range foundDbTaggings {
+		tagNames = append(tagNames, v.TagName)
+	}
+	deletedTagNames, err := json.Marshal(tagNames)
+	if err != nil {
+		return err
+	}
+	rowsAff, err := dbModel.Articles(
+		dbModel.ArticleWhere.ID.EQ(dbArticle.ID),
+		dbModel.ArticleWhere.DeletedAt.IsNull(),
+		dbModel.ArticleWhere.Version.EQ(version),
+	).UpdateAll(ctx, r.exec, dbModel.M{
+		dbModel.ArticleColumns.DeletedAt: null.TimeFrom(time.Now()),
+		dbModel.ArticleColumns.DeletedTagNames: null.JSONFrom(deletedTagNames),
+		dbModel.ArticleColumns.Version: version + 1,
+	})
+	if err != nil {
+		return err
+	}
+	if rowsAff == 0 {
+		return errs.NewPreconditionFailed(errs.CodeArticleVersionMismatch, "Article has been modified by another request")
+	}
+	if rowsAff != 1 {
+		return errors.New(fmt.Sprintf("Number of rows affected by delete is invalid %v", rowsAff))
+	}
+
+	// タグの処理
 	for _, v := range foundDbTaggings {
 		shouldDeleteTag := false
-		foundDbTagging, err := dbModel.Taggings(dbModel.TaggingWhere.TagName.EQ(v.TagName), dbModel.TaggingWhere.ArticleID.NEQ(dbArticle.ID)).One(r.ctx, r.exec)
+		foundDbTagging, err := dbModel.Taggings(dbModel.TaggingWhere.TagName.EQ(v.TagName), dbModel.TaggingWhere.ArticleID.NEQ(dbArticle.ID)).One(ctx, r.exec)
 		if err != nil && err != sql.ErrNoRows {
 			return err
 		}
 		if foundDbTagging == nil {
 			shouldDeleteTag = true
 		}
-		rowsAff, err := v.Delete(r.ctx, r.exec)
+		rowsAff, err := v.Delete(ctx, r.exec)
+		if err != nil {
+			return err
+		}
 		if rowsAff != 1 {
 			return errors.New(fmt.Sprintf("Number of rows affected by tagging delete is invalid %d", rowsAff))
 		}
 		if shouldDeleteTag {
-			foundDbTag, err := dbModel.FindTag(r.ctx, r.exec, v.TagName)
+			foundDbTag, err := dbModel.FindTag(ctx, r.exec, v.TagName)
 			if err != nil {
 				return err
 			}
-			rowsAff, err = foundDbTag.Delete(r.ctx, r.exec)
+			rowsAff, err = foundDbTag.Delete(ctx, r.exec)
 			if err != nil {
 				return err
 			}
@@ -259,17 +500,196 @@ func (r *ArticleRepository) Delete(id uuid.UUID) (error) {
 			}
 		}
 	}
+	return nil
+}
+
+// ゴミ箱の記事を削除した日時の新しい順に返す（タグは削除時に付いていたもの）
+func (r *ArticleRepository) FindDeleted(ctx context.Context) ([]*model.Article, error) {
+	dbArticles, err := dbModel.Articles(
+		dbModel.ArticleWhere.DeletedAt.IsNotNull(),
+		qm.OrderBy(fmt.Sprintf("%s DESC, %s DESC", dbModel.ArticleTableColumns.DeletedAt, dbModel.ArticleTableColumns.ID)),
+	).All(ctx, r.exec)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+	var articleIds []string
+	for _, v := range dbArticles {
+		articleIds = append(articleIds, v.ID)
+	}
+	// 著者はゴミ箱に移しても外さない
+	authorsByArticleId, err := findAuthorsByArticleIds(ctx, articleIds, r)
+	if err != nil {
+		return nil, err
+	}
+	articles := []*model.Article{}
+	for _, v := range dbArticles {
+		tags, err := toDeletedTags(v)
+		if err != nil {
+			return nil, err
+		}
+		article, err := toArticle(v, tags, authorsByArticleId[v.ID])
+		if err != nil {
+			return nil, err
+		}
+		articles = append(articles, article)
+	}
+	return articles, nil
+}
+
+// ゴミ箱から戻し、削除時に付いていたタグを付け直す
+func (r *ArticleRepository) Restore(ctx context.Context, id uuid.UUID) (error) {
+	dbArticle, err := dbModel.Articles(dbModel.ArticleWhere.ID.EQ(id.String()), dbModel.ArticleWhere.DeletedAt.IsNotNull()).One(ctx, r.exec)
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+	if dbArticle == nil {
+		return errs.NewNotFound(errs.CodeArticleNotFound, "Article to restore was not found in the trash")
+	}
+	tags, err := toDeletedTags(dbArticle)
+	if err != nil {
+		return err
+	}
 
-	rowsAff, err := dbArticle.Delete(r.ctx, r.exec)
+	dbArticle.DeletedAt = null.TimeFromPtr(nil)
+	dbArticle.DeletedTagNames = null.JSONFromPtr(nil)
+	dbArticle.Version++
+	rowsAff, err := dbArticle.Update(ctx, r.exec, boil.Whitelist(dbModel.ArticleColumns.DeletedAt, dbModel.ArticleColumns.DeletedTagNames, dbModel.ArticleColumns.Version))
 	if err != nil {
 		return err
 	}
 	if rowsAff != 1 {
-		return errors.New(fmt.Sprintf("Number of rows affected by delete is invalid %v", rowsAff))
+		return errors.New(fmt.Sprintf("Number of rows affected by restore is invalid %v", rowsAff))
+	}
+
+	a := &model.Article{Id: id, Tags: tags}
+	for _, v := range toDbTags(a) {
+		err = v.Upsert(ctx, r.exec, boil.Infer(), boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+	for _, v := range toDbTaggings(a) {
+		err = v.Insert(ctx, r.exec, boil.Infer())
+		if err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
+// beforeより前にゴミ箱に移した記事を、スラッグの履歴・版も含めて完全に削除する
+func (r *ArticleRepository) Purge(ctx context.Context, before time.Time) (int64, error) {
+	dbArticles, err := dbModel.Articles(
+		qm.Select(dbModel.ArticleColumns.ID),
+		dbModel.ArticleWhere.DeletedAt.LT(null.TimeFrom(before)),
+	).All(ctx, r.exec)
+	if err != nil && err != sql.ErrNoRows {
+		return 0, err
+	}
+	if len(dbArticles) == 0 {
+		return 0, nil
+	}
+	var articleIds []string
+	for _, v := range dbArticles {
+		articleIds = append(articleIds, v.ID)
+	}
+
+	_, err = dbModel.ArticleSlugHistories(dbModel.ArticleSlugHistoryWhere.ArticleID.IN(articleIds)).DeleteAll(ctx, r.exec)
+	if err != nil {
+		return 0, err
+	}
+	_, err = dbModel.ArticleRevisions(dbModel.ArticleRevisionWhere.ArticleID.IN(articleIds)).DeleteAll(ctx, r.exec)
+	if err != nil {
+		return 0, err
+	}
+	// 選んだ後に復元された記事は消さない
+	return dbModel.Articles(
+		dbModel.ArticleWhere.ID.IN(articleIds),
+		dbModel.ArticleWhere.DeletedAt.IsNotNull(),
+	).DeleteAll(ctx, r.exec)
+}
+
+func (r *ArticleRepository) CountByCategory(ctx context.Context, categoryId uuid.UUID) (*model.CategoryArticleCounts, error) {
+	stats, err := findCategoryStats(ctx, r.exec, []uuid.UUID{categoryId})
+	if err != nil {
+		return nil, err
+	}
+	return &stats[categoryId].ArticleCounts, nil
+}
+
+func (r *ArticleRepository) ReassignCategory(ctx context.Context, from uuid.UUID, to uuid.UUID) (int64, error) {
+	// 編集中のクライアントが元のカテゴリで上書きしないようにversionも上げる
+	query := fmt.Sprintf(
+		"UPDATE %s SET %s = ?, %s = %s + 1 WHERE %s = ?",
+		dbModel.TableNames.Articles,
+		dbModel.ArticleColumns.CategoryID,
+		dbModel.ArticleColumns.Version, dbModel.ArticleColumns.Version,
+		dbModel.ArticleColumns.CategoryID,
+	)
+	result, err := r.exec.ExecContext(ctx, query, to.String(), from.String())
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+type archiveMonthRow struct {
+	Year int `boil:"year"`
+	Month int `boil:"month"`
+	Count int `boil:"count"`
+}
+
+// published_atはUTCで保存している（ドライバのlocの既定値）ので、locの時差に変換してから年月で集計する
+// 時差は現在のものを使う（Asia/Tokyoのように夏時間のない地域なら正確）
+func (r *ArticleRepository) CountPublishedByMonth(ctx context.Context, loc *time.Location) ([]*model.ArchiveMonth, error) {
+	_, offset := time.Now().In(loc).Zone()
+	sign := "+"
+	if offset < 0 {
+		sign, offset = "-", -offset
+	}
+	query := fmt.Sprintf(
+		"SELECT YEAR(t) AS year, MONTH(t) AS month, COUNT(*) AS count FROM (SELECT CONVERT_TZ(%s, '+00:00', ?) AS t FROM %s WHERE %s = ? AND %s IS NULL) AS published GROUP BY 1, 2 ORDER BY 1 DESC, 2 DESC",
+		dbModel.ArticleColumns.PublishedAt,
+		dbModel.TableNames.Articles,
+		dbModel.ArticleColumns.Status,
+		dbModel.ArticleColumns.DeletedAt,
+	)
+	var rows []*archiveMonthRow
+	err := queries.Raw(query, fmt.Sprintf("%s%02d:%02d", sign, offset / 3600, offset % 3600 / 60), model.Published.String()).Bind(ctx, r.exec, &rows)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+	months := make([]*model.ArchiveMonth, 0, len(rows))
+	for _, v := range rows {
+		months = append(months, &model.ArchiveMonth{Year: v.Year, Month: v.Month, Count: v.Count})
+	}
+	return months, nil
+}
+
+// 条件付きの1回のUPDATEで切り替えるため、複数のインスタンスが同時に実行しても同じ記事を二重に処理しない
+func (r *ArticleRepository) PublishScheduled(ctx context.Context, now time.Time) (int64, error) {
+	// 編集中のクライアントが予約中の状態で上書きしないようにversionも上げる
+	query := fmt.Sprintf(
+		"UPDATE %s SET %s = ?, %s = %s + 1 WHERE %s = ? AND %s <= ? AND %s IS NULL",
+		dbModel.TableNames.Articles,
+		dbModel.ArticleColumns.Status,
+		dbModel.ArticleColumns.Version, dbModel.ArticleColumns.Version,
+		dbModel.ArticleColumns.Status,
+		dbModel.ArticleColumns.PublishedAt,
+		dbModel.ArticleColumns.DeletedAt,
+	)
+	result, err := r.exec.ExecContext(ctx, query, model.Published.String(), model.Scheduled.String(), now)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+func deleteSlugHistory(ctx context.Context, r *ArticleRepository, slug string) (error) {
+	_, err := dbModel.ArticleSlugHistories(dbModel.ArticleSlugHistoryWhere.Slug.EQ(slug)).DeleteAll(ctx, r.exec)
+	return err
+}
+
 func toStatus(s string) (*model.Status, error) {
 	var status model.Status
 	switch s {
@@ -279,6 +699,9 @@ func toStatus(s string) (*model.Status, error) {
 	case "Published":
 		status = model.Published
 		return &status, nil
+	case "Scheduled":
+		status = model.Scheduled
+		return &status, nil
 	default:
 		return nil, errors.New("記事のステータスの値が不正です")
 	}
@@ -290,30 +713,86 @@ func toDbStatus(s model.Status) (string, error) {
 		return "Draft", nil
 	case model.Published:
 		return "Published", nil
+	case model.Scheduled:
+		return "Scheduled", nil
 	default:
 		return "", errors.New("記事のステータスの値が不正です")
 	}
 }
 
-func findTags(articleId string, r *ArticleRepository) ([]model.Tag, error) {
-	dbTags, err := dbModel.Tags(
-		qm.InnerJoin("taggings on taggings.tag_name = tags.name"),
-		qm.Where("taggings.article_id = ?", articleId),
-	).All(r.ctx, r.exec)
-	if err != nil {
+// ページ内の記事のタグを1クエリでまとめて取得する（記事ごとにクエリを発行しない）
+func findTagsByArticleIds(ctx context.Context, articleIds []string, r *ArticleRepository) (map[string][]model.Tag, error) {
+	tagsByArticleId := make(map[string][]model.Tag)
+	if len(articleIds) == 0 {
+		return tagsByArticleId, nil
+	}
+	dbTaggings, err := dbModel.Taggings(dbModel.TaggingWhere.ArticleID.IN(articleIds)).All(ctx, r.exec)
+	if err != nil && err != sql.ErrNoRows {
 		return nil, err
 	}
-	var tags []model.Tag
-	for _, v := range dbTags {
-		tag := &model.Tag{
-			Name: v.Name,
+	for _, v := range dbTaggings {
+		tagsByArticleId[v.ArticleID] = append(tagsByArticleId[v.ArticleID], model.Tag{Name: v.TagName})
+	}
+	return tagsByArticleId, nil
+}
+
+// ページ内の記事の著者を表示順に1クエリでまとめて取得する
+func findAuthorsByArticleIds(ctx context.Context, articleIds []string, r *ArticleRepository) (map[string][]model.AuthorSummary, error) {
+	authorsByArticleId := make(map[string][]model.AuthorSummary)
+	if len(articleIds) == 0 {
+		return authorsByArticleId, nil
+	}
+	dbArticleAuthors, err := dbModel.ArticleAuthors(
+		qm.Load(dbModel.ArticleAuthorRels.Author),
+		dbModel.ArticleAuthorWhere.ArticleID.IN(articleIds),
+		qm.OrderBy(dbModel.ArticleAuthorColumns.Position),
+	).All(ctx, r.exec)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+	for _, v := range dbArticleAuthors {
+		author, err := toAuthor(v.R.Author)
+		if err != nil {
+			return nil, err
 		}
-		tags = append(tags, *tag)
+		authorsByArticleId[v.ArticleID] = append(authorsByArticleId[v.ArticleID], author.Summary())
 	}
-	return tags, nil
+	return authorsByArticleId, nil
 }
 
-func toArticle(d *dbModel.Article, r *ArticleRepository) (*model.Article, error) {
+func insertArticleAuthors(ctx context.Context, r *ArticleRepository, a *model.Article) (error) {
+	for i, v := range a.Authors {
+		dbArticleAuthor := &dbModel.ArticleAuthor{ArticleID: a.Id.String(), AuthorID: v.Id.String(), Position: i}
+		if err := dbArticleAuthor.Insert(ctx, r.exec, boil.Infer()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// 著者またはその順番が変わった場合だけ付け直す
+func updateArticleAuthors(ctx context.Context, r *ArticleRepository, a *model.Article) (error) {
+	found, err := dbModel.ArticleAuthors(
+		dbModel.ArticleAuthorWhere.ArticleID.EQ(a.Id.String()),
+		qm.OrderBy(dbModel.ArticleAuthorColumns.Position),
+	).All(ctx, r.exec)
+	if err != nil {
+		return err
+	}
+	changed := len(found) != len(a.Authors)
+	for i := 0; !changed && i < len(found); i++ {
+		changed = found[i].AuthorID != a.Authors[i].Id.String()
+	}
+	if !changed {
+		return nil
+	}
+	if _, err := dbModel.ArticleAuthors(dbModel.ArticleAuthorWhere.ArticleID.EQ(a.Id.String())).DeleteAll(ctx, r.exec); err != nil {
+		return err
+	}
+	return insertArticleAuthors(ctx, r, a)
+}
+
+func toArticle(d *dbModel.Article, tags []model.Tag, authors []model.AuthorSummary) (*model.Article, error) {
 	id, err := uuid.Parse(d.ID)
 	if err != nil {
 		return nil, err
@@ -326,7 +805,6 @@ func toArticle(d *dbModel.Article, r *ArticleRepository) (*model.Article, error)
 	if err != nil {
 		return nil, err
 	}
-	tags, err := findTags(d.ID, r)
 	
 	var publishedAt *time.Time
 	if d.PublishedAt.Valid {
@@ -334,26 +812,71 @@ func toArticle(d *dbModel.Article, r *ArticleRepository) (*model.Article, error)
 	} else {
 		publishedAt = nil
 	}
+	// 著者のいない記事もJSONでは空の配列にする
+	if authors == nil {
+		authors = []model.AuthorSummary{}
+	}
+	var createdBy *uuid.UUID
+	if d.CreatedBy.Valid {
+		v, err := uuid.Parse(d.CreatedBy.String)
+		if err != nil {
+			return nil, err
+		}
+		createdBy = &v
+	}
 	article := &model.Article{
 		Id: id,
 		Title: d.Title,
+		Slug: d.Slug,
 		Content: d.Content,
 		CategoryId: categoryId,
 		Tags: tags,
 		PublishedAt: publishedAt,
 		Status: *status,
+		CreatedBy: createdBy,
+		Authors: authors,
 		CreatedAt: d.CreatedAt,
 		UpdatedAt: d.UpdatedAt,
+		Version: d.Version,
+		DeletedAt: d.DeletedAt.Ptr(),
 	}
 	
 	
 	return article, nil
 }
 
-func toArticles(dbArticles []*dbModel.Article, r *ArticleRepository) ([]*model.Article, error) {
+func toDeletedTags(d *dbModel.Article) ([]model.Tag, error) {
+	var tags []model.Tag
+	if !d.DeletedTagNames.Valid {
+		return tags, nil
+	}
+	var tagNames []string
+	if err := d.DeletedTagNames.Unmarshal(&tagNames); err != nil {
+		return nil, err
+	}
+	for _, v := range tagNames {
+		tags = append(tags, model.Tag{Name: v})
+	}
+	return tags, nil
+}
+
+func toArticles(ctx context.Context, dbArticles []*dbModel.Article, r *ArticleRepository) ([]*model.Article, error) {
+	var articleIds []string
+	for _, v := range dbArticles {
+		articleIds = append(articleIds, v.ID)
+	}
+	tagsByArticleId, err := findTagsByArticleIds(ctx, articleIds, r)
+	if err != nil {
+		return nil, err
+	}
+	authorsByArticleId, err := findAuthorsByArticleIds(ctx, articleIds, r)
+	if err != nil {
+		return nil, err
+	}
+
 	var articles []*model.Article
 	for _, v := range dbArticles {
-		article, err := toArticle(v, r)
+		article, err := toArticle(v, tagsByArticleId[v.ID], authorsByArticleId[v.ID])
 		if err != nil {
 			return nil, err
 		}
@@ -377,10 +900,15 @@ func toDbArticle(e *model.Article) (*dbModel.Article, error) {
 	dbArticle := &dbModel.Article{
 		ID: e.Id.String(),
 		Title: e.Title,
+		Slug: e.Slug,
 		Content: e.Content,
 		CategoryID: e.CategoryId.String(),
 		PublishedAt: publishedAt,
 		Status: status,
+		Version: e.Version,
+	}
+	if e.CreatedBy != nil {
+		dbArticle.CreatedBy = null.StringFrom(e.CreatedBy.String())
 	}
 	return dbArticle, nil
 }
@@ -3,15 +3,19 @@ package database
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"testing"
 	"time"
 
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/google/uuid"
+	"github.com/momonoki1990/tech-blog-api/domain/errs"
 	"github.com/momonoki1990/tech-blog-api/domain/model"
+	"github.com/momonoki1990/tech-blog-api/domain/repository"
 	dbModel "github.com/momonoki1990/tech-blog-api/infra/database/model"
 	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
 func TestArticleFindOneById(t *testing.T) {
@@ -28,6 +32,7 @@ func TestArticleFindOneById(t *testing.T) {
 	dbCategory1 := &dbModel.Category{
 		ID: "21111111-1111-1111-1111-111111111111",
 		Name: "Category1",
+		Slug: "category1",
 		DisplayOrder: null.IntFrom(99),
 	}
 	err := dbCategory1.Insert(ctx, tx, boil.Infer())
@@ -37,6 +42,7 @@ func TestArticleFindOneById(t *testing.T) {
 	
 	dbArticle1 := &dbModel.Article{
 		ID: "11111111-1111-1111-1111-111111111111",
+		Slug: "11111111-1111-1111-1111-111111111111",
 		Title: "Title1",
 		Content: "Content1",
 		CategoryID: "21111111-1111-1111-1111-111111111111",
@@ -67,6 +73,7 @@ func TestArticleFindOneById(t *testing.T) {
 
 	dbArticle2 := &dbModel.Article{
 		ID: "11111111-1111-1111-1111-111111111112",
+		Slug: "11111111-1111-1111-1111-111111111112",
 		Title: "Title2",
 		Content: "Content2",
 		CategoryID: "21111111-1111-1111-1111-111111111111",
@@ -143,12 +150,12 @@ func TestArticleFindOneById(t *testing.T) {
 	
 
 	// Execute
-	r := NewArticleRepository(ctx, tx)
-	actual1, err := r.FindOneById(articleId1)
+	r := NewArticleRepository(tx)
+	actual1, err := r.FindOneById(ctx, articleId1)
 	if err != nil {
 		panic(err)
 	}
-	actual2, err := r.FindOneById(articleId2)
+	actual2, err := r.FindOneById(ctx, articleId2)
 	if err != nil {
 		panic(err)
 	}
@@ -227,10 +234,10 @@ func TestArticleFindOneByIdNotExisting(t *testing.T) {
 	if err != nil {
 		panic(err)
 	}
-	r := NewArticleRepository(ctx, tx)
-	article, err := r.FindOneById(articleId)
+	r := NewArticleRepository(tx)
+	article, err := r.FindOneById(ctx, articleId)
 	if err != nil {
-		t.Errorf("err of r.FindOneById(articleId): Expected %v, but got %v", nil, err)
+		t.Errorf("err of r.FindOneById(ctx, articleId): Expected %v, but got %v", nil, err)
 	}
 	if article != nil {
 		t.Errorf("article: Expected %v, but got %v", nil, article)
@@ -259,6 +266,7 @@ func TestArticleFind(t *testing.T) {
 	dbCategory1 := &dbModel.Category{
 		ID: "21111111-1111-1111-1111-111111111111",
 		Name: "Category1",
+		Slug: "category1",
 		DisplayOrder: null.IntFrom(99),
 	}
 	err = dbCategory1.Insert(ctx, tx, boil.Infer())
@@ -268,6 +276,7 @@ func TestArticleFind(t *testing.T) {
 	dbCategory2 := &dbModel.Category{
 		ID: "21111111-1111-1111-1111-111111111112",
 		Name: "Category2",
+		Slug: "category2",
 		DisplayOrder: null.IntFrom(99),
 	}
 	err = dbCategory2.Insert(ctx, tx, boil.Infer())
@@ -277,6 +286,7 @@ func TestArticleFind(t *testing.T) {
 
 	dbArticle1 := &dbModel.Article{
 		ID: "11111111-1111-1111-1111-111111111111",
+		Slug: "11111111-1111-1111-1111-111111111111",
 		Title: "Title1",
 		Content: "Content1",
 		CategoryID: "21111111-1111-1111-1111-111111111111",
@@ -309,6 +319,7 @@ func TestArticleFind(t *testing.T) {
 
 	dbArticle2 := &dbModel.Article{
 		ID: "11111111-1111-1111-1111-111111111112",
+		Slug: "11111111-1111-1111-1111-111111111112",
 		Title: "Title2",
 		Content: "Content2",
 		CategoryID: "21111111-1111-1111-1111-111111111112",
@@ -387,8 +398,8 @@ func TestArticleFind(t *testing.T) {
 	
 
 	// Execute
-	r := NewArticleRepository(ctx, tx)
-	actuals, err := r.Find()
+	r := NewArticleRepository(tx)
+	actuals, _, err := r.Find(ctx, &repository.ArticleCriteria{})
 	if err != nil {
 		panic(err)
 	}
@@ -479,8 +490,8 @@ func TestArticleFindWithNoData(t *testing.T) {
 	
 
 	// Execute
-	r := NewArticleRepository(ctx, tx)
-	actuals, err := r.Find()
+	r := NewArticleRepository(tx)
+	actuals, _, err := r.Find(ctx, &repository.ArticleCriteria{})
 	if err != nil {
 		panic(err)
 	}
@@ -494,6 +505,257 @@ func TestArticleFindWithNoData(t *testing.T) {
 	}
 }
 
+func TestArticleFindWithCriteria(t *testing.T) {
+	db := GetTestConnection()
+	ctx := context.TODO()
+	tx := GetTestTransaction(db, ctx)
+	defer tx.Rollback()
+
+	// Prepare data
+	_, err := dbModel.Taggings().DeleteAll(ctx, tx)
+	if err != nil {
+		panic(err)
+	}
+	_, err = dbModel.Articles().DeleteAll(ctx, tx)
+	if err != nil {
+		panic(err)
+	}
+
+	n := time.Now()
+	now := n.Truncate(time.Second)
+
+	dbCategory1 := &dbModel.Category{
+		ID: "21111111-1111-1111-1111-111111111111",
+		Name: "Category1",
+		Slug: "category1",
+		DisplayOrder: null.IntFrom(99),
+	}
+	err = dbCategory1.Insert(ctx, tx, boil.Infer())
+	if err != nil {
+		panic(err)
+	}
+	dbCategory2 := &dbModel.Category{
+		ID: "21111111-1111-1111-1111-111111111112",
+		Name: "Category2",
+		Slug: "category2",
+		DisplayOrder: null.IntFrom(99),
+		// Category1の子
+		ParentID: null.StringFrom("21111111-1111-1111-1111-111111111111"),
+	}
+	err = dbCategory2.Insert(ctx, tx, boil.Infer())
+	if err != nil {
+		panic(err)
+	}
+
+	dbArticles := []*dbModel.Article{
+		{
+			ID: "11111111-1111-1111-1111-111111111111",
+			Slug: "title1",
+			Title: "Title1",
+			Content: "Content1",
+			CategoryID: "21111111-1111-1111-1111-111111111111",
+			PublishedAt: null.TimeFrom(now.AddDate(0, 0, -2)),
+			Status: "Published",
+		},
+		{
+			ID: "11111111-1111-1111-1111-111111111112",
+			Slug: "title2",
+			Title: "Title2",
+			Content: "Content2",
+			CategoryID: "21111111-1111-1111-1111-111111111111",
+			PublishedAt: null.TimeFrom(now.AddDate(0, 0, -1)),
+			Status: "Published",
+		},
+		{
+			ID: "11111111-1111-1111-1111-111111111113",
+			Slug: "title3",
+			Title: "Title3",
+			Content: "Content3",
+			CategoryID: "21111111-1111-1111-1111-111111111112",
+			PublishedAt: null.TimeFromPtr(nil),
+			Status: "Draft",
+		},
+	}
+	for _, v := range dbArticles {
+		err = v.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			panic(err)
+		}
+	}
+	dbTag1 := &dbModel.Tag{
+		Name: "Tag1",
+	}
+	err = dbTag1.Insert(ctx, tx, boil.Infer())
+	if err != nil {
+		panic(err)
+	}
+	for _, v := range []string{"11111111-1111-1111-1111-111111111111", "11111111-1111-1111-1111-111111111113"} {
+		dbTagging := &dbModel.Tagging{
+			ArticleID: v,
+			TagName: "Tag1",
+		}
+		err = dbTagging.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	categoryId1, err := uuid.Parse("21111111-1111-1111-1111-111111111111")
+	if err != nil {
+		panic(err)
+	}
+	tagName := "Tag1"
+	published := model.Published
+	from := now.AddDate(0, 0, -1)
+
+	r := NewArticleRepository(tx)
+
+	// Execute1 (category)
+	actuals, _, err := r.Find(ctx, &repository.ArticleCriteria{CategoryId: &categoryId1})
+	if err != nil {
+		panic(err)
+	}
+	// Check1
+	if len(actuals) != 2 {
+		t.Errorf("len(actuals) by category: Expected %d, but got %d", 2, len(actuals))
+	}
+
+	// Execute1-2 (category and its descendants)
+	actuals, _, err = r.Find(ctx, &repository.ArticleCriteria{CategoryId: &categoryId1, IncludeDescendantCategories: true})
+	if err != nil {
+		panic(err)
+	}
+	// Check1-2
+	if len(actuals) != 3 {
+		t.Errorf("len(actuals) by category and its descendants: Expected %d, but got %d", 3, len(actuals))
+	}
+
+	// Execute2 (tag)
+	actuals, _, err = r.Find(ctx, &repository.ArticleCriteria{TagName: &tagName})
+	if err != nil {
+		panic(err)
+	}
+	// Check2
+	if len(actuals) != 2 {
+		t.Errorf("len(actuals) by tag: Expected %d, but got %d", 2, len(actuals))
+	}
+
+	// Execute3 (status and published date)
+	actuals, _, err = r.Find(ctx, &repository.ArticleCriteria{Status: &published, PublishedFrom: &from})
+	if err != nil {
+		panic(err)
+	}
+	// Check3
+	if len(actuals) != 1 {
+		t.Errorf("len(actuals) by status and publishedFrom: Expected %d, but got %d", 1, len(actuals))
+	}
+	if len(actuals) == 1 && actuals[0].Title != "Title2" {
+		t.Errorf("actuals[0].Title: Expected %s, but got %s", "Title2", actuals[0].Title)
+	}
+
+	// Execute4 (ascending)
+	actuals, _, err = r.Find(ctx, &repository.ArticleCriteria{Ascending: true})
+	if err != nil {
+		panic(err)
+	}
+	// Check4
+	if actuals[0].Title != "Title3" || actuals[1].Title != "Title1" || actuals[2].Title != "Title2" {
+		t.Errorf("order of actuals: Expected %v, but got %v", []string{"Title3", "Title1", "Title2"}, []string{actuals[0].Title, actuals[1].Title, actuals[2].Title})
+	}
+}
+
+func TestArticleFindPaging(t *testing.T) {
+	db := GetTestConnection()
+	ctx := context.TODO()
+	tx := GetTestTransaction(db, ctx)
+	defer tx.Rollback()
+
+	// Prepare data
+	_, err := dbModel.Taggings().DeleteAll(ctx, tx)
+	if err != nil {
+		panic(err)
+	}
+	_, err = dbModel.Articles().DeleteAll(ctx, tx)
+	if err != nil {
+		panic(err)
+	}
+
+	n := time.Now()
+	now := n.Truncate(time.Second)
+
+	dbCategory1 := &dbModel.Category{
+		ID: "21111111-1111-1111-1111-111111111111",
+		Name: "Category1",
+		Slug: "category1",
+		DisplayOrder: null.IntFrom(99),
+	}
+	err = dbCategory1.Insert(ctx, tx, boil.Infer())
+	if err != nil {
+		panic(err)
+	}
+	// 公開日が同じ記事、未公開の記事をまたいでページングできること
+	publishedAts := []null.Time{
+		null.TimeFrom(now),
+		null.TimeFrom(now),
+		null.TimeFrom(now.AddDate(0, 0, -1)),
+		null.TimeFromPtr(nil),
+		null.TimeFromPtr(nil),
+	}
+	for i, v := range publishedAts {
+		status := "Published"
+		if !v.Valid {
+			status = "Draft"
+		}
+		dbArticle := &dbModel.Article{
+			ID: fmt.Sprintf("11111111-1111-1111-1111-11111111111%d", i+1),
+			Slug: fmt.Sprintf("title%d", i+1),
+			Title: fmt.Sprintf("Title%d", i+1),
+			Content: "Content",
+			CategoryID: "21111111-1111-1111-1111-111111111111",
+			PublishedAt: v,
+			Status: status,
+		}
+		err = dbArticle.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	for _, ascending := range []bool{false, true} {
+		// Execute
+		r := NewArticleRepository(tx)
+		var titles []string
+		var after *repository.ArticleCursor
+		pages := 0
+		for {
+			actuals, next, err := r.Find(ctx, &repository.ArticleCriteria{Ascending: ascending, Limit: 2, After: after})
+			if err != nil {
+				panic(err)
+			}
+			for _, v := range actuals {
+				titles = append(titles, v.Title)
+			}
+			pages++
+			if next == nil || pages > 5 {
+				break
+			}
+			after = next
+		}
+
+		// Check
+		expected := []string{"Title2", "Title1", "Title3", "Title5", "Title4"}
+		if ascending {
+			expected = []string{"Title4", "Title5", "Title3", "Title1", "Title2"}
+		}
+		if pages != 3 {
+			t.Errorf("pages (ascending=%v): Expected %d, but got %d", ascending, 3, pages)
+		}
+		if fmt.Sprint(titles) != fmt.Sprint(expected) {
+			t.Errorf("titles (ascending=%v): Expected %v, but got %v", ascending, expected, titles)
+		}
+	}
+}
+
 func TestArticleInsert(t *testing.T) {
 	db := GetTestConnection()
 	ctx := context.TODO()
@@ -508,6 +770,7 @@ func TestArticleInsert(t *testing.T) {
 	dbCategory1 := &dbModel.Category{
 		ID: "11111111-1111-1111-1111-111111111111",
 		Name: "Category1",
+		Slug: "category1",
 		DisplayOrder: null.IntFrom(99),
 	}
 	err = dbCategory1.Insert(ctx, tx, boil.Infer())
@@ -525,12 +788,12 @@ func TestArticleInsert(t *testing.T) {
 	}
 	
 	// Execute
-	r := NewArticleRepository(ctx, tx)
-	err = r.Insert(article1)
+	r := NewArticleRepository(tx)
+	err = r.Insert(ctx, article1)
 	if err != nil {
 		panic(err)
 	}
-	err = r.Insert(article2)
+	err = r.Insert(ctx, article2)
 	if err != nil {
 		panic(err)
 	}
@@ -636,6 +899,7 @@ func TestArticleInsertAlreadyExisting(t *testing.T) {
 	dbCategory1 := &dbModel.Category{
 		ID: "21111111-1111-1111-1111-111111111111",
 		Name: "Category1",
+		Slug: "category1",
 		DisplayOrder: null.IntFrom(99),
 	}
 	err := dbCategory1.Insert(ctx, tx, boil.Infer())
@@ -653,16 +917,186 @@ func TestArticleInsertAlreadyExisting(t *testing.T) {
 		panic(err)
 	}
 	
-	r := NewArticleRepository(ctx, tx)
-	err = r.Insert(article1)
+	r := NewArticleRepository(tx)
+	err = r.Insert(ctx, article1)
 	if err != nil {
 		panic(err)
 	}
 
 	// Execute
-	err = r.Insert(article1)
+	err = r.Insert(ctx, article1)
 	if err == nil {
-		t.Errorf("err of r.Insert(article1): Expected %v, but got %v", "not nil", err)
+		t.Errorf("err of r.Insert(ctx, article1): Expected %v, but got %v", "not nil", err)
+	}
+}
+
+func TestArticleInsertSlugConflict(t *testing.T) {
+	db := GetTestConnection()
+	ctx := context.TODO()
+	tx := GetTestTransaction(db, ctx)
+	defer tx.Rollback()
+
+	// Prepare data
+	dbCategory1 := &dbModel.Category{
+		ID: "21111111-1111-1111-1111-111111111111",
+		Name: "Category1",
+		Slug: "category1",
+		DisplayOrder: null.IntFrom(99),
+	}
+	err := dbCategory1.Insert(ctx, tx, boil.Infer())
+	if err != nil {
+		panic(err)
+	}
+	categoryId1, err := uuid.Parse("21111111-1111-1111-1111-111111111111")
+	if err != nil {
+		panic(err)
+	}
+	article1, err := model.NewArticle("Title1", "Content1", categoryId1, []string{}, false)
+	if err != nil {
+		panic(err)
+	}
+	article2, err := model.NewArticle("Title1", "Content2", categoryId1, []string{}, false)
+	if err != nil {
+		panic(err)
+	}
+	r := NewArticleRepository(tx)
+	err = r.Insert(ctx, article1)
+	if err != nil {
+		panic(err)
+	}
+
+	// Execute
+	err = r.Insert(ctx, article2)
+
+	// Check
+	if !errs.IsKind(err, errs.Conflict) {
+		t.Errorf("err of r.Insert(ctx, article2): Expected conflict error, but got %v", err)
+	}
+}
+
+func TestArticleFindOneBySlug(t *testing.T) {
+	db := GetTestConnection()
+	ctx := context.TODO()
+	tx := GetTestTransaction(db, ctx)
+	defer tx.Rollback()
+
+	// Prepare data
+	dbCategory1 := &dbModel.Category{
+		ID: "21111111-1111-1111-1111-111111111111",
+		Name: "Category1",
+		Slug: "category1",
+		DisplayOrder: null.IntFrom(99),
+	}
+	err := dbCategory1.Insert(ctx, tx, boil.Infer())
+	if err != nil {
+		panic(err)
+	}
+	categoryId1, err := uuid.Parse("21111111-1111-1111-1111-111111111111")
+	if err != nil {
+		panic(err)
+	}
+	article1, err := model.NewArticle("Title1", "Content1", categoryId1, []string{"Tag1"}, false)
+	if err != nil {
+		panic(err)
+	}
+	article2, err := model.NewArticle("Title2", "Content2", categoryId1, []string{}, false)
+	if err != nil {
+		panic(err)
+	}
+	r := NewArticleRepository(tx)
+	for _, v := range []*model.Article{article1, article2} {
+		err = r.Insert(ctx, v)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	// Execute1: スラッグを変更しても古いスラッグで引ける
+	err = article1.SetSlug("renamed")
+	if err != nil {
+		panic(err)
+	}
+	err = r.Update(ctx, article1)
+	if err != nil {
+		panic(err)
+	}
+	byNew, err := r.FindOneBySlug(ctx, "renamed")
+	if err != nil {
+		panic(err)
+	}
+	byOld, err := r.FindOneBySlug(ctx, "title1")
+	if err != nil {
+		panic(err)
+	}
+
+	// Check1
+	if byNew == nil || byNew.Id != article1.Id {
+		t.Errorf("byNew: Expected %s, but got %v", article1.Id, byNew)
+	}
+	if byOld == nil || byOld.Id != article1.Id {
+		t.Errorf("byOld: Expected %s, but got %v", article1.Id, byOld)
+	}
+	if byOld != nil && byOld.Slug != "renamed" {
+		t.Errorf("byOld.Slug: Expected %s, but got %s", "renamed", byOld.Slug)
+	}
+	if byOld != nil && (len(byOld.Tags) != 1 || byOld.Tags[0].Name != "Tag1") {
+		t.Errorf("byOld.Tags: Expected %v, but got %v", article1.Tags, byOld.Tags)
+	}
+
+	// Execute2: 他の記事が古いスラッグを引き継ぐ
+	err = article2.SetSlug("title1")
+	if err != nil {
+		panic(err)
+	}
+	err = r.Update(ctx, article2)
+	if err != nil {
+		panic(err)
+	}
+	taken, err := r.FindOneBySlug(ctx, "title1")
+	if err != nil {
+		panic(err)
+	}
+	notExisting, err := r.FindOneBySlug(ctx, "not-existing")
+	if err != nil {
+		panic(err)
+	}
+
+	// Check2
+	if taken == nil || taken.Id != article2.Id {
+		t.Errorf("taken: Expected %s, but got %v", article2.Id, taken)
+	}
+	if notExisting != nil {
+		t.Errorf("notExisting: Expected %v, but got %v", nil, notExisting)
+	}
+
+	// Execute3: ゴミ箱に移すと現在のスラッグでも過去のスラッグでも見つからない
+	err = r.Delete(ctx, article1.Id, article1.Version)
+	if err != nil {
+		panic(err)
+	}
+	deletedByNew, err := r.FindOneBySlug(ctx, "renamed")
+	if err != nil {
+		panic(err)
+	}
+	// FindIdBySlugはゴミ箱の記事も見つかるが、過去のスラッグ（article2の"title2"）では見つからない
+	deletedId, err := r.FindIdBySlug(ctx, "renamed")
+	if err != nil {
+		panic(err)
+	}
+	historyId, err := r.FindIdBySlug(ctx, "title2")
+	if err != nil {
+		panic(err)
+	}
+
+	// Check3
+	if deletedByNew != nil {
+		t.Errorf("deletedByNew: Expected %v, but got %v", nil, deletedByNew)
+	}
+	if deletedId == nil || *deletedId != article1.Id {
+		t.Errorf("deletedId: Expected %s, but got %v", article1.Id, deletedId)
+	}
+	if historyId != nil {
+		t.Errorf("historyId: Expected %v, but got %v", nil, historyId)
 	}
 }
 
@@ -676,6 +1110,7 @@ func TestArticleUpdate(t *testing.T) {
 	dbCategory1 := &dbModel.Category{
 		ID: "21111111-1111-1111-1111-111111111111",
 		Name: "Category1",
+		Slug: "category1",
 		DisplayOrder: null.IntFrom(99),
 	}
 	err := dbCategory1.Insert(ctx, tx, boil.Infer())
@@ -686,6 +1121,7 @@ func TestArticleUpdate(t *testing.T) {
 	dbCategory2 := &dbModel.Category{
 		ID: "21111111-1111-1111-1111-111111111112",
 		Name: "Category2",
+		Slug: "category2",
 		DisplayOrder: null.IntFrom(99),
 	}
 	err = dbCategory2.Insert(ctx, tx, boil.Infer())
@@ -711,12 +1147,12 @@ func TestArticleUpdate(t *testing.T) {
 		panic(err)
 	}
 
-	r := NewArticleRepository(ctx, tx)
-	err = r.Insert(article1)
+	r := NewArticleRepository(tx)
+	err = r.Insert(ctx, article1)
 	if err != nil {
 		panic(err)
 	}
-	err = r.Insert(article2)
+	err = r.Insert(ctx, article2)
 	if err != nil {
 		panic(err)
 	}
@@ -730,7 +1166,7 @@ func TestArticleUpdate(t *testing.T) {
 	if err != nil {
 		panic(err)
 	}
-	err = r.Update(article1)
+	err = r.Update(ctx, article1)
 	if err != nil {
 		panic(err)
 	}
@@ -797,7 +1233,7 @@ func TestArticleUpdate(t *testing.T) {
 	if err != nil {
 		panic(err)
 	}
-	err = r.Update(article1)
+	err = r.Update(ctx, article1)
 	if err != nil {
 		panic(err)
 	}
@@ -812,37 +1248,106 @@ func TestArticleUpdate(t *testing.T) {
 	}
 }
 
-func TestArticleUpdateNotExisting(t *testing.T) {
+func TestArticleUpdateVersionMismatch(t *testing.T) {
 	db := GetTestConnection()
 	ctx := context.TODO()
 	tx := GetTestTransaction(db, ctx)
 	defer tx.Rollback()
 
 	// Prepare data
+	dbCategory1 := &dbModel.Category{
+		ID: "21111111-1111-1111-1111-111111111111",
+		Name: "Category1",
+		Slug: "category1",
+		DisplayOrder: null.IntFrom(99),
+	}
+	err := dbCategory1.Insert(ctx, tx, boil.Infer())
+	if err != nil {
+		panic(err)
+	}
 	categoryId1, err := uuid.Parse("21111111-1111-1111-1111-111111111111")
 	if err != nil {
 		panic(err)
 	}
-	
 	article1, err := model.NewArticle("Title1", "Content1", categoryId1, []string{"Tag1"}, false)
 	if err != nil {
 		panic(err)
 	}
+	r := NewArticleRepository(tx)
+	err = r.Insert(ctx, article1)
+	if err != nil {
+		panic(err)
+	}
+	// 2人の編集者が同じ版を読み込む
+	editor1, err := r.FindOneById(ctx, article1.Id)
+	if err != nil {
+		panic(err)
+	}
+	editor2, err := r.FindOneById(ctx, article1.Id)
+	if err != nil {
+		panic(err)
+	}
 
 	// Execute
-	r := NewArticleRepository(ctx, tx)
-	err = r.Update(article1)
+	editor1.Title = "Title1ByEditor1"
+	err1 := r.Update(ctx, editor1)
+	editor2.Title = "Title1ByEditor2"
+	err2 := r.Update(ctx, editor2)
+	err3 := r.Delete(ctx, article1.Id, editor2.Version)
 
 	// Check
-	if err == nil {
-		t.Errorf("err of r.Update(article1): Expected %v, but got %v", "not nil", err)
+	if err1 != nil {
+		t.Errorf("err1: Expected %v, but got %v", nil, err1)
 	}
-	if err.Error() != "Article to update was not found" {
-		t.Errorf("err of r.Update(article1): Expected %v, but got %v", "Article to update was not found", err)
+	if editor1.Version != 2 {
+		t.Errorf("editor1.Version: Expected %d, but got %d", 2, editor1.Version)
 	}
-}
-
-func TestArticleDelete(t *testing.T) {
+	if !errs.IsKind(err2, errs.PreconditionFailed) {
+		t.Errorf("err2: Expected %s, but got %v", errs.PreconditionFailed, err2)
+	}
+	if !errs.IsKind(err3, errs.PreconditionFailed) {
+		t.Errorf("err3: Expected %s, but got %v", errs.PreconditionFailed, err3)
+	}
+	found, err := r.FindOneById(ctx, article1.Id)
+	if err != nil {
+		panic(err)
+	}
+	if found.Title != "Title1ByEditor1" || found.Version != 2 {
+		t.Errorf("found: Expected %s (version %d), but got %s (version %d)", "Title1ByEditor1", 2, found.Title, found.Version)
+	}
+}
+
+func TestArticleUpdateNotExisting(t *testing.T) {
+	db := GetTestConnection()
+	ctx := context.TODO()
+	tx := GetTestTransaction(db, ctx)
+	defer tx.Rollback()
+
+	// Prepare data
+	categoryId1, err := uuid.Parse("21111111-1111-1111-1111-111111111111")
+	if err != nil {
+		panic(err)
+	}
+	
+	article1, err := model.NewArticle("Title1", "Content1", categoryId1, []string{"Tag1"}, false)
+	if err != nil {
+		panic(err)
+	}
+
+	// Execute
+	r := NewArticleRepository(tx)
+	err = r.Update(ctx, article1)
+
+	// Check
+	if err == nil {
+		t.Errorf("err of r.Update(ctx, article1): Expected %v, but got %v", "not nil", err)
+	}
+	if err.Error() != "Article to update was not found" {
+		t.Errorf("err of r.Update(ctx, article1): Expected %v, but got %v", "Article to update was not found", err)
+	}
+}
+
+func TestArticleDelete(t *testing.T) {
 	db := GetTestConnection()
 	ctx := context.TODO()
 	tx := GetTestTransaction(db, ctx)
@@ -852,6 +1357,7 @@ func TestArticleDelete(t *testing.T) {
 	dbCategory1 := &dbModel.Category{
 		ID: "21111111-1111-1111-1111-111111111111",
 		Name: "Category1",
+		Slug: "category1",
 		DisplayOrder: null.IntFrom(99),
 	}
 	err := dbCategory1.Insert(ctx, tx, boil.Infer())
@@ -862,6 +1368,7 @@ func TestArticleDelete(t *testing.T) {
 	dbCategory2 := &dbModel.Category{
 		ID: "21111111-1111-1111-1111-111111111112",
 		Name: "Category2",
+		Slug: "category2",
 		DisplayOrder: null.IntFrom(99),
 	}
 	err = dbCategory2.Insert(ctx, tx, boil.Infer())
@@ -887,29 +1394,36 @@ func TestArticleDelete(t *testing.T) {
 		panic(err)
 	}
 
-	r := NewArticleRepository(ctx, tx)
-	err = r.Insert(article1)
+	r := NewArticleRepository(tx)
+	err = r.Insert(ctx, article1)
 	if err != nil {
 		panic(err)
 	}
-	err = r.Insert(article2)
+	err = r.Insert(ctx, article2)
 	if err != nil {
 		panic(err)
 	}
 
 	// Execute
-	err = r.Delete(article1.Id)
+	err = r.Delete(ctx, article1.Id, 1)
 	if err != nil {
 		panic(err)
 	}
 
-	// Check
+	// Check: ゴミ箱に移るだけで行は残る
+	foundArticle1, err := r.FindOneById(ctx, article1.Id)
+	if err != nil {
+		panic(err)
+	}
+	if foundArticle1 != nil {
+		t.Errorf("foundArticle1: Expected %v, but got %v", nil, foundArticle1)
+	}
 	dbArticle1, err := dbModel.FindArticle(ctx, tx, article1.Id.String())
-	if err != nil && err != sql.ErrNoRows {
+	if err != nil {
 		panic(err)
 	}
-	if dbArticle1 != nil {
-		t.Errorf("dbArticle1: Expected %v, but got %v", nil, dbArticle1)
+	if !dbArticle1.DeletedAt.Valid {
+		t.Errorf("dbArticle1.DeletedAt.Valid: Expected %v, but got %v", true, dbArticle1.DeletedAt.Valid)
 	}
 
 	dbTag1, err := dbModel.FindTag(ctx, tx, "Tag1")
@@ -954,13 +1468,396 @@ func TestArticleDeleteNotExisting(t *testing.T) {
 	}
 
 	// Execute
-	r := NewArticleRepository(ctx, tx)
-	err = r.Delete(article1.Id)
+	r := NewArticleRepository(tx)
+	err = r.Delete(ctx, article1.Id, 1)
 	if err == nil {
-		t.Errorf("err of r.Delete(article1): Expected %v, but got %v", "not nil", err)
+		t.Errorf("err of r.Delete(ctx, article1): Expected %v, but got %v", "not nil", err)
 	}
 	if err.Error() != "Article to delete was not found" {
-		t.Errorf("err of r.Delete(article1): Expected %v, but got %v", "Article to delete was not found", err)
+		t.Errorf("err of r.Delete(ctx, article1): Expected %v, but got %v", "Article to delete was not found", err)
+	}
+}
+
+func TestArticleRestore(t *testing.T) {
+	db := GetTestConnection()
+	ctx := context.TODO()
+	tx := GetTestTransaction(db, ctx)
+	defer tx.Rollback()
+
+	// Prepare data
+	dbCategory1 := &dbModel.Category{
+		ID: "21111111-1111-1111-1111-111111111111",
+		Name: "Category1",
+		Slug: "category1",
+		DisplayOrder: null.IntFrom(99),
+	}
+	err := dbCategory1.Insert(ctx, tx, boil.Infer())
+	if err != nil {
+		panic(err)
+	}
+	categoryId1, err := uuid.Parse("21111111-1111-1111-1111-111111111111")
+	if err != nil {
+		panic(err)
+	}
+	article1, err := model.NewArticle("Title1", "Content1", categoryId1, []string{"Tag1", "Tag2"}, false)
+	if err != nil {
+		panic(err)
+	}
+	r := NewArticleRepository(tx)
+	err = r.Insert(ctx, article1)
+	if err != nil {
+		panic(err)
+	}
+	err = r.Delete(ctx, article1.Id, 1)
+	if err != nil {
+		panic(err)
+	}
+
+	// Execute1
+	deleted, err := r.FindDeleted(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	// Check1: 削除時のタグも返す
+	if len(deleted) != 1 || deleted[0].Id != article1.Id {
+		t.Fatalf("deleted: Expected %v, but got %v", article1.Id, deleted)
+	}
+	if deleted[0].DeletedAt == nil {
+		t.Errorf("deleted[0].DeletedAt: Expected %v, but got %v", "not nil", deleted[0].DeletedAt)
+	}
+	if len(deleted[0].Tags) != 2 || deleted[0].Tags[0].Name != "Tag1" || deleted[0].Tags[1].Name != "Tag2" {
+		t.Errorf("deleted[0].Tags: Expected %v, but got %v", []string{"Tag1", "Tag2"}, deleted[0].Tags)
+	}
+
+	// Execute2
+	err = r.Restore(ctx, article1.Id)
+	if err != nil {
+		t.Fatalf("err of r.Restore: Expected %v, but got %v", nil, err)
+	}
+
+	// Check2: タグが付け直される
+	restored, err := r.FindOneById(ctx, article1.Id)
+	if err != nil {
+		panic(err)
+	}
+	if restored == nil {
+		t.Fatalf("restored: Expected %v, but got %v", "not nil", restored)
+	}
+	if restored.DeletedAt != nil {
+		t.Errorf("restored.DeletedAt: Expected %v, but got %v", nil, restored.DeletedAt)
+	}
+	if len(restored.Tags) != 2 {
+		t.Errorf("len(restored.Tags): Expected %d, but got %d", 2, len(restored.Tags))
+	}
+	dbTag1, err := dbModel.FindTag(ctx, tx, "Tag1")
+	if err != nil && err != sql.ErrNoRows {
+		panic(err)
+	}
+	if dbTag1 == nil {
+		t.Errorf("dbTag1: Expected %v, but got %v", "not nil", dbTag1)
+	}
+
+	// Execute3: ゴミ箱にない記事
+	err = r.Restore(ctx, article1.Id)
+
+	// Check3
+	if !errs.IsKind(err, errs.NotFound) {
+		t.Errorf("err of r.Restore: Expected %s, but got %v", errs.NotFound, err)
+	}
+}
+
+func TestArticlePurge(t *testing.T) {
+	db := GetTestConnection()
+	ctx := context.TODO()
+	tx := GetTestTransaction(db, ctx)
+	defer tx.Rollback()
+
+	// Prepare data
+	now := time.Now().Truncate(time.Second)
+	dbCategory1 := &dbModel.Category{
+		ID: "21111111-1111-1111-1111-111111111111",
+		Name: "Category1",
+		Slug: "category1",
+		DisplayOrder: null.IntFrom(99),
+	}
+	err := dbCategory1.Insert(ctx, tx, boil.Infer())
+	if err != nil {
+		panic(err)
+	}
+	// 1: 保持期間を過ぎたもの, 2: 保持期間内のもの, 3: ゴミ箱にないもの
+	deletedAts := []null.Time{null.TimeFrom(now.Add(-48 * time.Hour)), null.TimeFrom(now.Add(-time.Hour)), null.TimeFromPtr(nil)}
+	for i, v := range deletedAts {
+		dbArticle := &dbModel.Article{
+			ID: fmt.Sprintf("11111111-1111-1111-1111-11111111111%d", i+1),
+			Slug: fmt.Sprintf("title%d", i+1),
+			Title: fmt.Sprintf("Title%d", i+1),
+			Content: "Content",
+			CategoryID: "21111111-1111-1111-1111-111111111111",
+			Status: "Draft",
+			CreatedAt: now,
+			UpdatedAt: now,
+			DeletedAt: v,
+		}
+		err = dbArticle.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			panic(err)
+		}
+	}
+	history := &dbModel.ArticleSlugHistory{Slug: "old-title1", ArticleID: "11111111-1111-1111-1111-111111111111"}
+	err = history.Insert(ctx, tx, boil.Infer())
+	if err != nil {
+		panic(err)
+	}
+
+	// Execute
+	r := NewArticleRepository(tx)
+	n, err := r.Purge(ctx, now.Add(-24 * time.Hour))
+
+	// Check
+	if err != nil {
+		t.Fatalf("err of r.Purge: Expected %v, but got %v", nil, err)
+	}
+	if n != 1 {
+		t.Errorf("n: Expected %d, but got %d", 1, n)
+	}
+	ids := []string{}
+	dbArticles, err := dbModel.Articles(qm.OrderBy(dbModel.ArticleColumns.ID)).All(ctx, tx)
+	if err != nil {
+		panic(err)
+	}
+	for _, v := range dbArticles {
+		ids = append(ids, v.ID)
+	}
+	if len(ids) != 2 || ids[0] != "11111111-1111-1111-1111-111111111112" || ids[1] != "11111111-1111-1111-1111-111111111113" {
+		t.Errorf("ids: Expected %s, but got %v", "articles 2 and 3", ids)
+	}
+}
+
+func TestArticlePublishScheduled(t *testing.T) {
+	db := GetTestConnection()
+	ctx := context.TODO()
+	tx := GetTestTransaction(db, ctx)
+	defer tx.Rollback()
+
+	// Prepare data
+	dbCategory1 := &dbModel.Category{
+		ID: "21111111-1111-1111-1111-111111111111",
+		Name: "Category1",
+		Slug: "category1",
+		DisplayOrder: null.IntFrom(99),
+	}
+	err := dbCategory1.Insert(ctx, tx, boil.Infer())
+	if err != nil {
+		panic(err)
+	}
+	categoryId1, err := uuid.Parse("21111111-1111-1111-1111-111111111111")
+	if err != nil {
+		panic(err)
+	}
+	now := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	due, err := model.NewArticle("Title1", "Content1", categoryId1, []string{}, false)
+	if err != nil {
+		panic(err)
+	}
+	due.PublishAt(now.Add(time.Hour), now.Add(-time.Hour))
+	notDue, err := model.NewArticle("Title2", "Content2", categoryId1, []string{}, false)
+	if err != nil {
+		panic(err)
+	}
+	notDue.PublishAt(now.Add(time.Hour*2), now.Add(-time.Hour))
+	r := NewArticleRepository(tx)
+	for _, v := range []*model.Article{due, notDue} {
+		err = r.Insert(ctx, v)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	// Execute
+	n1, err := r.PublishScheduled(ctx, now.Add(time.Hour))
+	if err != nil {
+		panic(err)
+	}
+	// 2回目は公開済みなので対象にならない
+	n2, err := r.PublishScheduled(ctx, now.Add(time.Hour))
+	if err != nil {
+		panic(err)
+	}
+
+	// Check
+	if n1 != 1 {
+		t.Errorf("n1: Expected %d, but got %d", 1, n1)
+	}
+	if n2 != 0 {
+		t.Errorf("n2: Expected %d, but got %d", 0, n2)
+	}
+	dueCheck, err := r.FindOneById(ctx, due.Id)
+	if err != nil {
+		panic(err)
+	}
+	if dueCheck.Status != model.Published {
+		t.Errorf("dueCheck.Status: Expected %s, but got %s", model.Published, dueCheck.Status)
+	}
+	notDueCheck, err := r.FindOneById(ctx, notDue.Id)
+	if err != nil {
+		panic(err)
+	}
+	if notDueCheck.Status != model.Scheduled {
+		t.Errorf("notDueCheck.Status: Expected %s, but got %s", model.Scheduled, notDueCheck.Status)
+	}
+}
+
+func TestArticleCountAndReassignCategory(t *testing.T) {
+	db := GetTestConnection()
+	ctx := context.TODO()
+	tx := GetTestTransaction(db, ctx)
+	defer tx.Rollback()
+
+	// Prepare data
+	now := time.Now().Truncate(time.Second)
+	for i := 1; i <= 2; i++ {
+		dbCategory := &dbModel.Category{
+			ID: fmt.Sprintf("21111111-1111-1111-1111-11111111111%d", i),
+			Name: fmt.Sprintf("Category%d", i),
+			Slug: fmt.Sprintf("category%d", i),
+			DisplayOrder: null.IntFrom(99),
+		}
+		err := dbCategory.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			panic(err)
+		}
+	}
+	dbArticles := []*dbModel.Article{
+		{ID: "11111111-1111-1111-1111-111111111111", Status: "Published", PublishedAt: null.TimeFrom(now)},
+		{ID: "11111111-1111-1111-1111-111111111112", Status: "Published", PublishedAt: null.TimeFrom(now)},
+		{ID: "11111111-1111-1111-1111-111111111113", Status: "Draft"},
+		{ID: "11111111-1111-1111-1111-111111111114", Status: "Draft", DeletedAt: null.TimeFrom(now)},
+	}
+	for i, v := range dbArticles {
+		v.Slug = fmt.Sprintf("title%d", i + 1)
+		v.Title = fmt.Sprintf("Title%d", i + 1)
+		v.Content = fmt.Sprintf("Content%d", i + 1)
+		v.CategoryID = "21111111-1111-1111-1111-111111111111"
+		err := v.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			panic(err)
+		}
+	}
+	categoryId1, err := uuid.Parse("21111111-1111-1111-1111-111111111111")
+	if err != nil {
+		panic(err)
+	}
+	categoryId2, err := uuid.Parse("21111111-1111-1111-1111-111111111112")
+	if err != nil {
+		panic(err)
+	}
+	r := NewArticleRepository(tx)
+
+	// Execute1
+	counts, err := r.CountByCategory(ctx, categoryId1)
+	if err != nil {
+		panic(err)
+	}
+
+	// Check1
+	expected := model.CategoryArticleCounts{Published: 2, Draft: 1, Trashed: 1}
+	if *counts != expected {
+		t.Errorf("counts: Expected %+v, but got %+v", expected, *counts)
+	}
+
+	// Execute2
+	n, err := r.ReassignCategory(ctx, categoryId1, categoryId2)
+	if err != nil {
+		panic(err)
+	}
+
+	// Check2 (ゴミ箱の記事も移す)
+	if n != 4 {
+		t.Errorf("n: Expected %d, but got %d", 4, n)
+	}
+	counts, err = r.CountByCategory(ctx, categoryId2)
+	if err != nil {
+		panic(err)
+	}
+	if *counts != expected {
+		t.Errorf("counts of categoryId2: Expected %+v, but got %+v", expected, *counts)
+	}
+	article1, err := r.FindOneById(ctx, uuid.MustParse("11111111-1111-1111-1111-111111111111"))
+	if err != nil {
+		panic(err)
+	}
+	if article1.Version != 2 {
+		t.Errorf("article1.Version: Expected %d, but got %d", 2, article1.Version)
+	}
+}
+
+func TestArticleCountPublishedByMonth(t *testing.T) {
+	db := GetTestConnection()
+	ctx := context.TODO()
+	tx := GetTestTransaction(db, ctx)
+	defer tx.Rollback()
+
+	// Prepare data
+	_, err := dbModel.Taggings().DeleteAll(ctx, tx)
+	if err != nil {
+		panic(err)
+	}
+	_, err = dbModel.Articles().DeleteAll(ctx, tx)
+	if err != nil {
+		panic(err)
+	}
+	dbCategory1 := &dbModel.Category{
+		ID: "21111111-1111-1111-1111-111111111111",
+		Name: "Category1",
+		Slug: "category1",
+		DisplayOrder: null.IntFrom(99),
+	}
+	err = dbCategory1.Insert(ctx, tx, boil.Infer())
+	if err != nil {
+		panic(err)
+	}
+	// UTCでは3月でも、日本時間では4月になる記事がある
+	dbArticles := []*dbModel.Article{
+		{Status: "Published", PublishedAt: null.TimeFrom(time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC))},
+		{Status: "Published", PublishedAt: null.TimeFrom(time.Date(2025, 3, 31, 14, 30, 0, 0, time.UTC))},
+		{Status: "Published", PublishedAt: null.TimeFrom(time.Date(2025, 3, 31, 15, 30, 0, 0, time.UTC))},
+		{Status: "Published", PublishedAt: null.TimeFrom(time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC))},
+		{Status: "Draft"},
+		{Status: "Published", PublishedAt: null.TimeFrom(time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)), DeletedAt: null.TimeFrom(time.Now())},
+	}
+	for i, v := range dbArticles {
+		v.ID = fmt.Sprintf("11111111-1111-1111-1111-11111111111%d", i + 1)
+		v.Slug = fmt.Sprintf("title%d", i + 1)
+		v.Title = fmt.Sprintf("Title%d", i + 1)
+		v.Content = fmt.Sprintf("Content%d", i + 1)
+		v.CategoryID = "21111111-1111-1111-1111-111111111111"
+		err := v.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			panic(err)
+		}
+	}
+	loc, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		panic(err)
+	}
+
+	// Execute
+	r := NewArticleRepository(tx)
+	months, err := r.CountPublishedByMonth(ctx, loc)
+	if err != nil {
+		panic(err)
+	}
+
+	// Check
+	expected := []model.ArchiveMonth{{Year: 2025, Month: 4, Count: 1}, {Year: 2025, Month: 3, Count: 2}, {Year: 2024, Month: 12, Count: 1}}
+	if len(months) != len(expected) {
+		t.Fatalf("len(months): Expected %d, but got %d", len(expected), len(months))
+	}
+	for i, v := range expected {
+		if *months[i] != v {
+			t.Errorf("months[%d]: Expected %+v, but got %+v", i, v, *months[i])
+		}
 	}
 }
 
@@ -974,6 +1871,7 @@ func TestArticleHandleNoTagArticle(t *testing.T) {
 	dbCategory1 := &dbModel.Category{
 		ID: "21111111-1111-1111-1111-111111111111",
 		Name: "Category1",
+		Slug: "category1",
 		DisplayOrder: null.IntFrom(99),
 	}
 	err := dbCategory1.Insert(ctx, tx, boil.Infer())
@@ -992,14 +1890,14 @@ func TestArticleHandleNoTagArticle(t *testing.T) {
 	}
 
 	// Execute
-	r := NewArticleRepository(ctx, tx)
-	err = r.Insert(article1)
+	r := NewArticleRepository(tx)
+	err = r.Insert(ctx, article1)
 	if err != nil {
 		panic(err)
 	}
 
 	// Check
-	article1Check, err := r.FindOneById(article1.Id)
+	article1Check, err := r.FindOneById(ctx, article1.Id)
 	if err != nil {
 		panic(err)
 	}
@@ -1009,13 +1907,13 @@ func TestArticleHandleNoTagArticle(t *testing.T) {
 
 	// Execute2
 	article1.SetTags([]string{"Tag1"})
-	err = r.Update(article1)
+	err = r.Update(ctx, article1)
 	if err != nil {
 		panic(err)
 	}
 
 	// Check2
-	article1Check2, err := r.FindOneById(article1.Id)
+	article1Check2, err := r.FindOneById(ctx, article1.Id)
 	if err != nil {
 		panic(err)
 	}
@@ -1025,13 +1923,13 @@ func TestArticleHandleNoTagArticle(t *testing.T) {
 
 	// Execute3
 	article1.SetTags([]string{})
-	err = r.Update(article1)
+	err = r.Update(ctx, article1)
 	if err != nil {
 		panic(err)
 	}
 
 	// Check3
-	article1Check3, err := r.FindOneById(article1.Id)
+	article1Check3, err := r.FindOneById(ctx, article1.Id)
 	if err != nil {
 		panic(err)
 	}
@@ -1040,17 +1938,148 @@ func TestArticleHandleNoTagArticle(t *testing.T) {
 	}
 
 	// Execute4
-	err = r.Delete(article1.Id)
+	err = r.Delete(ctx, article1.Id, article1.Version)
 	if err != nil {
 		panic(err)
 	}
 
 	// Check4
-	article1Check4, err := r.FindOneById(article1.Id)
+	article1Check4, err := r.FindOneById(ctx, article1.Id)
 	if err != nil {
 		panic(err)
 	}
 	if article1Check4 != nil {
 		t.Errorf("article1Check4: Expected %v, bot got %v", nil, article1Check4)
 	}
-}
\ No newline at end of file
+}
+
+// 発行したクエリ数を数えるためのExecutor
+type queryCountingExecutor struct {
+	boil.ContextExecutor
+	count int
+}
+
+func (e *queryCountingExecutor) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	e.count++
+	return e.ContextExecutor.QueryContext(ctx, query, args...)
+}
+
+func (e *queryCountingExecutor) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	e.count++
+	return e.ContextExecutor.QueryRowContext(ctx, query, args...)
+}
+
+func (e *queryCountingExecutor) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	e.count++
+	return e.ContextExecutor.ExecContext(ctx, query, args...)
+}
+
+func BenchmarkArticleFind(b *testing.B) {
+	db := GetTestConnection()
+	ctx := context.TODO()
+
+	for _, pageSize := range []int{10, 100, 500} {
+		b.Run(fmt.Sprintf("pageSize=%d", pageSize), func(b *testing.B) {
+			tx := GetTestTransaction(db, ctx)
+			defer tx.Rollback()
+
+			// Prepare data
+			_, err := dbModel.Taggings().DeleteAll(ctx, tx)
+			if err != nil {
+				panic(err)
+			}
+			_, err = dbModel.Articles().DeleteAll(ctx, tx)
+			if err != nil {
+				panic(err)
+			}
+			dbCategory1 := &dbModel.Category{
+				ID: "21111111-1111-1111-1111-111111111111",
+				Name: "Category1",
+				Slug: "category1",
+				DisplayOrder: null.IntFrom(99),
+			}
+			err = dbCategory1.Insert(ctx, tx, boil.Infer())
+			if err != nil {
+				panic(err)
+			}
+			for _, v := range []string{"Tag1", "Tag2"} {
+				dbTag := &dbModel.Tag{
+					Name: v,
+				}
+				err = dbTag.Insert(ctx, tx, boil.Infer())
+				if err != nil {
+					panic(err)
+				}
+			}
+			var authorIds []string
+			for _, v := range []string{"author1", "author2"} {
+				dbAuthor := &dbModel.Author{
+					ID: uuid.NewString(),
+					DisplayName: v,
+					Slug: v,
+				}
+				err = dbAuthor.Insert(ctx, tx, boil.Infer())
+				if err != nil {
+					panic(err)
+				}
+				authorIds = append(authorIds, dbAuthor.ID)
+			}
+			for i := 0; i < pageSize; i++ {
+				dbArticle := &dbModel.Article{
+					ID: uuid.NewString(),
+					Slug: uuid.NewString(),
+					Title: fmt.Sprintf("Title%d", i),
+					Content: "Content",
+					CategoryID: "21111111-1111-1111-1111-111111111111",
+					Status: "Draft",
+				}
+				err = dbArticle.Insert(ctx, tx, boil.Infer())
+				if err != nil {
+					panic(err)
+				}
+				for _, v := range []string{"Tag1", "Tag2"} {
+					dbTagging := &dbModel.Tagging{
+						ArticleID: dbArticle.ID,
+						TagName: v,
+					}
+					err = dbTagging.Insert(ctx, tx, boil.Infer())
+					if err != nil {
+						panic(err)
+					}
+				}
+				for j, v := range authorIds {
+					dbArticleAuthor := &dbModel.ArticleAuthor{
+						ArticleID: dbArticle.ID,
+						AuthorID: v,
+						Position: j,
+					}
+					err = dbArticleAuthor.Insert(ctx, tx, boil.Infer())
+					if err != nil {
+						panic(err)
+					}
+				}
+			}
+			exec := &queryCountingExecutor{ContextExecutor: tx}
+			r := NewArticleRepository(exec)
+
+			// Execute
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				exec.count = 0
+				articles, _, err := r.Find(ctx, &repository.ArticleCriteria{Limit: pageSize})
+				if err != nil {
+					panic(err)
+				}
+				// Check
+				if len(articles) != pageSize || len(articles[0].Tags) != 2 || len(articles[0].Authors) != 2 {
+					b.Fatalf("articles: Expected %d articles with %d tags and %d authors, but got %d", pageSize, 2, 2, len(articles))
+				}
+				// 記事1クエリ + タグ1クエリ + 著者の紐付け1クエリ + 著者1クエリ（ページの大きさによらない）
+				if exec.count != 4 {
+					b.Fatalf("exec.count: Expected %d, but got %d", 4, exec.count)
+				}
+			}
+			b.ReportMetric(float64(exec.count), "queries/op")
+		})
+	}
+}
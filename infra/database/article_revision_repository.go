@@ -0,0 +1,113 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+	"github.com/momonoki1990/tech-blog-api/domain/repository"
+	dbModel "github.com/momonoki1990/tech-blog-api/infra/database/model"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type ArticleRevisionRepository struct {
+	exec boil.ContextExecutor
+}
+
+func NewArticleRevisionRepository(exec boil.ContextExecutor) repository.ArticleRevisionRepository {
+	return &ArticleRevisionRepository{exec}
+}
+
+func (r *ArticleRevisionRepository) FindByArticleId(ctx context.Context, articleId uuid.UUID) ([]*model.ArticleRevision, error) {
+	dbRevisions, err := dbModel.ArticleRevisions(
+		dbModel.ArticleRevisionWhere.ArticleID.EQ(articleId.String()),
+		qm.OrderBy(dbModel.ArticleRevisionColumns.RevisionNumber+" DESC"),
+	).All(ctx, r.exec)
+	if err != nil {
+		return nil, err
+	}
+	revisions := []*model.ArticleRevision{}
+	for _, v := range dbRevisions {
+		revision, err := toArticleRevision(v)
+		if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, revision)
+	}
+	return revisions, nil
+}
+
+func (r *ArticleRevisionRepository) FindOne(ctx context.Context, articleId uuid.UUID, number int) (*model.ArticleRevision, error) {
+	return r.findOne(ctx,
+		dbModel.ArticleRevisionWhere.ArticleID.EQ(articleId.String()),
+		dbModel.ArticleRevisionWhere.RevisionNumber.EQ(number),
+	)
+}
+
+func (r *ArticleRevisionRepository) FindLatest(ctx context.Context, articleId uuid.UUID) (*model.ArticleRevision, error) {
+	return r.findOne(ctx,
+		dbModel.ArticleRevisionWhere.ArticleID.EQ(articleId.String()),
+		qm.OrderBy(dbModel.ArticleRevisionColumns.RevisionNumber+" DESC"),
+	)
+}
+
+func (r *ArticleRevisionRepository) findOne(ctx context.Context, mods ...qm.QueryMod) (*model.ArticleRevision, error) {
+	dbRevision, err := dbModel.ArticleRevisions(mods...).One(ctx, r.exec)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+	if dbRevision == nil {
+		return nil, nil
+	}
+	return toArticleRevision(dbRevision)
+}
+
+func (r *ArticleRevisionRepository) Insert(ctx context.Context, revision *model.ArticleRevision) (error) {
+	tagNames, err := json.Marshal(revision.TagNames)
+	if err != nil {
+		return err
+	}
+	dbRevision := &dbModel.ArticleRevision{
+		ID: revision.Id.String(),
+		ArticleID: revision.ArticleId.String(),
+		RevisionNumber: revision.Number,
+		Title: revision.Title,
+		Content: revision.Content,
+		CategoryID: revision.CategoryId.String(),
+		TagNames: tagNames,
+		CreatedAt: revision.CreatedAt,
+	}
+	return dbRevision.Insert(ctx, r.exec, boil.Infer())
+}
+
+func toArticleRevision(d *dbModel.ArticleRevision) (*model.ArticleRevision, error) {
+	id, err := uuid.Parse(d.ID)
+	if err != nil {
+		return nil, err
+	}
+	articleId, err := uuid.Parse(d.ArticleID)
+	if err != nil {
+		return nil, err
+	}
+	categoryId, err := uuid.Parse(d.CategoryID)
+	if err != nil {
+		return nil, err
+	}
+	tagNames := []string{}
+	if err := d.TagNames.Unmarshal(&tagNames); err != nil {
+		return nil, err
+	}
+	return &model.ArticleRevision{
+		Id: id,
+		ArticleId: articleId,
+		Number: d.RevisionNumber,
+		Title: d.Title,
+		Content: d.Content,
+		CategoryId: categoryId,
+		TagNames: tagNames,
+		CreatedAt: d.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,144 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+	dbModel "github.com/momonoki1990/tech-blog-api/infra/database/model"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestArticleRevisionInsertAndFind(t *testing.T) {
+	db := GetTestConnection()
+	ctx := context.TODO()
+	tx := GetTestTransaction(db, ctx)
+	defer tx.Rollback()
+
+	// Prepare data
+	now := time.Now().Truncate(time.Second)
+	dbCategory1 := &dbModel.Category{
+		ID: "21111111-1111-1111-1111-111111111111",
+		Name: "Category1",
+		Slug: "category1",
+		DisplayOrder: null.IntFrom(99),
+	}
+	err := dbCategory1.Insert(ctx, tx, boil.Infer())
+	if err != nil {
+		panic(err)
+	}
+	dbArticle1 := &dbModel.Article{
+		ID: "11111111-1111-1111-1111-111111111111",
+		Slug: "title1",
+		Title: "Title1",
+		Content: "Content1",
+		CategoryID: "21111111-1111-1111-1111-111111111111",
+		Status: "Draft",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	err = dbArticle1.Insert(ctx, tx, boil.Infer())
+	if err != nil {
+		panic(err)
+	}
+	articleId := uuid.MustParse(dbArticle1.ID)
+	article := &model.Article{
+		Id: articleId,
+		Title: "Title1",
+		Content: "Content1",
+		CategoryId: uuid.MustParse(dbCategory1.ID),
+		Tags: []model.Tag{{Name: "Tag1"}, {Name: "Tag2"}},
+	}
+	revision1 := model.NewArticleRevision(article, 1)
+	article.Title = "Title1Changed"
+	revision2 := model.NewArticleRevision(article, 2)
+
+	// Execute
+	r := NewArticleRevisionRepository(tx)
+	for _, v := range []*model.ArticleRevision{revision1, revision2} {
+		if err := r.Insert(ctx, v); err != nil {
+			t.Fatalf("err of r.Insert: Expected %v, but got %v", nil, err)
+		}
+	}
+	revisions, err := r.FindByArticleId(ctx, articleId)
+	if err != nil {
+		t.Fatalf("err of r.FindByArticleId: Expected %v, but got %v", nil, err)
+	}
+	found, err := r.FindOne(ctx, articleId, 1)
+	if err != nil {
+		t.Fatalf("err of r.FindOne: Expected %v, but got %v", nil, err)
+	}
+	notFound, err := r.FindOne(ctx, articleId, 3)
+	if err != nil {
+		t.Fatalf("err of r.FindOne: Expected %v, but got %v", nil, err)
+	}
+	latest, err := r.FindLatest(ctx, articleId)
+	if err != nil {
+		t.Fatalf("err of r.FindLatest: Expected %v, but got %v", nil, err)
+	}
+
+	// Check
+	if len(revisions) != 2 || revisions[0].Number != 2 || revisions[1].Number != 1 {
+		t.Errorf("revisions: Expected numbers %s, but got %v", "2,1", revisions)
+	}
+	if found == nil || found.Title != "Title1" || !found.Matches(&model.Article{Title: "Title1", Content: "Content1", CategoryId: uuid.MustParse(dbCategory1.ID), Tags: []model.Tag{{Name: "Tag1"}, {Name: "Tag2"}}}) {
+		t.Errorf("found: Expected revision 1 of Title1, but got %v", found)
+	}
+	if notFound != nil {
+		t.Errorf("notFound: Expected %v, but got %v", nil, notFound)
+	}
+	if latest == nil || latest.Number != 2 || latest.Title != "Title1Changed" {
+		t.Errorf("latest: Expected revision 2 of Title1Changed, but got %v", latest)
+	}
+}
+
+func TestArticleRevisionInsertDuplicateNumber(t *testing.T) {
+	db := GetTestConnection()
+	ctx := context.TODO()
+	tx := GetTestTransaction(db, ctx)
+	defer tx.Rollback()
+
+	// Prepare data
+	now := time.Now().Truncate(time.Second)
+	dbCategory1 := &dbModel.Category{
+		ID: "21111111-1111-1111-1111-111111111111",
+		Name: "Category1",
+		Slug: "category1",
+		DisplayOrder: null.IntFrom(99),
+	}
+	err := dbCategory1.Insert(ctx, tx, boil.Infer())
+	if err != nil {
+		panic(err)
+	}
+	dbArticle1 := &dbModel.Article{
+		ID: "11111111-1111-1111-1111-111111111111",
+		Slug: "title1",
+		Title: "Title1",
+		Content: "Content1",
+		CategoryID: "21111111-1111-1111-1111-111111111111",
+		Status: "Draft",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	err = dbArticle1.Insert(ctx, tx, boil.Infer())
+	if err != nil {
+		panic(err)
+	}
+	article := &model.Article{Id: uuid.MustParse(dbArticle1.ID), Title: "Title1", CategoryId: uuid.MustParse(dbCategory1.ID)}
+	r := NewArticleRevisionRepository(tx)
+	if err := r.Insert(ctx, model.NewArticleRevision(article, 1)); err != nil {
+		panic(err)
+	}
+
+	// Execute: 同時に更新されて同じ番号になった場合
+	err = r.Insert(ctx, model.NewArticleRevision(article, 1))
+
+	// Check
+	if err == nil {
+		t.Errorf("err of r.Insert: Expected %s, but got %v", "duplicate error", err)
+	}
+}
@@ -0,0 +1,80 @@
+package database
+
+import (
+	"context"
+	"strings"
+
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+	"github.com/momonoki1990/tech-blog-api/domain/repository"
+	dbModel "github.com/momonoki1990/tech-blog-api/infra/database/model"
+	"github.com/momonoki1990/tech-blog-api/infra/search"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+// articlesのFULLTEXTインデックス（ngramパーサ）を使った全文検索
+type ArticleSearcher struct {
+	exec boil.ContextExecutor
+}
+
+func NewArticleSearcher(exec boil.ContextExecutor) repository.ArticleSearcher {
+	return &ArticleSearcher{exec}
+}
+
+type scoredArticle struct {
+	dbModel.Article `boil:",bind"`
+	Score float64 `boil:"score"`
+}
+
+const searchArticlesQuery = `
+SELECT articles.*, MATCH (title, content) AGAINST (? IN BOOLEAN MODE) AS score
+FROM articles
+WHERE MATCH (title, content) AGAINST (? IN BOOLEAN MODE) AND status = ? AND deleted_at IS NULL
+ORDER BY score DESC, published_at DESC
+LIMIT ?`
+
+func (s *ArticleSearcher) Search(ctx context.Context, query string, limit int) ([]*repository.ArticleHit, error) {
+	terms := search.Terms(query)
+	if len(terms) == 0 {
+		return []*repository.ArticleHit{}, nil
+	}
+	against := toBooleanQuery(terms)
+	if against == "" {
+		return []*repository.ArticleHit{}, nil
+	}
+
+	var rows []*scoredArticle
+	err := queries.Raw(searchArticlesQuery, against, against, model.Published.String(), limit).Bind(ctx, s.exec, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	dbArticles := make([]*dbModel.Article, 0, len(rows))
+	for _, v := range rows {
+		dbArticles = append(dbArticles, &v.Article)
+	}
+	articles, err := toArticles(ctx, dbArticles, &ArticleRepository{s.exec})
+	if err != nil {
+		return nil, err
+	}
+
+	hits := make([]*repository.ArticleHit, 0, len(articles))
+	for i, a := range articles {
+		hits = append(hits, search.NewHit(a, rows[i].Score, terms))
+	}
+	return hits, nil
+}
+
+// すべての検索語を含む記事に絞る（ngramパーサでは語句検索で連続した文字列として一致する）
+// 検索語に含まれる演算子はそのまま渡さない
+func toBooleanQuery(terms []string) string {
+	quoted := make([]string, 0, len(terms))
+	for _, t := range terms {
+		t = strings.ReplaceAll(t, `"`, "")
+		if t == "" {
+			continue
+		}
+		quoted = append(quoted, `+"`+t+`"`)
+	}
+	return strings.Join(quoted, " ")
+}
@@ -0,0 +1,17 @@
+package database
+
+import "testing"
+
+func TestToBooleanQuery(t *testing.T) {
+	// Prepare
+	terms := []string{"go言語", `"テスト"`, `"`}
+
+	// Execute
+	got := toBooleanQuery(terms)
+
+	// Check
+	expected := `+"go言語" +"テスト"`
+	if got != expected {
+		t.Errorf("query: Expected %v, but got %v", expected, got)
+	}
+}
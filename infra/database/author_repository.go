@@ -0,0 +1,120 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/momonoki1990/tech-blog-api/domain/errs"
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+	"github.com/momonoki1990/tech-blog-api/domain/repository"
+	dbModel "github.com/momonoki1990/tech-blog-api/infra/database/model"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type AuthorRepository struct {
+	exec boil.ContextExecutor
+}
+
+func NewAuthorRepository(exec boil.ContextExecutor) repository.AuthorRepository {
+	return &AuthorRepository{exec}
+}
+
+func (r *AuthorRepository) Find(ctx context.Context) ([]*model.Author, error) {
+	dbAuthors, err := dbModel.Authors(
+		qm.OrderBy(dbModel.AuthorColumns.DisplayName+" ASC, "+dbModel.AuthorColumns.ID+" ASC"),
+	).All(ctx, r.exec)
+	if err != nil {
+		return nil, err
+	}
+	return toAuthors(dbAuthors)
+}
+
+func (r *AuthorRepository) FindOneBySlug(ctx context.Context, slug string) (*model.Author, error) {
+	return r.findOne(ctx, dbModel.AuthorWhere.Slug.EQ(slug))
+}
+
+func (r *AuthorRepository) FindOneByUserId(ctx context.Context, userId uuid.UUID) (*model.Author, error) {
+	return r.findOne(ctx, dbModel.AuthorWhere.UserID.EQ(null.StringFrom(userId.String())))
+}
+
+func (r *AuthorRepository) FindBySlugs(ctx context.Context, slugs []string) ([]*model.Author, error) {
+	if len(slugs) == 0 {
+		return []*model.Author{}, nil
+	}
+	dbAuthors, err := dbModel.Authors(dbModel.AuthorWhere.Slug.IN(slugs)).All(ctx, r.exec)
+	if err != nil {
+		return nil, err
+	}
+	return toAuthors(dbAuthors)
+}
+
+func (r *AuthorRepository) Insert(ctx context.Context, a *model.Author) (error) {
+	dbAuthor := &dbModel.Author{
+		ID: a.Id.String(),
+		DisplayName: a.DisplayName,
+		Slug: a.Slug,
+		Bio: a.Bio,
+		AvatarURL: a.AvatarUrl,
+		CreatedAt: a.CreatedAt,
+		UpdatedAt: a.UpdatedAt,
+	}
+	if a.UserId != nil {
+		dbAuthor.UserID = null.StringFrom(a.UserId.String())
+	}
+	err := dbAuthor.Insert(ctx, r.exec, boil.Infer())
+	if isDuplicateEntryError(err) {
+		return errs.NewConflict(errs.CodeAuthorSlugConflict, "Author slug or user is already in use")
+	}
+	return err
+}
+
+func (r *AuthorRepository) findOne(ctx context.Context, mods ...qm.QueryMod) (*model.Author, error) {
+	dbAuthor, err := dbModel.Authors(mods...).One(ctx, r.exec)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toAuthor(dbAuthor)
+}
+
+func toAuthors(dbAuthors []*dbModel.Author) ([]*model.Author, error) {
+	authors := make([]*model.Author, 0, len(dbAuthors))
+	for _, v := range dbAuthors {
+		a, err := toAuthor(v)
+		if err != nil {
+			return nil, err
+		}
+		authors = append(authors, a)
+	}
+	return authors, nil
+}
+
+func toAuthor(d *dbModel.Author) (*model.Author, error) {
+	id, err := uuid.Parse(d.ID)
+	if err != nil {
+		return nil, err
+	}
+	var userId *uuid.UUID
+	if d.UserID.Valid {
+		v, err := uuid.Parse(d.UserID.String)
+		if err != nil {
+			return nil, err
+		}
+		userId = &v
+	}
+	return &model.Author{
+		Id: id,
+		UserId: userId,
+		DisplayName: d.DisplayName,
+		Slug: d.Slug,
+		Bio: d.Bio,
+		AvatarUrl: d.AvatarURL,
+		CreatedAt: d.CreatedAt,
+		UpdatedAt: d.UpdatedAt,
+	}, nil
+}
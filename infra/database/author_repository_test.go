@@ -0,0 +1,171 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
+	"github.com/momonoki1990/tech-blog-api/domain/errs"
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+	"github.com/momonoki1990/tech-blog-api/domain/repository"
+	dbModel "github.com/momonoki1990/tech-blog-api/infra/database/model"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestAuthorInsertAndFind(t *testing.T) {
+	db := GetTestConnection()
+	ctx := context.TODO()
+	tx := GetTestTransaction(db, ctx)
+	defer tx.Rollback()
+
+	// Prepare
+	user, err := model.NewUser("author-owner", "correct horse battery", model.RoleWriter)
+	if err != nil {
+		panic(err)
+	}
+	if err := NewUserRepository(tx).Insert(ctx, user); err != nil {
+		panic(err)
+	}
+	bob, err := model.NewAuthor(&user.Id, "Bob", "bob", "Writes about Go", "https://example.com/bob.png")
+	if err != nil {
+		panic(err)
+	}
+	alice, err := model.NewAuthor(nil, "Alice", "alice", "", "")
+	if err != nil {
+		panic(err)
+	}
+	duplicated, err := model.NewAuthor(nil, "Bob 2", "bob", "", "")
+	if err != nil {
+		panic(err)
+	}
+
+	// Execute
+	r := NewAuthorRepository(tx)
+	for _, v := range []*model.Author{bob, alice} {
+		if err := r.Insert(ctx, v); err != nil {
+			panic(err)
+		}
+	}
+	conflictErr := r.Insert(ctx, duplicated)
+	all, err := r.Find(ctx)
+	if err != nil {
+		panic(err)
+	}
+	byUser, err := r.FindOneByUserId(ctx, user.Id)
+	if err != nil {
+		panic(err)
+	}
+	bySlugs, err := r.FindBySlugs(ctx, []string{"alice", "nobody"})
+	if err != nil {
+		panic(err)
+	}
+	notFound, err := r.FindOneBySlug(ctx, "nobody")
+	if err != nil {
+		panic(err)
+	}
+
+	// Check
+	if !errs.IsKind(conflictErr, errs.Conflict) {
+		t.Errorf("conflictErr: Expected %v, but got %v", errs.Conflict, conflictErr)
+	}
+	// 表示名の順
+	if len(all) != 2 || all[0].Slug != "alice" || all[1].Slug != "bob" {
+		t.Errorf("all: Expected %v, but got %v", []string{"alice", "bob"}, all)
+	}
+	if byUser == nil || byUser.Id != bob.Id || byUser.Bio != "Writes about Go" || byUser.AvatarUrl != "https://example.com/bob.png" {
+		t.Errorf("byUser: Expected %v, but got %v", bob, byUser)
+	}
+	if len(bySlugs) != 1 || bySlugs[0].Id != alice.Id {
+		t.Errorf("bySlugs: Expected %v, but got %v", []*model.Author{alice}, bySlugs)
+	}
+	if notFound != nil {
+		t.Errorf("notFound: Expected %v, but got %v", nil, notFound)
+	}
+}
+
+func TestArticleAuthors(t *testing.T) {
+	db := GetTestConnection()
+	ctx := context.TODO()
+	tx := GetTestTransaction(db, ctx)
+	defer tx.Rollback()
+
+	// Prepare
+	dbCategory := &dbModel.Category{
+		ID: "21111111-1111-1111-1111-111111111111",
+		Name: "Category1",
+		Slug: "category1",
+		DisplayOrder: null.IntFrom(1),
+	}
+	if err := dbCategory.Insert(ctx, tx, boil.Infer()); err != nil {
+		panic(err)
+	}
+	ar := NewAuthorRepository(tx)
+	alice, err := model.NewAuthor(nil, "Alice", "alice", "", "")
+	if err != nil {
+		panic(err)
+	}
+	bob, err := model.NewAuthor(nil, "Bob", "bob", "", "")
+	if err != nil {
+		panic(err)
+	}
+	for _, v := range []*model.Author{alice, bob} {
+		if err := ar.Insert(ctx, v); err != nil {
+			panic(err)
+		}
+	}
+	article, err := model.NewArticle("Title1", "Content1", uuid.MustParse(dbCategory.ID), []string{}, true)
+	if err != nil {
+		panic(err)
+	}
+	article.Authors = []model.AuthorSummary{bob.Summary(), alice.Summary()}
+	other, err := model.NewArticle("Title2", "Content2", uuid.MustParse(dbCategory.ID), []string{}, true)
+	if err != nil {
+		panic(err)
+	}
+	other.Authors = []model.AuthorSummary{bob.Summary()}
+
+	// Execute
+	r := NewArticleRepository(tx)
+	for _, v := range []*model.Article{article, other} {
+		if err := r.Insert(ctx, v); err != nil {
+			panic(err)
+		}
+	}
+	inserted, err := r.FindOneById(ctx, article.Id)
+	if err != nil {
+		panic(err)
+	}
+	byAlice, _, err := r.Find(ctx, &repository.ArticleCriteria{AuthorId: &alice.Id})
+	if err != nil {
+		panic(err)
+	}
+	inserted.Authors = []model.AuthorSummary{alice.Summary()}
+	if err := r.Update(ctx, inserted); err != nil {
+		panic(err)
+	}
+	updated, err := r.FindOneById(ctx, article.Id)
+	if err != nil {
+		panic(err)
+	}
+	byBob, _, err := r.Find(ctx, &repository.ArticleCriteria{AuthorId: &bob.Id})
+	if err != nil {
+		panic(err)
+	}
+
+	// Check
+	// 著者は指定した順に並ぶ
+	if len(inserted.Authors) != 1 || len(article.Authors) != 2 {
+		t.Fatalf("article.Authors: Expected %d authors, but got %v", 2, article.Authors)
+	}
+	if len(byAlice) != 1 || byAlice[0].Id != article.Id {
+		t.Errorf("byAlice: Expected [%v], but got %v", article.Id, byAlice)
+	}
+	if len(updated.Authors) != 1 || updated.Authors[0].Slug != "alice" {
+		t.Errorf("updated.Authors: Expected %v, but got %v", inserted.Authors, updated.Authors)
+	}
+	if len(byBob) != 1 || byBob[0].Id != other.Id {
+		t.Errorf("byBob: Expected [%v], but got %v", other.Id, byBob)
+	}
+}
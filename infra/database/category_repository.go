@@ -3,42 +3,55 @@ package database
 import (
 	"context"
 	"database/sql"
-	"errors"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/momonoki1990/tech-blog-api/domain/errs"
 	"github.com/momonoki1990/tech-blog-api/domain/model"
 	"github.com/momonoki1990/tech-blog-api/domain/repository"
 	dbModel "github.com/momonoki1990/tech-blog-api/infra/database/model"
 	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
 type CategoryRepository struct {
-	ctx context.Context
 	exec boil.ContextExecutor
 }
 
-func NewCategoryRepository(ctx context.Context,  exec boil.ContextExecutor) repository.CategoryRepository {
-    return &CategoryRepository{ctx, exec}
+func NewCategoryRepository(exec boil.ContextExecutor) repository.CategoryRepository {
+    return &CategoryRepository{exec}
 }
 
-func (r *CategoryRepository)FindOneByName(name string) (*model.Category, error) {
-	dbCategories, err := dbModel.Categories(dbModel.CategoryWhere.Name.EQ(name)).One(r.ctx, r.exec)
+func (r *CategoryRepository) FindIdByName(ctx context.Context, name string) (*uuid.UUID, error) {
+	dbCategory, err := dbModel.Categories(qm.Select(dbModel.CategoryColumns.ID), dbModel.CategoryWhere.Name.EQ(name)).One(ctx, r.exec)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
 	if err != nil {
 		return nil, err
 	}
-	category, err := toCategory(dbCategories)
+	id, err := uuid.Parse(dbCategory.ID)
 	if err != nil {
 		return nil, err
 	}
-	return category, nil
+	return &id, nil
+}
+
+func (r *CategoryRepository)FindOneById(ctx context.Context, id uuid.UUID) (*model.Category, error) {
+	return r.findOneById(ctx, id)
 }
 
-func (r *CategoryRepository)FindOneById(id uuid.UUID) (*model.Category, error) {
-	dbCategory, err := dbModel.Categories(dbModel.CategoryWhere.ID.EQ(id.String())).One(r.ctx, r.exec)
+func (r *CategoryRepository) FindOneByIdForUpdate(ctx context.Context, id uuid.UUID) (*model.Category, error) {
+	return r.findOneById(ctx, id, qm.For("UPDATE"))
+}
+
+func (r *CategoryRepository) findOneById(ctx context.Context, id uuid.UUID, mods ...qm.QueryMod) (*model.Category, error) {
+	mods = append([]qm.QueryMod{dbModel.CategoryWhere.ID.EQ(id.String()), dbModel.CategoryWhere.DeletedAt.IsNull()}, mods...)
+	dbCategory, err := dbModel.Categories(mods...).One(ctx, r.exec)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -52,8 +65,36 @@ func (r *CategoryRepository)FindOneById(id uuid.UUID) (*model.Category, error) {
 	return category, nil
 }
 
-func (r *CategoryRepository) Find() ([]*model.Category, error) {
-	dbCategories, err := dbModel.Categories().All(r.ctx, r.exec)
+func (r *CategoryRepository) FindIdBySlug(ctx context.Context, slug string) (*uuid.UUID, error) {
+	dbCategory, err := dbModel.Categories(qm.Select(dbModel.CategoryColumns.ID), dbModel.CategoryWhere.Slug.EQ(slug)).One(ctx, r.exec)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	id, err := uuid.Parse(dbCategory.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+func (r *CategoryRepository) Find(ctx context.Context) ([]*model.Category, error) {
+	return r.find(ctx)
+}
+
+func (r *CategoryRepository) FindForUpdate(ctx context.Context) ([]*model.Category, error) {
+	return r.find(ctx, qm.For("UPDATE"))
+}
+
+func (r *CategoryRepository) find(ctx context.Context, mods ...qm.QueryMod) ([]*model.Category, error) {
+	mods = append([]qm.QueryMod{
+		dbModel.CategoryWhere.DeletedAt.IsNull(),
+		// 表示順が同じでも毎回同じ順に並べる
+		qm.OrderBy(fmt.Sprintf("%s ASC, %s ASC, %s ASC", dbModel.CategoryColumns.DisplayOrder, dbModel.CategoryColumns.Name, dbModel.CategoryColumns.ID)),
+	}, mods...)
+	dbCategories, err := dbModel.Categories(mods...).All(ctx, r.exec)
 	if err == sql.ErrNoRows {
 		return []*model.Category{}, nil
 	}
@@ -67,50 +108,271 @@ func (r *CategoryRepository) Find() ([]*model.Category, error) {
 	return categories, nil
 }
 
-func (r *CategoryRepository) Insert(c *model.Category) (error) {
+func (r *CategoryRepository) FindStats(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*model.CategoryStats, error) {
+	return findCategoryStats(ctx, r.exec, ids)
+}
+
+func (r *CategoryRepository) Insert(ctx context.Context, c *model.Category) (error) {
 	dbCategory := toDbCategory(c)
-	err := dbCategory.Insert(r.ctx, r.exec, boil.Infer())
+	err := dbCategory.Insert(ctx, r.exec, boil.Infer())
+	if err := r.toConflict(ctx, c, err); err != nil {
+		return err
+	}
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (r *CategoryRepository) Update(c *model.Category) (error) {
-	dbCategory, err := dbModel.FindCategory(r.ctx, r.exec, c.Id.String())
-	if err == sql.ErrNoRows {
-		return errors.New("Category to update was not found")
+func (r *CategoryRepository) Update(ctx context.Context, c *model.Category) (error) {
+	exists, err := dbModel.Categories(dbModel.CategoryWhere.ID.EQ(c.Id.String()), dbModel.CategoryWhere.DeletedAt.IsNull()).Exists(ctx, r.exec)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return errs.NewNotFound(errs.CodeCategoryNotFound, "Category to update was not found")
+	}
+	// 読み込んだ時点のversionのままの場合だけ更新する（他の更新を上書きしない）
+	updatedAt := time.Now()
+	rowsAff, err := dbModel.Categories(
+		dbModel.CategoryWhere.ID.EQ(c.Id.String()),
+		dbModel.CategoryWhere.Version.EQ(c.Version),
+	).UpdateAll(ctx, r.exec, dbModel.M{
+		dbModel.CategoryColumns.Name: c.Name,
+		dbModel.CategoryColumns.Slug: c.Slug,
+		dbModel.CategoryColumns.Description: c.Description,
+		dbModel.CategoryColumns.DisplayOrder: null.IntFrom(c.DisplayOrder),
+		dbModel.CategoryColumns.ParentID: toDbParentId(c.ParentId),
+		dbModel.CategoryColumns.UpdatedAt: updatedAt,
+		dbModel.CategoryColumns.Version: c.Version + 1,
+	})
+	if err := r.toConflict(ctx, c, err); err != nil {
+		return err
 	}
 	if err != nil {
 		return err
 	}
-	dbCategory.Name = c.Name
-	dbCategory.DisplayOrder = null.IntFrom(c.DisplayOrder)
-	dbCategory.Update(r.ctx, r.exec, boil.Infer())
+	if rowsAff == 0 {
+		return errs.NewPreconditionFailed(errs.CodeCategoryVersionMismatch, "Category has been modified by another request")
+	}
+	c.UpdatedAt = updatedAt
+	c.Version++
 	return nil
 }
 
-func (r *CategoryRepository) Delete(id uuid.UUID) (error) {
-	dbCategory, err := dbModel.FindCategory(r.ctx, r.exec, id.String())
-	if err == sql.ErrNoRows {
-		return errors.New("Category to delete was not found")
+func (r *CategoryRepository) Delete(ctx context.Context, id uuid.UUID, version int) (error) {
+	exists, err := dbModel.Categories(dbModel.CategoryWhere.ID.EQ(id.String()), dbModel.CategoryWhere.DeletedAt.IsNull()).Exists(ctx, r.exec)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return errs.NewNotFound(errs.CodeCategoryNotFound, "Category to delete was not found")
 	}
+	rowsAff, err := dbModel.Categories(
+		dbModel.CategoryWhere.ID.EQ(id.String()),
+		dbModel.CategoryWhere.DeletedAt.IsNull(),
+		dbModel.CategoryWhere.Version.EQ(version),
+	).UpdateAll(ctx, r.exec, dbModel.M{
+		dbModel.CategoryColumns.DeletedAt: null.TimeFrom(time.Now()),
+		dbModel.CategoryColumns.Version: version + 1,
+	})
 	if err != nil {
 		return err
 	}
-	dbCategory.Delete(r.ctx, r.exec)
+	if rowsAff == 0 {
+		return errs.NewPreconditionFailed(errs.CodeCategoryVersionMismatch, "Category has been modified by another request")
+	}
 	return nil
 }
 
+func (r *CategoryRepository) FindDeleted(ctx context.Context) ([]*model.Category, error) {
+	dbCategories, err := dbModel.Categories(
+		dbModel.CategoryWhere.DeletedAt.IsNotNull(),
+		qm.OrderBy(fmt.Sprintf("%s DESC, %s DESC", dbModel.CategoryColumns.DeletedAt, dbModel.CategoryColumns.ID)),
+	).All(ctx, r.exec)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+	categories, err := toCategories(dbCategories)
+	if err != nil {
+		return nil, err
+	}
+	if categories == nil {
+		return []*model.Category{}, nil
+	}
+	return categories, nil
+}
+
+func (r *CategoryRepository) Restore(ctx context.Context, id uuid.UUID) (error) {
+	dbCategory, err := dbModel.Categories(dbModel.CategoryWhere.ID.EQ(id.String()), dbModel.CategoryWhere.DeletedAt.IsNotNull()).One(ctx, r.exec)
+	if err == sql.ErrNoRows {
+		return errs.NewNotFound(errs.CodeCategoryNotFound, "Category to restore was not found in the trash")
+	}
+	if err != nil {
+		return err
+	}
+	dbCategory.DeletedAt = null.TimeFromPtr(nil)
+	dbCategory.Version++
+	_, err = dbCategory.Update(ctx, r.exec, boil.Whitelist(dbModel.CategoryColumns.DeletedAt, dbModel.CategoryColumns.Version))
+	return err
+}
+
+func (r *CategoryRepository) Purge(ctx context.Context, before time.Time) (int64, error) {
+	dbCategories, err := dbModel.Categories(
+		qm.Select(dbModel.CategoryColumns.ID),
+		dbModel.CategoryWhere.DeletedAt.LT(null.TimeFrom(before)),
+	).All(ctx, r.exec)
+	if err != nil && err != sql.ErrNoRows {
+		return 0, err
+	}
+	if len(dbCategories) == 0 {
+		return 0, nil
+	}
+	var categoryIds []string
+	for _, v := range dbCategories {
+		categoryIds = append(categoryIds, v.ID)
+	}
+
+	dbArticles, err := dbModel.Articles(
+		qm.Select("DISTINCT " + dbModel.ArticleColumns.CategoryID),
+		dbModel.ArticleWhere.CategoryID.IN(categoryIds),
+	).All(ctx, r.exec)
+	if err != nil && err != sql.ErrNoRows {
+		return 0, err
+	}
+	inUse := make(map[string]bool)
+	for _, v := range dbArticles {
+		inUse[v.CategoryID] = true
+	}
+	var purgeIds []string
+	for _, v := range categoryIds {
+		if !inUse[v] {
+			purgeIds = append(purgeIds, v)
+		}
+	}
+	if len(purgeIds) == 0 {
+		return 0, nil
+	}
+	// 選んだ後に復元されたカテゴリは消さない
+	rowsAff, err := dbModel.Categories(
+		dbModel.CategoryWhere.ID.IN(purgeIds),
+		dbModel.CategoryWhere.DeletedAt.IsNotNull(),
+	).DeleteAll(ctx, r.exec)
+	if err != nil {
+		return 0, err
+	}
+	// 削除した親を指したままにしない（子は最上位になる）
+	_, err = dbModel.Categories(dbModel.CategoryWhere.ParentID.IN(purgeIds)).UpdateAll(ctx, r.exec, dbModel.M{dbModel.CategoryColumns.ParentID: null.StringFromPtr(nil)})
+	if err != nil {
+		return 0, err
+	}
+	return rowsAff, nil
+}
+
+// 名前・スラッグの一意制約の違反をConflictにする（違反でなければnil）
+// どちらの制約かはエラーメッセージでは判別できないことがあるので、スラッグを引き直して確かめる
+func (r *CategoryRepository) toConflict(ctx context.Context, c *model.Category, err error) (error) {
+	if !isDuplicateEntryError(err) {
+		return nil
+	}
+	id, findErr := r.FindIdBySlug(ctx, c.Slug)
+	if findErr != nil {
+		return findErr
+	}
+	if id != nil && *id != c.Id {
+		return errs.NewConflict(errs.CodeCategorySlugConflict, "Category slug is already in use")
+	}
+	return errs.NewConflict(errs.CodeCategoryNameConflict, "Category name is already registered")
+}
+
+type categoryStatsRow struct {
+	CategoryID string `boil:"category_id"`
+	Status string `boil:"status"`
+	Deleted bool `boil:"deleted"`
+	Count int `boil:"count"`
+	LatestPublishedAt null.Time `boil:"latest_published_at"`
+}
+
+// カテゴリごとに記事を状態別に数える（カテゴリの削除時の確認と、カテゴリの集計で使う）
+func findCategoryStats(ctx context.Context, exec boil.ContextExecutor, ids []uuid.UUID) (map[uuid.UUID]*model.CategoryStats, error) {
+	stats := make(map[uuid.UUID]*model.CategoryStats)
+	if len(ids) == 0 {
+		return stats, nil
+	}
+	args := make([]interface{}, 0, len(ids))
+	for _, v := range ids {
+		stats[v] = &model.CategoryStats{}
+		args = append(args, v.String())
+	}
+	query := fmt.Sprintf(
+		"SELECT %s AS category_id, %s AS status, %s IS NOT NULL AS deleted, COUNT(*) AS count, MAX(%s) AS latest_published_at FROM %s WHERE %s IN (%s) GROUP BY 1, 2, 3",
+		dbModel.ArticleColumns.CategoryID,
+		dbModel.ArticleColumns.Status,
+		dbModel.ArticleColumns.DeletedAt,
+		dbModel.ArticleColumns.PublishedAt,
+		dbModel.TableNames.Articles,
+		dbModel.ArticleColumns.CategoryID,
+		strings.TrimSuffix(strings.Repeat("?,", len(ids)), ","),
+	)
+	var rows []*categoryStatsRow
+	err := queries.Raw(query, args...).Bind(ctx, exec, &rows)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+	for _, v := range rows {
+		id, err := uuid.Parse(v.CategoryID)
+		if err != nil {
+			return nil, err
+		}
+		s, ok := stats[id]
+		if !ok {
+			continue
+		}
+		if v.Deleted {
+			s.ArticleCounts.Trashed += v.Count
+			continue
+		}
+		status, err := toStatus(v.Status)
+		if err != nil {
+			return nil, err
+		}
+		switch *status {
+		case model.Published:
+			s.ArticleCounts.Published += v.Count
+			s.LatestPublishedAt = v.LatestPublishedAt.Ptr()
+		case model.Scheduled:
+			s.ArticleCounts.Scheduled += v.Count
+		case model.Draft:
+			s.ArticleCounts.Draft += v.Count
+		}
+	}
+	return stats, nil
+}
+
 func toCategory(d *dbModel.Category) (*model.Category, error) {
 	id, err := uuid.Parse(d.ID)
 	if err != nil {
 		return nil, err
 	}
+	var parentId *uuid.UUID
+	if d.ParentID.Valid {
+		v, err := uuid.Parse(d.ParentID.String)
+		if err != nil {
+			return nil, err
+		}
+		parentId = &v
+	}
 	category := &model.Category{
 		Id: id,
 		Name: d.Name,
+		Slug: d.Slug,
+		Description: d.Description,
 		DisplayOrder: d.DisplayOrder.Int,
+		ParentId: parentId,
+		Version: d.Version,
+		CreatedAt: d.CreatedAt,
+		UpdatedAt: d.UpdatedAt,
+		DeletedAt: d.DeletedAt.Ptr(),
 	}
 	return category, nil
 }
@@ -131,7 +393,20 @@ func toDbCategory(e *model.Category) (*dbModel.Category) {
 	dbCategory := &dbModel.Category{
 		ID: e.Id.String(),
 		Name: e.Name,
+		Slug: e.Slug,
+		Description: e.Description,
 		DisplayOrder: null.IntFrom(e.DisplayOrder),
+		ParentID: toDbParentId(e.ParentId),
+		Version: e.Version,
+		CreatedAt: e.CreatedAt,
+		UpdatedAt: e.UpdatedAt,
 	}
 	return dbCategory
+}
+
+func toDbParentId(parentId *uuid.UUID) null.String {
+	if parentId == nil {
+		return null.StringFromPtr(nil)
+	}
+	return null.StringFrom(parentId.String())
 }
\ No newline at end of file
@@ -2,9 +2,13 @@ package database
 
 import (
 	"context"
+	"database/sql"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/momonoki1990/tech-blog-api/domain/errs"
 	"github.com/momonoki1990/tech-blog-api/domain/model"
 	"github.com/momonoki1990/tech-blog-api/domain/service"
 	dbModel "github.com/momonoki1990/tech-blog-api/infra/database/model"
@@ -22,6 +26,7 @@ func TestCategoryFindOneById(t *testing.T) {
 	dbCategory1 := &dbModel.Category{
 		ID: "11111111-1111-1111-1111-111111111111",
 		Name: "Category1",
+		Slug: "category1",
 		DisplayOrder: null.IntFrom(1),
 	}
 	err := dbCategory1.Insert(ctx, tx, boil.Infer())
@@ -31,6 +36,7 @@ func TestCategoryFindOneById(t *testing.T) {
 	dbCategory2 := &dbModel.Category{
 		ID: "11111111-1111-1111-1111-111111111112",
 		Name: "Category2",
+		Slug: "category2",
 		DisplayOrder: null.IntFrom(2),
 	}
 	err = dbCategory2.Insert(ctx, tx, boil.Infer())
@@ -48,12 +54,12 @@ func TestCategoryFindOneById(t *testing.T) {
 	}
 
 	// Execute
-	r := NewCategoryRepository(ctx, tx)
-	category1, err := r.FindOneById(categoryId1)
+	r := NewCategoryRepository(tx)
+	category1, err := r.FindOneById(ctx, categoryId1)
 	if err != nil {
 		panic(err)
 	}
-	category2, err := r.FindOneById(categoryId2)
+	category2, err := r.FindOneById(ctx, categoryId2)
 	if err != nil {
 		panic(err)
 	}
@@ -93,18 +99,55 @@ func TestCategoryFindOneByIdNotExisting(t *testing.T) {
 	}
 
 	// Execute
-	r := NewCategoryRepository(ctx, tx)
-	category, err := r.FindOneById(categoryId1)
+	r := NewCategoryRepository(tx)
+	category, err := r.FindOneById(ctx, categoryId1)
 	
 	// Check
 	if err != nil {
-		t.Errorf("err of r.FindOneById(categoryId): Expected %v, but got %v", nil, err)
+		t.Errorf("err of r.FindOneById(ctx, categoryId): Expected %v, but got %v", nil, err)
 	}
 	if category != nil {
 		t.Errorf("category: Expected %v, but got %v", nil, category)
 	}
 }
 
+func TestCategoryFindOneByIdForUpdate(t *testing.T) {
+	db := GetTestConnection()
+	ctx := context.TODO()
+	tx := GetTestTransaction(db, ctx)
+	defer tx.Rollback()
+
+	// Prepare data: ゴミ箱のカテゴリは見つからない
+	dbCategories := []*dbModel.Category{
+		{ID: "11111111-1111-1111-1111-111111111111", Name: "Category1", Slug: "category1", DisplayOrder: null.IntFrom(1)},
+		{ID: "11111111-1111-1111-1111-111111111112", Name: "Category2", Slug: "category2", DisplayOrder: null.IntFrom(2), DeletedAt: null.TimeFrom(time.Now())},
+	}
+	for _, v := range dbCategories {
+		if err := v.Insert(ctx, tx, boil.Infer()); err != nil {
+			panic(err)
+		}
+	}
+
+	// Execute
+	r := NewCategoryRepository(tx)
+	active, err := r.FindOneByIdForUpdate(ctx, uuid.MustParse("11111111-1111-1111-1111-111111111111"))
+	if err != nil {
+		t.Fatalf("err of r.FindOneByIdForUpdate: Expected %v, but got %v", nil, err)
+	}
+	trashed, err := r.FindOneByIdForUpdate(ctx, uuid.MustParse("11111111-1111-1111-1111-111111111112"))
+	if err != nil {
+		t.Fatalf("err of r.FindOneByIdForUpdate: Expected %v, but got %v", nil, err)
+	}
+
+	// Check
+	if active == nil || active.Name != "Category1" {
+		t.Errorf("active: Expected %s, but got %v", "Category1", active)
+	}
+	if trashed != nil {
+		t.Errorf("trashed: Expected %v, but got %v", nil, trashed)
+	}
+}
+
 func TestCategoryFind(t *testing.T) {
 	db := GetTestConnection()
 	ctx := context.TODO()
@@ -115,6 +158,7 @@ func TestCategoryFind(t *testing.T) {
 	dbCategory1 := &dbModel.Category{
 		ID: "11111111-1111-1111-1111-111111111111",
 		Name: "Category1",
+		Slug: "category1",
 		DisplayOrder: null.IntFrom(1),
 	}
 	err := dbCategory1.Insert(ctx, tx, boil.Infer())
@@ -124,6 +168,7 @@ func TestCategoryFind(t *testing.T) {
 	dbCategory2 := &dbModel.Category{
 		ID: "11111111-1111-1111-1111-111111111112",
 		Name: "Category2",
+		Slug: "category2",
 		DisplayOrder: null.IntFrom(2),
 	}
 	err = dbCategory2.Insert(ctx, tx, boil.Infer())
@@ -141,8 +186,8 @@ func TestCategoryFind(t *testing.T) {
 	}
 
 	// Execute
-	r := NewCategoryRepository(ctx, tx)
-	categories, err := r.Find()
+	r := NewCategoryRepository(tx)
+	categories, err := r.Find(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -179,6 +224,47 @@ func TestCategoryFind(t *testing.T) {
 	}
 }
 
+func TestCategoryFindOrder(t *testing.T) {
+	db := GetTestConnection()
+	ctx := context.TODO()
+	tx := GetTestTransaction(db, ctx)
+	defer tx.Rollback()
+
+	// Prepare data: 表示順が同じなら名前順
+	_, err := dbModel.Categories(dbModel.CategoryWhere.ID.NEQ("")).UpdateAll(ctx, tx, dbModel.M{dbModel.CategoryColumns.DisplayOrder: 999})
+	if err != nil {
+		panic(err)
+	}
+	for i, v := range []struct{ name string; displayOrder int }{{"CategoryB", 1}, {"CategoryC", 2}, {"CategoryA", 1}} {
+		dbCategory := &dbModel.Category{
+			ID: fmt.Sprintf("11111111-1111-1111-1111-11111111111%d", i + 1),
+			Name: v.name,
+			Slug: fmt.Sprintf("category-%d", i + 1),
+			DisplayOrder: null.IntFrom(v.displayOrder),
+		}
+		err := dbCategory.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	// Execute
+	r := NewCategoryRepository(tx)
+	categories, err := r.Find(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	// Check
+	var names []string
+	for _, v := range categories[:3] {
+		names = append(names, v.Name)
+	}
+	if names[0] != "CategoryA" || names[1] != "CategoryB" || names[2] != "CategoryC" {
+		t.Errorf("names: Expected %v, but got %v", []string{"CategoryA", "CategoryB", "CategoryC"}, names)
+	}
+}
+
 func TestCategoryFindWithNoData(t *testing.T) {
 	db := GetTestConnection()
 	ctx := context.TODO()
@@ -201,8 +287,8 @@ func TestCategoryFindWithNoData(t *testing.T) {
 	}
 
 	// Execute
-	r := NewCategoryRepository(ctx, tx)
-	actuals, err := r.Find()
+	r := NewCategoryRepository(tx)
+	actuals, err := r.Find(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -216,6 +302,48 @@ func TestCategoryFindWithNoData(t *testing.T) {
 	}
 }
 
+func TestCategoryFindForUpdate(t *testing.T) {
+	db := GetTestConnection()
+	ctx := context.TODO()
+	tx := GetTestTransaction(db, ctx)
+	defer tx.Rollback()
+
+	// Prepare data: ゴミ箱のカテゴリは含めない
+	dbCategories := []*dbModel.Category{
+		{ID: "11111111-1111-1111-1111-111111111111", Name: "Category1", Slug: "category1", DisplayOrder: null.IntFrom(1)},
+		{ID: "11111111-1111-1111-1111-111111111112", Name: "Category2", Slug: "category2", DisplayOrder: null.IntFrom(2), DeletedAt: null.TimeFrom(time.Now())},
+	}
+	for _, v := range dbCategories {
+		if err := v.Insert(ctx, tx, boil.Infer()); err != nil {
+			panic(err)
+		}
+	}
+
+	// Execute
+	r := NewCategoryRepository(tx)
+	found, err := r.Find(ctx)
+	if err != nil {
+		panic(err)
+	}
+	locked, err := r.FindForUpdate(ctx)
+	if err != nil {
+		t.Fatalf("err of r.FindForUpdate: Expected %v, but got %v", nil, err)
+	}
+
+	// Check
+	if len(locked) != len(found) {
+		t.Fatalf("len(locked): Expected %d, but got %d", len(found), len(locked))
+	}
+	for i, v := range locked {
+		if v.Id != found[i].Id {
+			t.Errorf("locked[%d].Id: Expected %s, but got %s", i, found[i].Id, v.Id)
+		}
+		if v.Id.String() == "11111111-1111-1111-1111-111111111112" {
+			t.Errorf("locked[%d]: Expected not to include the trashed category, but got %v", i, v)
+		}
+	}
+}
+
 func TestCategoryInsert(t *testing.T) {
 	db := GetTestConnection()
 	ctx := context.TODO()
@@ -223,15 +351,15 @@ func TestCategoryInsert(t *testing.T) {
 	defer tx.Rollback()
 
 	// Prepare data
-	r := NewCategoryRepository(ctx, tx)
+	r := NewCategoryRepository(tx)
 	creator := service.NewCategoryCreator(r)
-	category, err := creator.Create("Name1", 1)
+	category, err := creator.Create(ctx, "Name1", 1, nil)
 	if err != nil {
 		panic(err)
 	}
 
 	// Execute
-	err = r.Insert(category)
+	err = r.Insert(ctx, category)
 	if err != nil {
 		panic(err)
 	}
@@ -256,23 +384,168 @@ func TestCategoryInsertAlreadyExisting(t *testing.T) {
 	defer tx.Rollback()
 
 	// Prepare data
-	r := NewCategoryRepository(ctx, tx)
+	r := NewCategoryRepository(tx)
 	creator := service.NewCategoryCreator(r)
-	category, err := creator.Create("Name1", 1)
+	category, err := creator.Create(ctx, "Name1", 1, nil)
 	if err != nil {
 		panic(err)
 	}
-	err = r.Insert(category)
+	err = r.Insert(ctx, category)
 	if err != nil {
 		panic(err)
 	}
 
 	// Execute
-	err = r.Insert(category)
+	err = r.Insert(ctx, category)
 
 	// Check
 	if err == nil {
-		t.Errorf("err of r.Insert(category): Expected %v, but got %v", "not nil", err)
+		t.Errorf("err of r.Insert(ctx, category): Expected %v, but got %v", "not nil", err)
+	}
+}
+
+func TestCategoryInsertSlugConflict(t *testing.T) {
+	db := GetTestConnection()
+	ctx := context.TODO()
+	tx := GetTestTransaction(db, ctx)
+	defer tx.Rollback()
+
+	// Prepare data: ゴミ箱のカテゴリのスラッグも使えない
+	dbCategory1 := &dbModel.Category{
+		ID: "11111111-1111-1111-1111-111111111111",
+		Name: "Category1",
+		Slug: "category1",
+		DisplayOrder: null.IntFrom(1),
+		DeletedAt: null.TimeFrom(time.Now()),
+	}
+	err := dbCategory1.Insert(ctx, tx, boil.Infer())
+	if err != nil {
+		panic(err)
+	}
+	category, err := model.NewCategory("Name1", 1)
+	if err != nil {
+		panic(err)
+	}
+	category.Slug = "category1"
+
+	// Execute
+	r := NewCategoryRepository(tx)
+	id, err := r.FindIdBySlug(ctx, "category1")
+	if err != nil {
+		panic(err)
+	}
+	err = r.Insert(ctx, category)
+
+	// Check
+	if id == nil || id.String() != "11111111-1111-1111-1111-111111111111" {
+		t.Errorf("id of r.FindIdBySlug(ctx, 'category1'): Expected %s, but got %v", "11111111-1111-1111-1111-111111111111", id)
+	}
+	e, ok := errs.As(err)
+	if !ok || e.Code != errs.CodeCategorySlugConflict {
+		t.Errorf("err of r.Insert(ctx, category): Expected %s, but got %v", errs.CodeCategorySlugConflict, err)
+	}
+}
+
+func TestCategoryFindIdByName(t *testing.T) {
+	db := GetTestConnection()
+	ctx := context.TODO()
+	tx := GetTestTransaction(db, ctx)
+	defer tx.Rollback()
+
+	// Prepare data: ゴミ箱のカテゴリも見つかる
+	dbCategory1 := &dbModel.Category{
+		ID: "11111111-1111-1111-1111-111111111111",
+		Name: "Category1",
+		Slug: "category1",
+		DisplayOrder: null.IntFrom(1),
+		DeletedAt: null.TimeFrom(time.Now()),
+	}
+	err := dbCategory1.Insert(ctx, tx, boil.Infer())
+	if err != nil {
+		panic(err)
+	}
+
+	// Execute
+	r := NewCategoryRepository(tx)
+	id, err := r.FindIdByName(ctx, "Category1")
+	if err != nil {
+		panic(err)
+	}
+	notExisting, err := r.FindIdByName(ctx, "Category2")
+	if err != nil {
+		panic(err)
+	}
+
+	// Check
+	if id == nil || id.String() != "11111111-1111-1111-1111-111111111111" {
+		t.Errorf("id of r.FindIdByName(ctx, 'Category1'): Expected %s, but got %v", "11111111-1111-1111-1111-111111111111", id)
+	}
+	if notExisting != nil {
+		t.Errorf("notExisting: Expected %v, but got %v", nil, notExisting)
+	}
+}
+
+func TestCategoryFindStats(t *testing.T) {
+	db := GetTestConnection()
+	ctx := context.TODO()
+	tx := GetTestTransaction(db, ctx)
+	defer tx.Rollback()
+
+	// Prepare data
+	now := time.Now().Truncate(time.Second)
+	for i := 1; i <= 2; i++ {
+		dbCategory := &dbModel.Category{
+			ID: fmt.Sprintf("21111111-1111-1111-1111-11111111111%d", i),
+			Name: fmt.Sprintf("Category%d", i),
+			Slug: fmt.Sprintf("category%d", i),
+			DisplayOrder: null.IntFrom(99),
+		}
+		err := dbCategory.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			panic(err)
+		}
+	}
+	// ゴミ箱の記事は最新の公開日時に含めない
+	dbArticles := []*dbModel.Article{
+		{Status: "Published", PublishedAt: null.TimeFrom(now.AddDate(0, 0, -2))},
+		{Status: "Published", PublishedAt: null.TimeFrom(now.AddDate(0, 0, -1))},
+		{Status: "Scheduled", PublishedAt: null.TimeFrom(now.AddDate(0, 0, 1))},
+		{Status: "Draft"},
+		{Status: "Published", PublishedAt: null.TimeFrom(now), DeletedAt: null.TimeFrom(now)},
+	}
+	for i, v := range dbArticles {
+		v.ID = fmt.Sprintf("11111111-1111-1111-1111-11111111111%d", i + 1)
+		v.Slug = fmt.Sprintf("title%d", i + 1)
+		v.Title = fmt.Sprintf("Title%d", i + 1)
+		v.Content = fmt.Sprintf("Content%d", i + 1)
+		v.CategoryID = "21111111-1111-1111-1111-111111111111"
+		err := v.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			panic(err)
+		}
+	}
+	categoryId1 := uuid.MustParse("21111111-1111-1111-1111-111111111111")
+	categoryId2 := uuid.MustParse("21111111-1111-1111-1111-111111111112")
+
+	// Execute
+	r := NewCategoryRepository(tx)
+	stats, err := r.FindStats(ctx, []uuid.UUID{categoryId1, categoryId2})
+	if err != nil {
+		panic(err)
+	}
+
+	// Check
+	expected := model.CategoryArticleCounts{Published: 2, Scheduled: 1, Draft: 1, Trashed: 1}
+	if stats[categoryId1] == nil || stats[categoryId1].ArticleCounts != expected {
+		t.Fatalf("stats[categoryId1]: Expected %+v, but got %+v", expected, stats[categoryId1])
+	}
+	latest := stats[categoryId1].LatestPublishedAt
+	if latest == nil || !latest.Equal(now.AddDate(0, 0, -1)) {
+		t.Errorf("stats[categoryId1].LatestPublishedAt: Expected %v, but got %v", now.AddDate(0, 0, -1), latest)
+	}
+	// 記事のないカテゴリも0件として返す
+	if stats[categoryId2] == nil || stats[categoryId2].ArticleCounts != (model.CategoryArticleCounts{}) || stats[categoryId2].LatestPublishedAt != nil {
+		t.Errorf("stats[categoryId2]: Expected %s, but got %+v", "no articles", stats[categoryId2])
 	}
 }
 
@@ -283,13 +556,13 @@ func TestCategoryUpdate(t *testing.T) {
 	defer tx.Rollback()
 
 	// Prepare data
-	r := NewCategoryRepository(ctx, tx)
+	r := NewCategoryRepository(tx)
 	creator := service.NewCategoryCreator(r)
-	category, err := creator.Create("Name1", 1)
+	category, err := creator.Create(ctx, "Name1", 1, nil)
 	if err != nil {
 		panic(err)
 	}
-	err = r.Insert(category)
+	err = r.Insert(ctx, category)
 	if err != nil {
 		panic(err)
 	}
@@ -297,13 +570,13 @@ func TestCategoryUpdate(t *testing.T) {
 	// Execute
 	category.Name = "Name1Changed"
 	category.DisplayOrder = 11
-	err = r.Update(category)
+	err = r.Update(ctx, category)
 	if err != nil {
 		panic(err)
 	}
 
 	// Check
-	categoryCheck, err := r.FindOneById(category.Id)
+	categoryCheck, err := r.FindOneById(ctx, category.Id)
 	if err != nil {
 		panic(err)
 	}
@@ -316,6 +589,104 @@ func TestCategoryUpdate(t *testing.T) {
 	if categoryCheck.DisplayOrder != 11 {
 		t.Errorf("categoryCheck.DisplayOrder: Expected %v, but got %v", 11, categoryCheck.DisplayOrder)
 	}
+	if categoryCheck.ParentId != nil {
+		t.Errorf("categoryCheck.ParentId: Expected %v, but got %v", nil, categoryCheck.ParentId)
+	}
+}
+
+func TestCategoryUpdateParent(t *testing.T) {
+	db := GetTestConnection()
+	ctx := context.TODO()
+	tx := GetTestTransaction(db, ctx)
+	defer tx.Rollback()
+
+	// Prepare data
+	r := NewCategoryRepository(tx)
+	creator := service.NewCategoryCreator(r)
+	parent, err := creator.Create(ctx, "Parent1", 1, nil)
+	if err != nil {
+		panic(err)
+	}
+	err = r.Insert(ctx, parent)
+	if err != nil {
+		panic(err)
+	}
+	child, err := creator.Create(ctx, "Child1", 1, &parent.Id)
+	if err != nil {
+		panic(err)
+	}
+	err = r.Insert(ctx, child)
+	if err != nil {
+		panic(err)
+	}
+
+	// Check1 (insert)
+	childCheck, err := r.FindOneById(ctx, child.Id)
+	if err != nil {
+		panic(err)
+	}
+	if childCheck.ParentId == nil || *childCheck.ParentId != parent.Id {
+		t.Errorf("childCheck.ParentId: Expected %v, but got %v", parent.Id, childCheck.ParentId)
+	}
+
+	// Execute2 (update to top level)
+	child.ParentId = nil
+	err = r.Update(ctx, child)
+	if err != nil {
+		panic(err)
+	}
+
+	// Check2
+	childCheck, err = r.FindOneById(ctx, child.Id)
+	if err != nil {
+		panic(err)
+	}
+	if childCheck.ParentId != nil {
+		t.Errorf("childCheck.ParentId: Expected %v, but got %v", nil, childCheck.ParentId)
+	}
+}
+
+func TestCategoryUpdateVersionMismatch(t *testing.T) {
+	db := GetTestConnection()
+	ctx := context.TODO()
+	tx := GetTestTransaction(db, ctx)
+	defer tx.Rollback()
+
+	// Prepare data
+	r := NewCategoryRepository(tx)
+	category, err := model.NewCategory("Name1", 1)
+	if err != nil {
+		panic(err)
+	}
+	err = r.Insert(ctx, category)
+	if err != nil {
+		panic(err)
+	}
+	stale, err := r.FindOneById(ctx, category.Id)
+	if err != nil {
+		panic(err)
+	}
+	category.Name = "Name1Changed"
+	err = r.Update(ctx, category)
+	if err != nil {
+		panic(err)
+	}
+
+	// Execute
+	stale.DisplayOrder = 2
+	err = r.Update(ctx, stale)
+
+	// Check
+	if !errs.IsKind(err, errs.PreconditionFailed) {
+		t.Errorf("err of r.Update: Expected %s, but got %v", errs.PreconditionFailed, err)
+	}
+	found, err := r.FindOneById(ctx, category.Id)
+	if err != nil {
+		panic(err)
+	}
+	if found.Name != "Name1Changed" || found.DisplayOrder != 1 || found.Version != 2 {
+		t.Errorf("found: Expected %s %d (version %d), but got %v", "Name1Changed", 1, 2, found)
+	}
 }
 
 func TestCategoryUpdateNotExisting(t *testing.T) {
@@ -325,9 +696,9 @@ func TestCategoryUpdateNotExisting(t *testing.T) {
 	defer tx.Rollback()
 
 	// Prepare data
-	r := NewCategoryRepository(ctx, tx)
+	r := NewCategoryRepository(tx)
 	creator := service.NewCategoryCreator(r)
-	category, err := creator.Create("Name1", 1)
+	category, err := creator.Create(ctx, "Name1", 1, nil)
 	if err != nil {
 		panic(err)
 	}
@@ -335,14 +706,14 @@ func TestCategoryUpdateNotExisting(t *testing.T) {
 	// Execute
 	category.Name = "Name1Changed"
 	category.DisplayOrder = 11
-	err = r.Update(category)
+	err = r.Update(ctx, category)
 
 	// Check
 	if err == nil {
-		t.Errorf("err of r.Update(category): Expected %v, but got %v", "not nil", err)
+		t.Errorf("err of r.Update(ctx, category): Expected %v, but got %v", "not nil", err)
 	}
 	if err.Error() != "Category to update was not found" {
-		t.Errorf("err of r.Update(article1): Expected %v, but got %v", "Category to update was not found", err)
+		t.Errorf("err of r.Update(ctx, article1): Expected %v, but got %v", "Category to update was not found", err)
 	}
 }
 
@@ -353,25 +724,25 @@ func TestCategoryDelete(t *testing.T) {
 	defer tx.Rollback()
 
 	// Prepare data
-	r := NewCategoryRepository(ctx, tx)
+	r := NewCategoryRepository(tx)
 	creator := service.NewCategoryCreator(r)
-	category, err := creator.Create("Name1", 1)
+	category, err := creator.Create(ctx, "Name1", 1, nil)
 	if err != nil {
 		panic(err)
 	}
-	err = r.Insert(category)
+	err = r.Insert(ctx, category)
 	if err != nil {
 		panic(err)
 	}
 
 	// Execute
-	err = r.Delete(category.Id)
+	err = r.Delete(ctx, category.Id, 1)
 	if err != nil {
 		panic(err)
 	}
 
 	// Check
-	categoryCheck, err := r.FindOneById(category.Id)
+	categoryCheck, err := r.FindOneById(ctx, category.Id)
 	if err != nil {
 		panic(err)
 	}
@@ -380,6 +751,111 @@ func TestCategoryDelete(t *testing.T) {
 	}
 }
 
+func TestCategoryRestore(t *testing.T) {
+	db := GetTestConnection()
+	ctx := context.TODO()
+	tx := GetTestTransaction(db, ctx)
+	defer tx.Rollback()
+
+	// Prepare data
+	r := NewCategoryRepository(tx)
+	category, err := model.NewCategory("Name1", 1)
+	if err != nil {
+		panic(err)
+	}
+	err = r.Insert(ctx, category)
+	if err != nil {
+		panic(err)
+	}
+	err = r.Delete(ctx, category.Id, 1)
+	if err != nil {
+		panic(err)
+	}
+
+	// Execute1
+	deleted, err := r.FindDeleted(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	// Check1
+	if len(deleted) != 1 || deleted[0].Id != category.Id || deleted[0].DeletedAt == nil {
+		t.Errorf("deleted: Expected %v, but got %v", category.Id, deleted)
+	}
+
+	// Execute2
+	err = r.Restore(ctx, category.Id)
+	if err != nil {
+		t.Fatalf("err of r.Restore: Expected %v, but got %v", nil, err)
+	}
+
+	// Check2
+	categoryCheck, err := r.FindOneById(ctx, category.Id)
+	if err != nil {
+		panic(err)
+	}
+	if categoryCheck == nil {
+		t.Errorf("categoryCheck: Expected %v, but got %v", "not nil", categoryCheck)
+	}
+}
+
+func TestCategoryPurge(t *testing.T) {
+	db := GetTestConnection()
+	ctx := context.TODO()
+	tx := GetTestTransaction(db, ctx)
+	defer tx.Rollback()
+
+	// Prepare data: 1は記事が残っているので消さない
+	now := time.Now().Truncate(time.Second)
+	for i := 1; i <= 2; i++ {
+		dbCategory := &dbModel.Category{
+			ID: fmt.Sprintf("21111111-1111-1111-1111-11111111111%d", i),
+			Name: fmt.Sprintf("Category%d", i),
+			Slug: fmt.Sprintf("category%d", i),
+			DisplayOrder: null.IntFrom(99),
+			DeletedAt: null.TimeFrom(now.Add(-48 * time.Hour)),
+		}
+		err := dbCategory.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			panic(err)
+		}
+	}
+	dbArticle1 := &dbModel.Article{
+		ID: "11111111-1111-1111-1111-111111111111",
+		Slug: "title1",
+		Title: "Title1",
+		Content: "Content1",
+		CategoryID: "21111111-1111-1111-1111-111111111111",
+		Status: "Draft",
+		CreatedAt: now,
+		UpdatedAt: now,
+		DeletedAt: null.TimeFrom(now),
+	}
+	err := dbArticle1.Insert(ctx, tx, boil.Infer())
+	if err != nil {
+		panic(err)
+	}
+
+	// Execute
+	r := NewCategoryRepository(tx)
+	n, err := r.Purge(ctx, now.Add(-24 * time.Hour))
+
+	// Check
+	if err != nil {
+		t.Fatalf("err of r.Purge: Expected %v, but got %v", nil, err)
+	}
+	if n != 1 {
+		t.Errorf("n: Expected %d, but got %d", 1, n)
+	}
+	dbCategory1, err := dbModel.FindCategory(ctx, tx, "21111111-1111-1111-1111-111111111111")
+	if err != nil && err != sql.ErrNoRows {
+		panic(err)
+	}
+	if dbCategory1 == nil {
+		t.Errorf("dbCategory1: Expected %v, but got %v", "not nil", dbCategory1)
+	}
+}
+
 func TestCategoryDeleteNotExisting(t *testing.T) {
 	db := GetTestConnection()
 	ctx := context.TODO()
@@ -387,21 +863,21 @@ func TestCategoryDeleteNotExisting(t *testing.T) {
 	defer tx.Rollback()
 
 	// Prepare data
-	r := NewCategoryRepository(ctx, tx)
+	r := NewCategoryRepository(tx)
 	creator := service.NewCategoryCreator(r)
-	category, err := creator.Create("Name1", 1)
+	category, err := creator.Create(ctx, "Name1", 1, nil)
 	if err != nil {
 		panic(err)
 	}
 
 	// Execute
-	err = r.Delete(category.Id)
+	err = r.Delete(ctx, category.Id, 1)
 
 	// Check
 	if err == nil {
-		t.Errorf("err of r.Delete(category): Expected %v, but got %v", "not nil", err)
+		t.Errorf("err of r.Delete(ctx, category): Expected %v, but got %v", "not nil", err)
 	}
 	if err.Error() != "Category to delete was not found" {
-		t.Errorf("err of r.Update(article1): Expected %v, but got %v", "Category to delete was not found", err)
+		t.Errorf("err of r.Update(ctx, article1): Expected %v, but got %v", "Category to delete was not found", err)
 	}
 }
\ No newline at end of file
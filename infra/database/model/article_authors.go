@@ -0,0 +1,1339 @@
+// Code generated by SQLBoiler 4.15.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ArticleAuthor is an object representing the database table.
+type ArticleAuthor struct {
+	ArticleID string `boil:"article_id" json:"article_id" toml:"article_id" yaml:"article_id"`
+	AuthorID  string `boil:"author_id" json:"author_id" toml:"author_id" yaml:"author_id"`
+	Position  int    `boil:"position" json:"position" toml:"position" yaml:"position"`
+
+	R *articleAuthorR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L articleAuthorL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ArticleAuthorColumns = struct {
+	ArticleID string
+	AuthorID  string
+	Position  string
+}{
+	ArticleID: "article_id",
+	AuthorID:  "author_id",
+	Position:  "position",
+}
+
+var ArticleAuthorTableColumns = struct {
+	ArticleID string
+	AuthorID  string
+	Position  string
+}{
+	ArticleID: "article_authors.article_id",
+	AuthorID:  "article_authors.author_id",
+	Position:  "article_authors.position",
+}
+
+// Generated where
+
+type whereHelperint struct{ field string }
+
+func (w whereHelperint) EQ(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperint) NEQ(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperint) LT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperint) LTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperint) GT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperint) GTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperint) IN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperint) NIN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+var ArticleAuthorWhere = struct {
+	ArticleID whereHelperstring
+	AuthorID  whereHelperstring
+	Position  whereHelperint
+}{
+	ArticleID: whereHelperstring{field: "`article_authors`.`article_id`"},
+	AuthorID:  whereHelperstring{field: "`article_authors`.`author_id`"},
+	Position:  whereHelperint{field: "`article_authors`.`position`"},
+}
+
+// ArticleAuthorRels is where relationship names are stored.
+var ArticleAuthorRels = struct {
+	Article string
+	Author  string
+}{
+	Article: "Article",
+	Author:  "Author",
+}
+
+// articleAuthorR is where relationships are stored.
+type articleAuthorR struct {
+	Article *Article `boil:"Article" json:"Article" toml:"Article" yaml:"Article"`
+	Author  *Author  `boil:"Author" json:"Author" toml:"Author" yaml:"Author"`
+}
+
+// NewStruct creates a new relationship struct
+func (*articleAuthorR) NewStruct() *articleAuthorR {
+	return &articleAuthorR{}
+}
+
+func (r *articleAuthorR) GetArticle() *Article {
+	if r == nil {
+		return nil
+	}
+	return r.Article
+}
+
+func (r *articleAuthorR) GetAuthor() *Author {
+	if r == nil {
+		return nil
+	}
+	return r.Author
+}
+
+// articleAuthorL is where Load methods for each relationship are stored.
+type articleAuthorL struct{}
+
+var (
+	articleAuthorAllColumns            = []string{"article_id", "author_id", "position"}
+	articleAuthorColumnsWithoutDefault = []string{"article_id", "author_id", "position"}
+	articleAuthorColumnsWithDefault    = []string{}
+	articleAuthorPrimaryKeyColumns     = []string{"article_id", "author_id"}
+	articleAuthorGeneratedColumns      = []string{}
+)
+
+type (
+	// ArticleAuthorSlice is an alias for a slice of pointers to ArticleAuthor.
+	// This should almost always be used instead of []ArticleAuthor.
+	ArticleAuthorSlice []*ArticleAuthor
+	// ArticleAuthorHook is the signature for custom ArticleAuthor hook methods
+	ArticleAuthorHook func(context.Context, boil.ContextExecutor, *ArticleAuthor) error
+
+	articleAuthorQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	articleAuthorType                 = reflect.TypeOf(&ArticleAuthor{})
+	articleAuthorMapping              = queries.MakeStructMapping(articleAuthorType)
+	articleAuthorPrimaryKeyMapping, _ = queries.BindMapping(articleAuthorType, articleAuthorMapping, articleAuthorPrimaryKeyColumns)
+	articleAuthorInsertCacheMut       sync.RWMutex
+	articleAuthorInsertCache          = make(map[string]insertCache)
+	articleAuthorUpdateCacheMut       sync.RWMutex
+	articleAuthorUpdateCache          = make(map[string]updateCache)
+	articleAuthorUpsertCacheMut       sync.RWMutex
+	articleAuthorUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var articleAuthorAfterSelectHooks []ArticleAuthorHook
+
+var articleAuthorBeforeInsertHooks []ArticleAuthorHook
+var articleAuthorAfterInsertHooks []ArticleAuthorHook
+
+var articleAuthorBeforeUpdateHooks []ArticleAuthorHook
+var articleAuthorAfterUpdateHooks []ArticleAuthorHook
+
+var articleAuthorBeforeDeleteHooks []ArticleAuthorHook
+var articleAuthorAfterDeleteHooks []ArticleAuthorHook
+
+var articleAuthorBeforeUpsertHooks []ArticleAuthorHook
+var articleAuthorAfterUpsertHooks []ArticleAuthorHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ArticleAuthor) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range articleAuthorAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ArticleAuthor) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range articleAuthorBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ArticleAuthor) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range articleAuthorAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ArticleAuthor) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range articleAuthorBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ArticleAuthor) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range articleAuthorAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ArticleAuthor) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range articleAuthorBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ArticleAuthor) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range articleAuthorAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ArticleAuthor) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range articleAuthorBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ArticleAuthor) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range articleAuthorAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddArticleAuthorHook registers your hook function for all future operations.
+func AddArticleAuthorHook(hookPoint boil.HookPoint, articleAuthorHook ArticleAuthorHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		articleAuthorAfterSelectHooks = append(articleAuthorAfterSelectHooks, articleAuthorHook)
+	case boil.BeforeInsertHook:
+		articleAuthorBeforeInsertHooks = append(articleAuthorBeforeInsertHooks, articleAuthorHook)
+	case boil.AfterInsertHook:
+		articleAuthorAfterInsertHooks = append(articleAuthorAfterInsertHooks, articleAuthorHook)
+	case boil.BeforeUpdateHook:
+		articleAuthorBeforeUpdateHooks = append(articleAuthorBeforeUpdateHooks, articleAuthorHook)
+	case boil.AfterUpdateHook:
+		articleAuthorAfterUpdateHooks = append(articleAuthorAfterUpdateHooks, articleAuthorHook)
+	case boil.BeforeDeleteHook:
+		articleAuthorBeforeDeleteHooks = append(articleAuthorBeforeDeleteHooks, articleAuthorHook)
+	case boil.AfterDeleteHook:
+		articleAuthorAfterDeleteHooks = append(articleAuthorAfterDeleteHooks, articleAuthorHook)
+	case boil.BeforeUpsertHook:
+		articleAuthorBeforeUpsertHooks = append(articleAuthorBeforeUpsertHooks, articleAuthorHook)
+	case boil.AfterUpsertHook:
+		articleAuthorAfterUpsertHooks = append(articleAuthorAfterUpsertHooks, articleAuthorHook)
+	}
+}
+
+// One returns a single articleAuthor record from the query.
+func (q articleAuthorQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ArticleAuthor, error) {
+	o := &ArticleAuthor{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "model: failed to execute a one query for article_authors")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all ArticleAuthor records from the query.
+func (q articleAuthorQuery) All(ctx context.Context, exec boil.ContextExecutor) (ArticleAuthorSlice, error) {
+	var o []*ArticleAuthor
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "model: failed to assign all query results to ArticleAuthor slice")
+	}
+
+	if len(articleAuthorAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ArticleAuthor records in the query.
+func (q articleAuthorQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "model: failed to count article_authors rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q articleAuthorQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "model: failed to check if article_authors exists")
+	}
+
+	return count > 0, nil
+}
+
+// Article pointed to by the foreign key.
+func (o *ArticleAuthor) Article(mods ...qm.QueryMod) articleQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.ArticleID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Articles(queryMods...)
+}
+
+// Author pointed to by the foreign key.
+func (o *ArticleAuthor) Author(mods ...qm.QueryMod) authorQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.AuthorID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Authors(queryMods...)
+}
+
+// LoadArticle allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (articleAuthorL) LoadArticle(ctx context.Context, e boil.ContextExecutor, singular bool, maybeArticleAuthor interface{}, mods queries.Applicator) error {
+	var slice []*ArticleAuthor
+	var object *ArticleAuthor
+
+	if singular {
+		var ok bool
+		object, ok = maybeArticleAuthor.(*ArticleAuthor)
+		if !ok {
+			object = new(ArticleAuthor)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeArticleAuthor)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeArticleAuthor))
+			}
+		}
+	} else {
+		s, ok := maybeArticleAuthor.(*[]*ArticleAuthor)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeArticleAuthor)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeArticleAuthor))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &articleAuthorR{}
+		}
+		args = append(args, object.ArticleID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &articleAuthorR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ArticleID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ArticleID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`articles`),
+		qm.WhereIn(`articles.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Article")
+	}
+
+	var resultSlice []*Article
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Article")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for articles")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for articles")
+	}
+
+	if len(articleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Article = foreign
+		if foreign.R == nil {
+			foreign.R = &articleR{}
+		}
+		foreign.R.ArticleAuthors = append(foreign.R.ArticleAuthors, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ArticleID == foreign.ID {
+				local.R.Article = foreign
+				if foreign.R == nil {
+					foreign.R = &articleR{}
+				}
+				foreign.R.ArticleAuthors = append(foreign.R.ArticleAuthors, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAuthor allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (articleAuthorL) LoadAuthor(ctx context.Context, e boil.ContextExecutor, singular bool, maybeArticleAuthor interface{}, mods queries.Applicator) error {
+	var slice []*ArticleAuthor
+	var object *ArticleAuthor
+
+	if singular {
+		var ok bool
+		object, ok = maybeArticleAuthor.(*ArticleAuthor)
+		if !ok {
+			object = new(ArticleAuthor)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeArticleAuthor)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeArticleAuthor))
+			}
+		}
+	} else {
+		s, ok := maybeArticleAuthor.(*[]*ArticleAuthor)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeArticleAuthor)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeArticleAuthor))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &articleAuthorR{}
+		}
+		args = append(args, object.AuthorID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &articleAuthorR{}
+			}
+
+			for _, a := range args {
+				if a == obj.AuthorID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.AuthorID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`authors`),
+		qm.WhereIn(`authors.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Author")
+	}
+
+	var resultSlice []*Author
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Author")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for authors")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for authors")
+	}
+
+	if len(authorAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Author = foreign
+		if foreign.R == nil {
+			foreign.R = &authorR{}
+		}
+		foreign.R.ArticleAuthors = append(foreign.R.ArticleAuthors, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.AuthorID == foreign.ID {
+				local.R.Author = foreign
+				if foreign.R == nil {
+					foreign.R = &authorR{}
+				}
+				foreign.R.ArticleAuthors = append(foreign.R.ArticleAuthors, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetArticle of the articleAuthor to the related item.
+// Sets o.R.Article to related.
+// Adds o to related.R.ArticleAuthors.
+func (o *ArticleAuthor) SetArticle(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Article) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `article_authors` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"article_id"}),
+		strmangle.WhereClause("`", "`", 0, articleAuthorPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ArticleID, o.AuthorID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ArticleID = related.ID
+	if o.R == nil {
+		o.R = &articleAuthorR{
+			Article: related,
+		}
+	} else {
+		o.R.Article = related
+	}
+
+	if related.R == nil {
+		related.R = &articleR{
+			ArticleAuthors: ArticleAuthorSlice{o},
+		}
+	} else {
+		related.R.ArticleAuthors = append(related.R.ArticleAuthors, o)
+	}
+
+	return nil
+}
+
+// SetAuthor of the articleAuthor to the related item.
+// Sets o.R.Author to related.
+// Adds o to related.R.ArticleAuthors.
+func (o *ArticleAuthor) SetAuthor(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Author) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `article_authors` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"author_id"}),
+		strmangle.WhereClause("`", "`", 0, articleAuthorPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ArticleID, o.AuthorID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.AuthorID = related.ID
+	if o.R == nil {
+		o.R = &articleAuthorR{
+			Author: related,
+		}
+	} else {
+		o.R.Author = related
+	}
+
+	if related.R == nil {
+		related.R = &authorR{
+			ArticleAuthors: ArticleAuthorSlice{o},
+		}
+	} else {
+		related.R.ArticleAuthors = append(related.R.ArticleAuthors, o)
+	}
+
+	return nil
+}
+
+// ArticleAuthors retrieves all the records using an executor.
+func ArticleAuthors(mods ...qm.QueryMod) articleAuthorQuery {
+	mods = append(mods, qm.From("`article_authors`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`article_authors`.*"})
+	}
+
+	return articleAuthorQuery{q}
+}
+
+// FindArticleAuthor retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindArticleAuthor(ctx context.Context, exec boil.ContextExecutor, articleID string, authorID string, selectCols ...string) (*ArticleAuthor, error) {
+	articleAuthorObj := &ArticleAuthor{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `article_authors` where `article_id`=? AND `author_id`=?", sel,
+	)
+
+	q := queries.Raw(query, articleID, authorID)
+
+	err := q.Bind(ctx, exec, articleAuthorObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "model: unable to select from article_authors")
+	}
+
+	if err = articleAuthorObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return articleAuthorObj, err
+	}
+
+	return articleAuthorObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ArticleAuthor) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("model: no article_authors provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(articleAuthorColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	articleAuthorInsertCacheMut.RLock()
+	cache, cached := articleAuthorInsertCache[key]
+	articleAuthorInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			articleAuthorAllColumns,
+			articleAuthorColumnsWithDefault,
+			articleAuthorColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(articleAuthorType, articleAuthorMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(articleAuthorType, articleAuthorMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `article_authors` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `article_authors` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `article_authors` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, articleAuthorPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "model: unable to insert into article_authors")
+	}
+
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ArticleID,
+		o.AuthorID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to populate default values for article_authors")
+	}
+
+CacheNoHooks:
+	if !cached {
+		articleAuthorInsertCacheMut.Lock()
+		articleAuthorInsertCache[key] = cache
+		articleAuthorInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the ArticleAuthor.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ArticleAuthor) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	articleAuthorUpdateCacheMut.RLock()
+	cache, cached := articleAuthorUpdateCache[key]
+	articleAuthorUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			articleAuthorAllColumns,
+			articleAuthorPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("model: unable to update article_authors, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `article_authors` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, articleAuthorPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(articleAuthorType, articleAuthorMapping, append(wl, articleAuthorPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "model: unable to update article_authors row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "model: failed to get rows affected by update for article_authors")
+	}
+
+	if !cached {
+		articleAuthorUpdateCacheMut.Lock()
+		articleAuthorUpdateCache[key] = cache
+		articleAuthorUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q articleAuthorQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "model: unable to update all for article_authors")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "model: unable to retrieve rows affected for article_authors")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ArticleAuthorSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("model: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), articleAuthorPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `article_authors` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, articleAuthorPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "model: unable to update all in articleAuthor slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "model: unable to retrieve rows affected all in update all articleAuthor")
+	}
+	return rowsAff, nil
+}
+
+var mySQLArticleAuthorUniqueColumns = []string{}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ArticleAuthor) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("model: no article_authors provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(articleAuthorColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLArticleAuthorUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	articleAuthorUpsertCacheMut.RLock()
+	cache, cached := articleAuthorUpsertCache[key]
+	articleAuthorUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			articleAuthorAllColumns,
+			articleAuthorColumnsWithDefault,
+			articleAuthorColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			articleAuthorAllColumns,
+			articleAuthorPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("model: unable to upsert article_authors, could not build update column list")
+		}
+
+		ret = strmangle.SetComplement(ret, nzUniques)
+		cache.query = buildUpsertQueryMySQL(dialect, "`article_authors`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `article_authors` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(articleAuthorType, articleAuthorMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(articleAuthorType, articleAuthorMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "model: unable to upsert for article_authors")
+	}
+
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(articleAuthorType, articleAuthorMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to retrieve unique values for article_authors")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to populate default values for article_authors")
+	}
+
+CacheNoHooks:
+	if !cached {
+		articleAuthorUpsertCacheMut.Lock()
+		articleAuthorUpsertCache[key] = cache
+		articleAuthorUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single ArticleAuthor record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ArticleAuthor) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("model: no ArticleAuthor provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), articleAuthorPrimaryKeyMapping)
+	sql := "DELETE FROM `article_authors` WHERE `article_id`=? AND `author_id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "model: unable to delete from article_authors")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "model: failed to get rows affected by delete for article_authors")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q articleAuthorQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("model: no articleAuthorQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "model: unable to delete all from article_authors")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "model: failed to get rows affected by deleteall for article_authors")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ArticleAuthorSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(articleAuthorBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), articleAuthorPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `article_authors` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, articleAuthorPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "model: unable to delete all from articleAuthor slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "model: failed to get rows affected by deleteall for article_authors")
+	}
+
+	if len(articleAuthorAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ArticleAuthor) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindArticleAuthor(ctx, exec, o.ArticleID, o.AuthorID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ArticleAuthorSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ArticleAuthorSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), articleAuthorPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `article_authors`.* FROM `article_authors` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, articleAuthorPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to reload all in ArticleAuthorSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ArticleAuthorExists checks if the ArticleAuthor row exists.
+func ArticleAuthorExists(ctx context.Context, exec boil.ContextExecutor, articleID string, authorID string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `article_authors` where `article_id`=? AND `author_id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, articleID, authorID)
+	}
+	row := exec.QueryRowContext(ctx, sql, articleID, authorID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "model: unable to check if article_authors exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the ArticleAuthor row exists.
+func (o *ArticleAuthor) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return ArticleAuthorExists(ctx, exec, o.ArticleID, o.AuthorID)
+}
@@ -0,0 +1,1143 @@
+// Code generated by SQLBoiler 4.15.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ArticleSlugHistory is an object representing the database table.
+type ArticleSlugHistory struct {
+	Slug      string    `boil:"slug" json:"slug" toml:"slug" yaml:"slug"`
+	ArticleID string    `boil:"article_id" json:"article_id" toml:"article_id" yaml:"article_id"`
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *articleSlugHistoryR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L articleSlugHistoryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ArticleSlugHistoryColumns = struct {
+	Slug      string
+	ArticleID string
+	CreatedAt string
+}{
+	Slug:      "slug",
+	ArticleID: "article_id",
+	CreatedAt: "created_at",
+}
+
+var ArticleSlugHistoryTableColumns = struct {
+	Slug      string
+	ArticleID string
+	CreatedAt string
+}{
+	Slug:      "article_slug_histories.slug",
+	ArticleID: "article_slug_histories.article_id",
+	CreatedAt: "article_slug_histories.created_at",
+}
+
+// Generated where
+
+var ArticleSlugHistoryWhere = struct {
+	Slug      whereHelperstring
+	ArticleID whereHelperstring
+	CreatedAt whereHelpertime_Time
+}{
+	Slug:      whereHelperstring{field: "`article_slug_histories`.`slug`"},
+	ArticleID: whereHelperstring{field: "`article_slug_histories`.`article_id`"},
+	CreatedAt: whereHelpertime_Time{field: "`article_slug_histories`.`created_at`"},
+}
+
+// ArticleSlugHistoryRels is where relationship names are stored.
+var ArticleSlugHistoryRels = struct {
+	Article string
+}{
+	Article: "Article",
+}
+
+// articleSlugHistoryR is where relationships are stored.
+type articleSlugHistoryR struct {
+	Article *Article `boil:"Article" json:"Article" toml:"Article" yaml:"Article"`
+}
+
+// NewStruct creates a new relationship struct
+func (*articleSlugHistoryR) NewStruct() *articleSlugHistoryR {
+	return &articleSlugHistoryR{}
+}
+
+func (r *articleSlugHistoryR) GetArticle() *Article {
+	if r == nil {
+		return nil
+	}
+	return r.Article
+}
+
+// articleSlugHistoryL is where Load methods for each relationship are stored.
+type articleSlugHistoryL struct{}
+
+var (
+	articleSlugHistoryAllColumns            = []string{"slug", "article_id", "created_at"}
+	articleSlugHistoryColumnsWithoutDefault = []string{"slug", "article_id"}
+	articleSlugHistoryColumnsWithDefault    = []string{"created_at"}
+	articleSlugHistoryPrimaryKeyColumns     = []string{"slug"}
+	articleSlugHistoryGeneratedColumns      = []string{}
+)
+
+type (
+	// ArticleSlugHistorySlice is an alias for a slice of pointers to ArticleSlugHistory.
+	// This should almost always be used instead of []ArticleSlugHistory.
+	ArticleSlugHistorySlice []*ArticleSlugHistory
+	// ArticleSlugHistoryHook is the signature for custom ArticleSlugHistory hook methods
+	ArticleSlugHistoryHook func(context.Context, boil.ContextExecutor, *ArticleSlugHistory) error
+
+	articleSlugHistoryQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	articleSlugHistoryType                 = reflect.TypeOf(&ArticleSlugHistory{})
+	articleSlugHistoryMapping              = queries.MakeStructMapping(articleSlugHistoryType)
+	articleSlugHistoryPrimaryKeyMapping, _ = queries.BindMapping(articleSlugHistoryType, articleSlugHistoryMapping, articleSlugHistoryPrimaryKeyColumns)
+	articleSlugHistoryInsertCacheMut       sync.RWMutex
+	articleSlugHistoryInsertCache          = make(map[string]insertCache)
+	articleSlugHistoryUpdateCacheMut       sync.RWMutex
+	articleSlugHistoryUpdateCache          = make(map[string]updateCache)
+	articleSlugHistoryUpsertCacheMut       sync.RWMutex
+	articleSlugHistoryUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var articleSlugHistoryAfterSelectHooks []ArticleSlugHistoryHook
+
+var articleSlugHistoryBeforeInsertHooks []ArticleSlugHistoryHook
+var articleSlugHistoryAfterInsertHooks []ArticleSlugHistoryHook
+
+var articleSlugHistoryBeforeUpdateHooks []ArticleSlugHistoryHook
+var articleSlugHistoryAfterUpdateHooks []ArticleSlugHistoryHook
+
+var articleSlugHistoryBeforeDeleteHooks []ArticleSlugHistoryHook
+var articleSlugHistoryAfterDeleteHooks []ArticleSlugHistoryHook
+
+var articleSlugHistoryBeforeUpsertHooks []ArticleSlugHistoryHook
+var articleSlugHistoryAfterUpsertHooks []ArticleSlugHistoryHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ArticleSlugHistory) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range articleSlugHistoryAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ArticleSlugHistory) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range articleSlugHistoryBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ArticleSlugHistory) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range articleSlugHistoryAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ArticleSlugHistory) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range articleSlugHistoryBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ArticleSlugHistory) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range articleSlugHistoryAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ArticleSlugHistory) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range articleSlugHistoryBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ArticleSlugHistory) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range articleSlugHistoryAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ArticleSlugHistory) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range articleSlugHistoryBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ArticleSlugHistory) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range articleSlugHistoryAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddArticleSlugHistoryHook registers your hook function for all future operations.
+func AddArticleSlugHistoryHook(hookPoint boil.HookPoint, articleSlugHistoryHook ArticleSlugHistoryHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		articleSlugHistoryAfterSelectHooks = append(articleSlugHistoryAfterSelectHooks, articleSlugHistoryHook)
+	case boil.BeforeInsertHook:
+		articleSlugHistoryBeforeInsertHooks = append(articleSlugHistoryBeforeInsertHooks, articleSlugHistoryHook)
+	case boil.AfterInsertHook:
+		articleSlugHistoryAfterInsertHooks = append(articleSlugHistoryAfterInsertHooks, articleSlugHistoryHook)
+	case boil.BeforeUpdateHook:
+		articleSlugHistoryBeforeUpdateHooks = append(articleSlugHistoryBeforeUpdateHooks, articleSlugHistoryHook)
+	case boil.AfterUpdateHook:
+		articleSlugHistoryAfterUpdateHooks = append(articleSlugHistoryAfterUpdateHooks, articleSlugHistoryHook)
+	case boil.BeforeDeleteHook:
+		articleSlugHistoryBeforeDeleteHooks = append(articleSlugHistoryBeforeDeleteHooks, articleSlugHistoryHook)
+	case boil.AfterDeleteHook:
+		articleSlugHistoryAfterDeleteHooks = append(articleSlugHistoryAfterDeleteHooks, articleSlugHistoryHook)
+	case boil.BeforeUpsertHook:
+		articleSlugHistoryBeforeUpsertHooks = append(articleSlugHistoryBeforeUpsertHooks, articleSlugHistoryHook)
+	case boil.AfterUpsertHook:
+		articleSlugHistoryAfterUpsertHooks = append(articleSlugHistoryAfterUpsertHooks, articleSlugHistoryHook)
+	}
+}
+
+// One returns a single articleSlugHistory record from the query.
+func (q articleSlugHistoryQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ArticleSlugHistory, error) {
+	o := &ArticleSlugHistory{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "model: failed to execute a one query for article_slug_histories")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all ArticleSlugHistory records from the query.
+func (q articleSlugHistoryQuery) All(ctx context.Context, exec boil.ContextExecutor) (ArticleSlugHistorySlice, error) {
+	var o []*ArticleSlugHistory
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "model: failed to assign all query results to ArticleSlugHistory slice")
+	}
+
+	if len(articleSlugHistoryAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ArticleSlugHistory records in the query.
+func (q articleSlugHistoryQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "model: failed to count article_slug_histories rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q articleSlugHistoryQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "model: failed to check if article_slug_histories exists")
+	}
+
+	return count > 0, nil
+}
+
+// Article pointed to by the foreign key.
+func (o *ArticleSlugHistory) Article(mods ...qm.QueryMod) articleQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.ArticleID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Articles(queryMods...)
+}
+
+// LoadArticle allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (articleSlugHistoryL) LoadArticle(ctx context.Context, e boil.ContextExecutor, singular bool, maybeArticleSlugHistory interface{}, mods queries.Applicator) error {
+	var slice []*ArticleSlugHistory
+	var object *ArticleSlugHistory
+
+	if singular {
+		var ok bool
+		object, ok = maybeArticleSlugHistory.(*ArticleSlugHistory)
+		if !ok {
+			object = new(ArticleSlugHistory)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeArticleSlugHistory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeArticleSlugHistory))
+			}
+		}
+	} else {
+		s, ok := maybeArticleSlugHistory.(*[]*ArticleSlugHistory)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeArticleSlugHistory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeArticleSlugHistory))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &articleSlugHistoryR{}
+		}
+		args = append(args, object.ArticleID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &articleSlugHistoryR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ArticleID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ArticleID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`articles`),
+		qm.WhereIn(`articles.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Article")
+	}
+
+	var resultSlice []*Article
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Article")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for articles")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for articles")
+	}
+
+	if len(articleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Article = foreign
+		if foreign.R == nil {
+			foreign.R = &articleR{}
+		}
+		foreign.R.ArticleSlugHistories = append(foreign.R.ArticleSlugHistories, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ArticleID == foreign.ID {
+				local.R.Article = foreign
+				if foreign.R == nil {
+					foreign.R = &articleR{}
+				}
+				foreign.R.ArticleSlugHistories = append(foreign.R.ArticleSlugHistories, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetArticle of the articleSlugHistory to the related item.
+// Sets o.R.Article to related.
+// Adds o to related.R.ArticleSlugHistories.
+func (o *ArticleSlugHistory) SetArticle(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Article) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `article_slug_histories` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"article_id"}),
+		strmangle.WhereClause("`", "`", 0, articleSlugHistoryPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.Slug}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ArticleID = related.ID
+	if o.R == nil {
+		o.R = &articleSlugHistoryR{
+			Article: related,
+		}
+	} else {
+		o.R.Article = related
+	}
+
+	if related.R == nil {
+		related.R = &articleR{
+			ArticleSlugHistories: ArticleSlugHistorySlice{o},
+		}
+	} else {
+		related.R.ArticleSlugHistories = append(related.R.ArticleSlugHistories, o)
+	}
+
+	return nil
+}
+
+// ArticleSlugHistories retrieves all the records using an executor.
+func ArticleSlugHistories(mods ...qm.QueryMod) articleSlugHistoryQuery {
+	mods = append(mods, qm.From("`article_slug_histories`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`article_slug_histories`.*"})
+	}
+
+	return articleSlugHistoryQuery{q}
+}
+
+// FindArticleSlugHistory retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindArticleSlugHistory(ctx context.Context, exec boil.ContextExecutor, slug string, selectCols ...string) (*ArticleSlugHistory, error) {
+	articleSlugHistoryObj := &ArticleSlugHistory{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `article_slug_histories` where `slug`=?", sel,
+	)
+
+	q := queries.Raw(query, slug)
+
+	err := q.Bind(ctx, exec, articleSlugHistoryObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "model: unable to select from article_slug_histories")
+	}
+
+	if err = articleSlugHistoryObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return articleSlugHistoryObj, err
+	}
+
+	return articleSlugHistoryObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ArticleSlugHistory) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("model: no article_slug_histories provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(articleSlugHistoryColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	articleSlugHistoryInsertCacheMut.RLock()
+	cache, cached := articleSlugHistoryInsertCache[key]
+	articleSlugHistoryInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			articleSlugHistoryAllColumns,
+			articleSlugHistoryColumnsWithDefault,
+			articleSlugHistoryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(articleSlugHistoryType, articleSlugHistoryMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(articleSlugHistoryType, articleSlugHistoryMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `article_slug_histories` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `article_slug_histories` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `article_slug_histories` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, articleSlugHistoryPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "model: unable to insert into article_slug_histories")
+	}
+
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.Slug,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to populate default values for article_slug_histories")
+	}
+
+CacheNoHooks:
+	if !cached {
+		articleSlugHistoryInsertCacheMut.Lock()
+		articleSlugHistoryInsertCache[key] = cache
+		articleSlugHistoryInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the ArticleSlugHistory.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ArticleSlugHistory) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	articleSlugHistoryUpdateCacheMut.RLock()
+	cache, cached := articleSlugHistoryUpdateCache[key]
+	articleSlugHistoryUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			articleSlugHistoryAllColumns,
+			articleSlugHistoryPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("model: unable to update article_slug_histories, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `article_slug_histories` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, articleSlugHistoryPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(articleSlugHistoryType, articleSlugHistoryMapping, append(wl, articleSlugHistoryPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "model: unable to update article_slug_histories row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "model: failed to get rows affected by update for article_slug_histories")
+	}
+
+	if !cached {
+		articleSlugHistoryUpdateCacheMut.Lock()
+		articleSlugHistoryUpdateCache[key] = cache
+		articleSlugHistoryUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q articleSlugHistoryQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "model: unable to update all for article_slug_histories")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "model: unable to retrieve rows affected for article_slug_histories")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ArticleSlugHistorySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("model: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), articleSlugHistoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `article_slug_histories` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, articleSlugHistoryPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "model: unable to update all in articleSlugHistory slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "model: unable to retrieve rows affected all in update all articleSlugHistory")
+	}
+	return rowsAff, nil
+}
+
+var mySQLArticleSlugHistoryUniqueColumns = []string{
+	"slug",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ArticleSlugHistory) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("model: no article_slug_histories provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(articleSlugHistoryColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLArticleSlugHistoryUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	articleSlugHistoryUpsertCacheMut.RLock()
+	cache, cached := articleSlugHistoryUpsertCache[key]
+	articleSlugHistoryUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			articleSlugHistoryAllColumns,
+			articleSlugHistoryColumnsWithDefault,
+			articleSlugHistoryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			articleSlugHistoryAllColumns,
+			articleSlugHistoryPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("model: unable to upsert article_slug_histories, could not build update column list")
+		}
+
+		ret = strmangle.SetComplement(ret, nzUniques)
+		cache.query = buildUpsertQueryMySQL(dialect, "`article_slug_histories`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `article_slug_histories` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(articleSlugHistoryType, articleSlugHistoryMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(articleSlugHistoryType, articleSlugHistoryMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "model: unable to upsert for article_slug_histories")
+	}
+
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(articleSlugHistoryType, articleSlugHistoryMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to retrieve unique values for article_slug_histories")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to populate default values for article_slug_histories")
+	}
+
+CacheNoHooks:
+	if !cached {
+		articleSlugHistoryUpsertCacheMut.Lock()
+		articleSlugHistoryUpsertCache[key] = cache
+		articleSlugHistoryUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single ArticleSlugHistory record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ArticleSlugHistory) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("model: no ArticleSlugHistory provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), articleSlugHistoryPrimaryKeyMapping)
+	sql := "DELETE FROM `article_slug_histories` WHERE `slug`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "model: unable to delete from article_slug_histories")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "model: failed to get rows affected by delete for article_slug_histories")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q articleSlugHistoryQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("model: no articleSlugHistoryQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "model: unable to delete all from article_slug_histories")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "model: failed to get rows affected by deleteall for article_slug_histories")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ArticleSlugHistorySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(articleSlugHistoryBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), articleSlugHistoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `article_slug_histories` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, articleSlugHistoryPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "model: unable to delete all from articleSlugHistory slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "model: failed to get rows affected by deleteall for article_slug_histories")
+	}
+
+	if len(articleSlugHistoryAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ArticleSlugHistory) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindArticleSlugHistory(ctx, exec, o.Slug)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ArticleSlugHistorySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ArticleSlugHistorySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), articleSlugHistoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `article_slug_histories`.* FROM `article_slug_histories` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, articleSlugHistoryPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to reload all in ArticleSlugHistorySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ArticleSlugHistoryExists checks if the ArticleSlugHistory row exists.
+func ArticleSlugHistoryExists(ctx context.Context, exec boil.ContextExecutor, slug string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `article_slug_histories` where `slug`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, slug)
+	}
+	row := exec.QueryRowContext(ctx, sql, slug)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "model: unable to check if article_slug_histories exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the ArticleSlugHistory row exists.
+func (o *ArticleSlugHistory) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return ArticleSlugHistoryExists(ctx, exec, o.Slug)
+}
@@ -24,164 +24,209 @@ import (
 
 // Article is an object representing the database table.
 type Article struct {
-	ID          string    `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Title       string    `boil:"title" json:"title" toml:"title" yaml:"title"`
-	Content     string    `boil:"content" json:"content" toml:"content" yaml:"content"`
-	CategoryID  string    `boil:"category_id" json:"category_id" toml:"category_id" yaml:"category_id"`
-	Status      string    `boil:"status" json:"status" toml:"status" yaml:"status"`
-	PublishedAt null.Time `boil:"published_at" json:"published_at,omitempty" toml:"published_at" yaml:"published_at,omitempty"`
-	CreatedAt   time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt   time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	ID              string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Title           string      `boil:"title" json:"title" toml:"title" yaml:"title"`
+	Slug            string      `boil:"slug" json:"slug" toml:"slug" yaml:"slug"`
+	Content         string      `boil:"content" json:"content" toml:"content" yaml:"content"`
+	CategoryID      string      `boil:"category_id" json:"category_id" toml:"category_id" yaml:"category_id"`
+	Status          string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	PublishedAt     null.Time   `boil:"published_at" json:"published_at,omitempty" toml:"published_at" yaml:"published_at,omitempty"`
+	CreatedAt       time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt       time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	DeletedAt       null.Time   `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	DeletedTagNames null.JSON   `boil:"deleted_tag_names" json:"deleted_tag_names,omitempty" toml:"deleted_tag_names" yaml:"deleted_tag_names,omitempty"`
+	Version         int         `boil:"version" json:"version" toml:"version" yaml:"version"`
+	CreatedBy       null.String `boil:"created_by" json:"created_by,omitempty" toml:"created_by" yaml:"created_by,omitempty"`
 
 	R *articleR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L articleL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var ArticleColumns = struct {
-	ID          string
-	Title       string
-	Content     string
-	CategoryID  string
-	Status      string
-	PublishedAt string
-	CreatedAt   string
-	UpdatedAt   string
+	ID              string
+	Title           string
+	Slug            string
+	Content         string
+	CategoryID      string
+	Status          string
+	PublishedAt     string
+	CreatedAt       string
+	UpdatedAt       string
+	DeletedAt       string
+	DeletedTagNames string
+	Version         string
+	CreatedBy       string
 }{
-	ID:          "id",
-	Title:       "title",
-	Content:     "content",
-	CategoryID:  "category_id",
-	Status:      "status",
-	PublishedAt: "published_at",
-	CreatedAt:   "created_at",
-	UpdatedAt:   "updated_at",
+	ID:              "id",
+	Title:           "title",
+	Slug:            "slug",
+	Content:         "content",
+	CategoryID:      "category_id",
+	Status:          "status",
+	PublishedAt:     "published_at",
+	CreatedAt:       "created_at",
+	UpdatedAt:       "updated_at",
+	DeletedAt:       "deleted_at",
+	DeletedTagNames: "deleted_tag_names",
+	Version:         "version",
+	CreatedBy:       "created_by",
 }
 
 var ArticleTableColumns = struct {
-	ID          string
-	Title       string
-	Content     string
-	CategoryID  string
-	Status      string
-	PublishedAt string
-	CreatedAt   string
-	UpdatedAt   string
+	ID              string
+	Title           string
+	Slug            string
+	Content         string
+	CategoryID      string
+	Status          string
+	PublishedAt     string
+	CreatedAt       string
+	UpdatedAt       string
+	DeletedAt       string
+	DeletedTagNames string
+	Version         string
+	CreatedBy       string
 }{
-	ID:          "articles.id",
-	Title:       "articles.title",
-	Content:     "articles.content",
-	CategoryID:  "articles.category_id",
-	Status:      "articles.status",
-	PublishedAt: "articles.published_at",
-	CreatedAt:   "articles.created_at",
-	UpdatedAt:   "articles.updated_at",
+	ID:              "articles.id",
+	Title:           "articles.title",
+	Slug:            "articles.slug",
+	Content:         "articles.content",
+	CategoryID:      "articles.category_id",
+	Status:          "articles.status",
+	PublishedAt:     "articles.published_at",
+	CreatedAt:       "articles.created_at",
+	UpdatedAt:       "articles.updated_at",
+	DeletedAt:       "articles.deleted_at",
+	DeletedTagNames: "articles.deleted_tag_names",
+	Version:         "articles.version",
+	CreatedBy:       "articles.created_by",
 }
 
 // Generated where
 
-type whereHelperstring struct{ field string }
-
-func (w whereHelperstring) EQ(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperstring) NEQ(x string) qm.QueryMod   { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperstring) LT(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperstring) LTE(x string) qm.QueryMod   { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperstring) GT(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperstring) GTE(x string) qm.QueryMod   { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperstring) LIKE(x string) qm.QueryMod  { return qm.Where(w.field+" LIKE ?", x) }
-func (w whereHelperstring) NLIKE(x string) qm.QueryMod { return qm.Where(w.field+" NOT LIKE ?", x) }
-func (w whereHelperstring) IN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
+type whereHelpernull_JSON struct{ field string }
 
-type whereHelpernull_Time struct{ field string }
-
-func (w whereHelpernull_Time) EQ(x null.Time) qm.QueryMod {
+func (w whereHelpernull_JSON) EQ(x null.JSON) qm.QueryMod {
 	return qmhelper.WhereNullEQ(w.field, false, x)
 }
-func (w whereHelpernull_Time) NEQ(x null.Time) qm.QueryMod {
+func (w whereHelpernull_JSON) NEQ(x null.JSON) qm.QueryMod {
 	return qmhelper.WhereNullEQ(w.field, true, x)
 }
-func (w whereHelpernull_Time) LT(x null.Time) qm.QueryMod {
+func (w whereHelpernull_JSON) LT(x null.JSON) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.LT, x)
 }
-func (w whereHelpernull_Time) LTE(x null.Time) qm.QueryMod {
+func (w whereHelpernull_JSON) LTE(x null.JSON) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.LTE, x)
 }
-func (w whereHelpernull_Time) GT(x null.Time) qm.QueryMod {
+func (w whereHelpernull_JSON) GT(x null.JSON) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GT, x)
 }
-func (w whereHelpernull_Time) GTE(x null.Time) qm.QueryMod {
+func (w whereHelpernull_JSON) GTE(x null.JSON) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GTE, x)
 }
 
-func (w whereHelpernull_Time) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_Time) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+func (w whereHelpernull_JSON) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_JSON) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
 
-type whereHelpertime_Time struct{ field string }
+type whereHelpernull_String struct{ field string }
 
-func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.EQ, x)
+func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
 }
-func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+func (w whereHelpernull_String) NEQ(x null.String) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
 }
-func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
+func (w whereHelpernull_String) LT(x null.String) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.LT, x)
 }
-func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
+func (w whereHelpernull_String) LTE(x null.String) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.LTE, x)
 }
-func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
+func (w whereHelpernull_String) GT(x null.String) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GT, x)
 }
-func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
+func (w whereHelpernull_String) GTE(x null.String) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GTE, x)
 }
+func (w whereHelpernull_String) LIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" LIKE ?", x)
+}
+func (w whereHelpernull_String) NLIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" NOT LIKE ?", x)
+}
+func (w whereHelpernull_String) IN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_String) NIN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
 
 var ArticleWhere = struct {
-	ID          whereHelperstring
-	Title       whereHelperstring
-	Content     whereHelperstring
-	CategoryID  whereHelperstring
-	Status      whereHelperstring
-	PublishedAt whereHelpernull_Time
-	CreatedAt   whereHelpertime_Time
-	UpdatedAt   whereHelpertime_Time
+	ID              whereHelperstring
+	Title           whereHelperstring
+	Slug            whereHelperstring
+	Content         whereHelperstring
+	CategoryID      whereHelperstring
+	Status          whereHelperstring
+	PublishedAt     whereHelpernull_Time
+	CreatedAt       whereHelpertime_Time
+	UpdatedAt       whereHelpertime_Time
+	DeletedAt       whereHelpernull_Time
+	DeletedTagNames whereHelpernull_JSON
+	Version         whereHelperint
+	CreatedBy       whereHelpernull_String
 }{
-	ID:          whereHelperstring{field: "`articles`.`id`"},
-	Title:       whereHelperstring{field: "`articles`.`title`"},
-	Content:     whereHelperstring{field: "`articles`.`content`"},
-	CategoryID:  whereHelperstring{field: "`articles`.`category_id`"},
-	Status:      whereHelperstring{field: "`articles`.`status`"},
-	PublishedAt: whereHelpernull_Time{field: "`articles`.`published_at`"},
-	CreatedAt:   whereHelpertime_Time{field: "`articles`.`created_at`"},
-	UpdatedAt:   whereHelpertime_Time{field: "`articles`.`updated_at`"},
+	ID:              whereHelperstring{field: "`articles`.`id`"},
+	Title:           whereHelperstring{field: "`articles`.`title`"},
+	Slug:            whereHelperstring{field: "`articles`.`slug`"},
+	Content:         whereHelperstring{field: "`articles`.`content`"},
+	CategoryID:      whereHelperstring{field: "`articles`.`category_id`"},
+	Status:          whereHelperstring{field: "`articles`.`status`"},
+	PublishedAt:     whereHelpernull_Time{field: "`articles`.`published_at`"},
+	CreatedAt:       whereHelpertime_Time{field: "`articles`.`created_at`"},
+	UpdatedAt:       whereHelpertime_Time{field: "`articles`.`updated_at`"},
+	DeletedAt:       whereHelpernull_Time{field: "`articles`.`deleted_at`"},
+	DeletedTagNames: whereHelpernull_JSON{field: "`articles`.`deleted_tag_names`"},
+	Version:         whereHelperint{field: "`articles`.`version`"},
+	CreatedBy:       whereHelpernull_String{field: "`articles`.`created_by`"},
 }
 
 // ArticleRels is where relationship names are stored.
 var ArticleRels = struct {
-	Category string
-	Taggings string
+	Category             string
+	CreatedByUser        string
+	ArticleAuthors       string
+	ArticleRevisions     string
+	ArticleSlugHistories string
+	Taggings             string
 }{
-	Category: "Category",
-	Taggings: "Taggings",
+	Category:             "Category",
+	CreatedByUser:        "CreatedByUser",
+	ArticleAuthors:       "ArticleAuthors",
+	ArticleRevisions:     "ArticleRevisions",
+	ArticleSlugHistories: "ArticleSlugHistories",
+	Taggings:             "Taggings",
 }
 
 // articleR is where relationships are stored.
 type articleR struct {
-	Category *Category    `boil:"Category" json:"Category" toml:"Category" yaml:"Category"`
-	Taggings TaggingSlice `boil:"Taggings" json:"Taggings" toml:"Taggings" yaml:"Taggings"`
+	Category             *Category               `boil:"Category" json:"Category" toml:"Category" yaml:"Category"`
+	CreatedByUser        *User                   `boil:"CreatedByUser" json:"CreatedByUser" toml:"CreatedByUser" yaml:"CreatedByUser"`
+	ArticleAuthors       ArticleAuthorSlice      `boil:"ArticleAuthors" json:"ArticleAuthors" toml:"ArticleAuthors" yaml:"ArticleAuthors"`
+	ArticleRevisions     ArticleRevisionSlice    `boil:"ArticleRevisions" json:"ArticleRevisions" toml:"ArticleRevisions" yaml:"ArticleRevisions"`
+	ArticleSlugHistories ArticleSlugHistorySlice `boil:"ArticleSlugHistories" json:"ArticleSlugHistories" toml:"ArticleSlugHistories" yaml:"ArticleSlugHistories"`
+	Taggings             TaggingSlice            `boil:"Taggings" json:"Taggings" toml:"Taggings" yaml:"Taggings"`
 }
 
 // NewStruct creates a new relationship struct
@@ -196,6 +241,34 @@ func (r *articleR) GetCategory() *Category {
 	return r.Category
 }
 
+func (r *articleR) GetCreatedByUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.CreatedByUser
+}
+
+func (r *articleR) GetArticleAuthors() ArticleAuthorSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ArticleAuthors
+}
+
+func (r *articleR) GetArticleRevisions() ArticleRevisionSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ArticleRevisions
+}
+
+func (r *articleR) GetArticleSlugHistories() ArticleSlugHistorySlice {
+	if r == nil {
+		return nil
+	}
+	return r.ArticleSlugHistories
+}
+
 func (r *articleR) GetTaggings() TaggingSlice {
 	if r == nil {
 		return nil
@@ -207,9 +280,9 @@ func (r *articleR) GetTaggings() TaggingSlice {
 type articleL struct{}
 
 var (
-	articleAllColumns            = []string{"id", "title", "content", "category_id", "status", "published_at", "created_at", "updated_at"}
-	articleColumnsWithoutDefault = []string{"id", "title", "content", "category_id", "published_at"}
-	articleColumnsWithDefault    = []string{"status", "created_at", "updated_at"}
+	articleAllColumns            = []string{"id", "title", "slug", "content", "category_id", "status", "published_at", "created_at", "updated_at", "deleted_at", "deleted_tag_names", "version", "created_by"}
+	articleColumnsWithoutDefault = []string{"id", "title", "slug", "content", "category_id", "published_at", "deleted_at", "deleted_tag_names", "created_by"}
+	articleColumnsWithDefault    = []string{"status", "created_at", "updated_at", "version"}
 	articlePrimaryKeyColumns     = []string{"id"}
 	articleGeneratedColumns      = []string{}
 )
@@ -503,6 +576,59 @@ func (o *Article) Category(mods ...qm.QueryMod) categoryQuery {
 	return Categories(queryMods...)
 }
 
+// CreatedByUser pointed to by the foreign key.
+func (o *Article) CreatedByUser(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.CreatedBy),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// ArticleAuthors retrieves all the article_author's ArticleAuthors with an executor.
+func (o *Article) ArticleAuthors(mods ...qm.QueryMod) articleAuthorQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`article_authors`.`article_id`=?", o.ID),
+	)
+
+	return ArticleAuthors(queryMods...)
+}
+
+// ArticleRevisions retrieves all the article_revision's ArticleRevisions with an executor.
+func (o *Article) ArticleRevisions(mods ...qm.QueryMod) articleRevisionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`article_revisions`.`article_id`=?", o.ID),
+	)
+
+	return ArticleRevisions(queryMods...)
+}
+
+// ArticleSlugHistories retrieves all the article_slug_history's ArticleSlugHistories with an executor.
+func (o *Article) ArticleSlugHistories(mods ...qm.QueryMod) articleSlugHistoryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`article_slug_histories`.`article_id`=?", o.ID),
+	)
+
+	return ArticleSlugHistories(queryMods...)
+}
+
 // Taggings retrieves all the tagging's Taggings with an executor.
 func (o *Article) Taggings(mods ...qm.QueryMod) taggingQuery {
 	var queryMods []qm.QueryMod
@@ -637,9 +763,133 @@ func (articleL) LoadCategory(ctx context.Context, e boil.ContextExecutor, singul
 	return nil
 }
 
-// LoadTaggings allows an eager lookup of values, cached into the
+// LoadCreatedByUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (articleL) LoadCreatedByUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeArticle interface{}, mods queries.Applicator) error {
+	var slice []*Article
+	var object *Article
+
+	if singular {
+		var ok bool
+		object, ok = maybeArticle.(*Article)
+		if !ok {
+			object = new(Article)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeArticle)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeArticle))
+			}
+		}
+	} else {
+		s, ok := maybeArticle.(*[]*Article)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeArticle)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeArticle))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &articleR{}
+		}
+		if !queries.IsNil(object.CreatedBy) {
+			args = append(args, object.CreatedBy)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &articleR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.CreatedBy) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.CreatedBy) {
+				args = append(args, obj.CreatedBy)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.CreatedByUser = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.CreatedByArticles = append(foreign.R.CreatedByArticles, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.CreatedBy, foreign.ID) {
+				local.R.CreatedByUser = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.CreatedByArticles = append(foreign.R.CreatedByArticles, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadArticleAuthors allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (articleL) LoadTaggings(ctx context.Context, e boil.ContextExecutor, singular bool, maybeArticle interface{}, mods queries.Applicator) error {
+func (articleL) LoadArticleAuthors(ctx context.Context, e boil.ContextExecutor, singular bool, maybeArticle interface{}, mods queries.Applicator) error {
 	var slice []*Article
 	var object *Article
 
@@ -693,8 +943,8 @@ func (articleL) LoadTaggings(ctx context.Context, e boil.ContextExecutor, singul
 	}
 
 	query := NewQuery(
-		qm.From(`taggings`),
-		qm.WhereIn(`taggings.article_id in ?`, args...),
+		qm.From(`article_authors`),
+		qm.WhereIn(`article_authors.article_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -702,22 +952,22 @@ func (articleL) LoadTaggings(ctx context.Context, e boil.ContextExecutor, singul
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load taggings")
+		return errors.Wrap(err, "failed to eager load article_authors")
 	}
 
-	var resultSlice []*Tagging
+	var resultSlice []*ArticleAuthor
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice taggings")
+		return errors.Wrap(err, "failed to bind eager loaded slice article_authors")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on taggings")
+		return errors.Wrap(err, "failed to close results in eager load on article_authors")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for taggings")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for article_authors")
 	}
 
-	if len(taggingAfterSelectHooks) != 0 {
+	if len(articleAuthorAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -725,10 +975,10 @@ func (articleL) LoadTaggings(ctx context.Context, e boil.ContextExecutor, singul
 		}
 	}
 	if singular {
-		object.R.Taggings = resultSlice
+		object.R.ArticleAuthors = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &taggingR{}
+				foreign.R = &articleAuthorR{}
 			}
 			foreign.R.Article = object
 		}
@@ -738,9 +988,9 @@ func (articleL) LoadTaggings(ctx context.Context, e boil.ContextExecutor, singul
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.ArticleID {
-				local.R.Taggings = append(local.R.Taggings, foreign)
+				local.R.ArticleAuthors = append(local.R.ArticleAuthors, foreign)
 				if foreign.R == nil {
-					foreign.R = &taggingR{}
+					foreign.R = &articleAuthorR{}
 				}
 				foreign.R.Article = local
 				break
@@ -751,43 +1001,385 @@ func (articleL) LoadTaggings(ctx context.Context, e boil.ContextExecutor, singul
 	return nil
 }
 
-// SetCategory of the article to the related item.
-// Sets o.R.Category to related.
-// Adds o to related.R.Articles.
-func (o *Article) SetCategory(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Category) error {
-	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
-		}
-	}
-
-	updateQuery := fmt.Sprintf(
-		"UPDATE `articles` SET %s WHERE %s",
-		strmangle.SetParamNames("`", "`", 0, []string{"category_id"}),
-		strmangle.WhereClause("`", "`", 0, articlePrimaryKeyColumns),
-	)
-	values := []interface{}{related.ID, o.ID}
-
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
-		fmt.Fprintln(writer, values)
-	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
-	}
+// LoadArticleRevisions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (articleL) LoadArticleRevisions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeArticle interface{}, mods queries.Applicator) error {
+	var slice []*Article
+	var object *Article
 
-	o.CategoryID = related.ID
-	if o.R == nil {
-		o.R = &articleR{
-			Category: related,
+	if singular {
+		var ok bool
+		object, ok = maybeArticle.(*Article)
+		if !ok {
+			object = new(Article)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeArticle)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeArticle))
+			}
 		}
 	} else {
-		o.R.Category = related
+		s, ok := maybeArticle.(*[]*Article)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeArticle)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeArticle))
+			}
+		}
 	}
 
-	if related.R == nil {
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &articleR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &articleR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`article_revisions`),
+		qm.WhereIn(`article_revisions.article_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load article_revisions")
+	}
+
+	var resultSlice []*ArticleRevision
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice article_revisions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on article_revisions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for article_revisions")
+	}
+
+	if len(articleRevisionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ArticleRevisions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &articleRevisionR{}
+			}
+			foreign.R.Article = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ArticleID {
+				local.R.ArticleRevisions = append(local.R.ArticleRevisions, foreign)
+				if foreign.R == nil {
+					foreign.R = &articleRevisionR{}
+				}
+				foreign.R.Article = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadArticleSlugHistories allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (articleL) LoadArticleSlugHistories(ctx context.Context, e boil.ContextExecutor, singular bool, maybeArticle interface{}, mods queries.Applicator) error {
+	var slice []*Article
+	var object *Article
+
+	if singular {
+		var ok bool
+		object, ok = maybeArticle.(*Article)
+		if !ok {
+			object = new(Article)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeArticle)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeArticle))
+			}
+		}
+	} else {
+		s, ok := maybeArticle.(*[]*Article)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeArticle)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeArticle))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &articleR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &articleR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`article_slug_histories`),
+		qm.WhereIn(`article_slug_histories.article_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load article_slug_histories")
+	}
+
+	var resultSlice []*ArticleSlugHistory
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice article_slug_histories")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on article_slug_histories")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for article_slug_histories")
+	}
+
+	if len(articleSlugHistoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ArticleSlugHistories = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &articleSlugHistoryR{}
+			}
+			foreign.R.Article = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ArticleID {
+				local.R.ArticleSlugHistories = append(local.R.ArticleSlugHistories, foreign)
+				if foreign.R == nil {
+					foreign.R = &articleSlugHistoryR{}
+				}
+				foreign.R.Article = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTaggings allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (articleL) LoadTaggings(ctx context.Context, e boil.ContextExecutor, singular bool, maybeArticle interface{}, mods queries.Applicator) error {
+	var slice []*Article
+	var object *Article
+
+	if singular {
+		var ok bool
+		object, ok = maybeArticle.(*Article)
+		if !ok {
+			object = new(Article)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeArticle)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeArticle))
+			}
+		}
+	} else {
+		s, ok := maybeArticle.(*[]*Article)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeArticle)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeArticle))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &articleR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &articleR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`taggings`),
+		qm.WhereIn(`taggings.article_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load taggings")
+	}
+
+	var resultSlice []*Tagging
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice taggings")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on taggings")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for taggings")
+	}
+
+	if len(taggingAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Taggings = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &taggingR{}
+			}
+			foreign.R.Article = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ArticleID {
+				local.R.Taggings = append(local.R.Taggings, foreign)
+				if foreign.R == nil {
+					foreign.R = &taggingR{}
+				}
+				foreign.R.Article = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetCategory of the article to the related item.
+// Sets o.R.Category to related.
+// Adds o to related.R.Articles.
+func (o *Article) SetCategory(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Category) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `articles` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"category_id"}),
+		strmangle.WhereClause("`", "`", 0, articlePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.CategoryID = related.ID
+	if o.R == nil {
+		o.R = &articleR{
+			Category: related,
+		}
+	} else {
+		o.R.Category = related
+	}
+
+	if related.R == nil {
 		related.R = &categoryR{
 			Articles: ArticleSlice{o},
 		}
@@ -798,6 +1390,245 @@ func (o *Article) SetCategory(ctx context.Context, exec boil.ContextExecutor, in
 	return nil
 }
 
+// SetCreatedByUser of the article to the related item.
+// Sets o.R.CreatedByUser to related.
+// Adds o to related.R.CreatedByArticles.
+func (o *Article) SetCreatedByUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `articles` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"created_by"}),
+		strmangle.WhereClause("`", "`", 0, articlePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.CreatedBy, related.ID)
+	if o.R == nil {
+		o.R = &articleR{
+			CreatedByUser: related,
+		}
+	} else {
+		o.R.CreatedByUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			CreatedByArticles: ArticleSlice{o},
+		}
+	} else {
+		related.R.CreatedByArticles = append(related.R.CreatedByArticles, o)
+	}
+
+	return nil
+}
+
+// RemoveCreatedByUser relationship.
+// Sets o.R.CreatedByUser to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Article) RemoveCreatedByUser(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.CreatedBy, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("created_by")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.CreatedByUser = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.CreatedByArticles {
+		if queries.Equal(o.CreatedBy, ri.CreatedBy) {
+			continue
+		}
+
+		ln := len(related.R.CreatedByArticles)
+		if ln > 1 && i < ln-1 {
+			related.R.CreatedByArticles[i] = related.R.CreatedByArticles[ln-1]
+		}
+		related.R.CreatedByArticles = related.R.CreatedByArticles[:ln-1]
+		break
+	}
+	return nil
+}
+
+// AddArticleAuthors adds the given related objects to the existing relationships
+// of the article, optionally inserting them as new records.
+// Appends related to o.R.ArticleAuthors.
+// Sets related.R.Article appropriately.
+func (o *Article) AddArticleAuthors(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ArticleAuthor) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ArticleID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `article_authors` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"article_id"}),
+				strmangle.WhereClause("`", "`", 0, articleAuthorPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ArticleID, rel.AuthorID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ArticleID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &articleR{
+			ArticleAuthors: related,
+		}
+	} else {
+		o.R.ArticleAuthors = append(o.R.ArticleAuthors, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &articleAuthorR{
+				Article: o,
+			}
+		} else {
+			rel.R.Article = o
+		}
+	}
+	return nil
+}
+
+// AddArticleRevisions adds the given related objects to the existing relationships
+// of the article, optionally inserting them as new records.
+// Appends related to o.R.ArticleRevisions.
+// Sets related.R.Article appropriately.
+func (o *Article) AddArticleRevisions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ArticleRevision) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ArticleID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `article_revisions` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"article_id"}),
+				strmangle.WhereClause("`", "`", 0, articleRevisionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ArticleID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &articleR{
+			ArticleRevisions: related,
+		}
+	} else {
+		o.R.ArticleRevisions = append(o.R.ArticleRevisions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &articleRevisionR{
+				Article: o,
+			}
+		} else {
+			rel.R.Article = o
+		}
+	}
+	return nil
+}
+
+// AddArticleSlugHistories adds the given related objects to the existing relationships
+// of the article, optionally inserting them as new records.
+// Appends related to o.R.ArticleSlugHistories.
+// Sets related.R.Article appropriately.
+func (o *Article) AddArticleSlugHistories(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ArticleSlugHistory) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ArticleID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `article_slug_histories` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"article_id"}),
+				strmangle.WhereClause("`", "`", 0, articleSlugHistoryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.Slug}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ArticleID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &articleR{
+			ArticleSlugHistories: related,
+		}
+	} else {
+		o.R.ArticleSlugHistories = append(o.R.ArticleSlugHistories, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &articleSlugHistoryR{
+				Article: o,
+			}
+		} else {
+			rel.R.Article = o
+		}
+	}
+	return nil
+}
+
 // AddTaggings adds the given related objects to the existing relationships
 // of the article, optionally inserting them as new records.
 // Appends related to o.R.Taggings.
@@ -1133,6 +1964,7 @@ func (o ArticleSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor,
 
 var mySQLArticleUniqueColumns = []string{
 	"id",
+	"slug",
 }
 
 // Upsert attempts an insert using an executor, and does an update or ignore on conflict.
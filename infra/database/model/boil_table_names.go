@@ -4,13 +4,29 @@
 package model
 
 var TableNames = struct {
-	Articles   string
-	Categories string
-	Taggings   string
-	Tags       string
+	APIKeys              string
+	ArticleAuthors       string
+	ArticleRevisions     string
+	ArticleSlugHistories string
+	Articles             string
+	Authors              string
+	Categories           string
+	RevokedTokens        string
+	TagAliases           string
+	Taggings             string
+	Tags                 string
+	Users                string
 }{
-	Articles:   "articles",
-	Categories: "categories",
-	Taggings:   "taggings",
-	Tags:       "tags",
+	APIKeys:              "api_keys",
+	ArticleAuthors:       "article_authors",
+	ArticleRevisions:     "article_revisions",
+	ArticleSlugHistories: "article_slug_histories",
+	Articles:             "articles",
+	Authors:              "authors",
+	Categories:           "categories",
+	RevokedTokens:        "revoked_tokens",
+	TagAliases:           "tag_aliases",
+	Taggings:             "taggings",
+	Tags:                 "tags",
+	Users:                "users",
 }
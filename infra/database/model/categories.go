@@ -24,11 +24,16 @@ import (
 
 // Category is an object representing the database table.
 type Category struct {
-	ID           string    `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Name         string    `boil:"name" json:"name" toml:"name" yaml:"name"`
-	DisplayOrder null.Int  `boil:"display_order" json:"display_order,omitempty" toml:"display_order" yaml:"display_order,omitempty"`
-	CreatedAt    time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt    time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	ID           string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Name         string      `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Slug         string      `boil:"slug" json:"slug" toml:"slug" yaml:"slug"`
+	Description  string      `boil:"description" json:"description" toml:"description" yaml:"description"`
+	DisplayOrder null.Int    `boil:"display_order" json:"display_order,omitempty" toml:"display_order" yaml:"display_order,omitempty"`
+	CreatedAt    time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt    time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	DeletedAt    null.Time   `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	Version      int         `boil:"version" json:"version" toml:"version" yaml:"version"`
+	ParentID     null.String `boil:"parent_id" json:"parent_id,omitempty" toml:"parent_id" yaml:"parent_id,omitempty"`
 
 	R *categoryR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L categoryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -37,29 +42,49 @@ type Category struct {
 var CategoryColumns = struct {
 	ID           string
 	Name         string
+	Slug         string
+	Description  string
 	DisplayOrder string
 	CreatedAt    string
 	UpdatedAt    string
+	DeletedAt    string
+	Version      string
+	ParentID     string
 }{
 	ID:           "id",
 	Name:         "name",
+	Slug:         "slug",
+	Description:  "description",
 	DisplayOrder: "display_order",
 	CreatedAt:    "created_at",
 	UpdatedAt:    "updated_at",
+	DeletedAt:    "deleted_at",
+	Version:      "version",
+	ParentID:     "parent_id",
 }
 
 var CategoryTableColumns = struct {
 	ID           string
 	Name         string
+	Slug         string
+	Description  string
 	DisplayOrder string
 	CreatedAt    string
 	UpdatedAt    string
+	DeletedAt    string
+	Version      string
+	ParentID     string
 }{
 	ID:           "categories.id",
 	Name:         "categories.name",
+	Slug:         "categories.slug",
+	Description:  "categories.description",
 	DisplayOrder: "categories.display_order",
 	CreatedAt:    "categories.created_at",
 	UpdatedAt:    "categories.updated_at",
+	DeletedAt:    "categories.deleted_at",
+	Version:      "categories.version",
+	ParentID:     "categories.parent_id",
 }
 
 // Generated where
@@ -105,15 +130,25 @@ func (w whereHelpernull_Int) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNo
 var CategoryWhere = struct {
 	ID           whereHelperstring
 	Name         whereHelperstring
+	Slug         whereHelperstring
+	Description  whereHelperstring
 	DisplayOrder whereHelpernull_Int
 	CreatedAt    whereHelpertime_Time
 	UpdatedAt    whereHelpertime_Time
+	DeletedAt    whereHelpernull_Time
+	Version      whereHelperint
+	ParentID     whereHelpernull_String
 }{
 	ID:           whereHelperstring{field: "`categories`.`id`"},
 	Name:         whereHelperstring{field: "`categories`.`name`"},
+	Slug:         whereHelperstring{field: "`categories`.`slug`"},
+	Description:  whereHelperstring{field: "`categories`.`description`"},
 	DisplayOrder: whereHelpernull_Int{field: "`categories`.`display_order`"},
 	CreatedAt:    whereHelpertime_Time{field: "`categories`.`created_at`"},
 	UpdatedAt:    whereHelpertime_Time{field: "`categories`.`updated_at`"},
+	DeletedAt:    whereHelpernull_Time{field: "`categories`.`deleted_at`"},
+	Version:      whereHelperint{field: "`categories`.`version`"},
+	ParentID:     whereHelpernull_String{field: "`categories`.`parent_id`"},
 }
 
 // CategoryRels is where relationship names are stored.
@@ -144,9 +179,9 @@ func (r *categoryR) GetArticles() ArticleSlice {
 type categoryL struct{}
 
 var (
-	categoryAllColumns            = []string{"id", "name", "display_order", "created_at", "updated_at"}
-	categoryColumnsWithoutDefault = []string{"id", "name"}
-	categoryColumnsWithDefault    = []string{"display_order", "created_at", "updated_at"}
+	categoryAllColumns            = []string{"id", "name", "slug", "description", "display_order", "created_at", "updated_at", "deleted_at", "version", "parent_id"}
+	categoryColumnsWithoutDefault = []string{"id", "name", "slug", "description", "deleted_at", "parent_id"}
+	categoryColumnsWithDefault    = []string{"display_order", "created_at", "updated_at", "version"}
 	categoryPrimaryKeyColumns     = []string{"id"}
 	categoryGeneratedColumns      = []string{}
 )
@@ -893,6 +928,7 @@ func (o CategorySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor,
 var mySQLCategoryUniqueColumns = []string{
 	"id",
 	"name",
+	"slug",
 }
 
 // Upsert attempts an insert using an executor, and does an update or ignore on conflict.
@@ -0,0 +1,954 @@
+// Code generated by SQLBoiler 4.15.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// RevokedToken is an object representing the database table.
+type RevokedToken struct {
+	TokenID   string    `boil:"token_id" json:"token_id" toml:"token_id" yaml:"token_id"`
+	ExpiresAt time.Time `boil:"expires_at" json:"expires_at" toml:"expires_at" yaml:"expires_at"`
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *revokedTokenR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L revokedTokenL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var RevokedTokenColumns = struct {
+	TokenID   string
+	ExpiresAt string
+	CreatedAt string
+}{
+	TokenID:   "token_id",
+	ExpiresAt: "expires_at",
+	CreatedAt: "created_at",
+}
+
+var RevokedTokenTableColumns = struct {
+	TokenID   string
+	ExpiresAt string
+	CreatedAt string
+}{
+	TokenID:   "revoked_tokens.token_id",
+	ExpiresAt: "revoked_tokens.expires_at",
+	CreatedAt: "revoked_tokens.created_at",
+}
+
+// Generated where
+
+var RevokedTokenWhere = struct {
+	TokenID   whereHelperstring
+	ExpiresAt whereHelpertime_Time
+	CreatedAt whereHelpertime_Time
+}{
+	TokenID:   whereHelperstring{field: "`revoked_tokens`.`token_id`"},
+	ExpiresAt: whereHelpertime_Time{field: "`revoked_tokens`.`expires_at`"},
+	CreatedAt: whereHelpertime_Time{field: "`revoked_tokens`.`created_at`"},
+}
+
+// RevokedTokenRels is where relationship names are stored.
+var RevokedTokenRels = struct {
+}{}
+
+// revokedTokenR is where relationships are stored.
+type revokedTokenR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*revokedTokenR) NewStruct() *revokedTokenR {
+	return &revokedTokenR{}
+}
+
+// revokedTokenL is where Load methods for each relationship are stored.
+type revokedTokenL struct{}
+
+var (
+	revokedTokenAllColumns            = []string{"token_id", "expires_at", "created_at"}
+	revokedTokenColumnsWithoutDefault = []string{"token_id", "expires_at"}
+	revokedTokenColumnsWithDefault    = []string{"created_at"}
+	revokedTokenPrimaryKeyColumns     = []string{"token_id"}
+	revokedTokenGeneratedColumns      = []string{}
+)
+
+type (
+	// RevokedTokenSlice is an alias for a slice of pointers to RevokedToken.
+	// This should almost always be used instead of []RevokedToken.
+	RevokedTokenSlice []*RevokedToken
+	// RevokedTokenHook is the signature for custom RevokedToken hook methods
+	RevokedTokenHook func(context.Context, boil.ContextExecutor, *RevokedToken) error
+
+	revokedTokenQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	revokedTokenType                 = reflect.TypeOf(&RevokedToken{})
+	revokedTokenMapping              = queries.MakeStructMapping(revokedTokenType)
+	revokedTokenPrimaryKeyMapping, _ = queries.BindMapping(revokedTokenType, revokedTokenMapping, revokedTokenPrimaryKeyColumns)
+	revokedTokenInsertCacheMut       sync.RWMutex
+	revokedTokenInsertCache          = make(map[string]insertCache)
+	revokedTokenUpdateCacheMut       sync.RWMutex
+	revokedTokenUpdateCache          = make(map[string]updateCache)
+	revokedTokenUpsertCacheMut       sync.RWMutex
+	revokedTokenUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var revokedTokenAfterSelectHooks []RevokedTokenHook
+
+var revokedTokenBeforeInsertHooks []RevokedTokenHook
+var revokedTokenAfterInsertHooks []RevokedTokenHook
+
+var revokedTokenBeforeUpdateHooks []RevokedTokenHook
+var revokedTokenAfterUpdateHooks []RevokedTokenHook
+
+var revokedTokenBeforeDeleteHooks []RevokedTokenHook
+var revokedTokenAfterDeleteHooks []RevokedTokenHook
+
+var revokedTokenBeforeUpsertHooks []RevokedTokenHook
+var revokedTokenAfterUpsertHooks []RevokedTokenHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *RevokedToken) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range revokedTokenAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *RevokedToken) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range revokedTokenBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *RevokedToken) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range revokedTokenAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *RevokedToken) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range revokedTokenBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *RevokedToken) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range revokedTokenAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *RevokedToken) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range revokedTokenBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *RevokedToken) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range revokedTokenAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *RevokedToken) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range revokedTokenBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *RevokedToken) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range revokedTokenAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddRevokedTokenHook registers your hook function for all future operations.
+func AddRevokedTokenHook(hookPoint boil.HookPoint, revokedTokenHook RevokedTokenHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		revokedTokenAfterSelectHooks = append(revokedTokenAfterSelectHooks, revokedTokenHook)
+	case boil.BeforeInsertHook:
+		revokedTokenBeforeInsertHooks = append(revokedTokenBeforeInsertHooks, revokedTokenHook)
+	case boil.AfterInsertHook:
+		revokedTokenAfterInsertHooks = append(revokedTokenAfterInsertHooks, revokedTokenHook)
+	case boil.BeforeUpdateHook:
+		revokedTokenBeforeUpdateHooks = append(revokedTokenBeforeUpdateHooks, revokedTokenHook)
+	case boil.AfterUpdateHook:
+		revokedTokenAfterUpdateHooks = append(revokedTokenAfterUpdateHooks, revokedTokenHook)
+	case boil.BeforeDeleteHook:
+		revokedTokenBeforeDeleteHooks = append(revokedTokenBeforeDeleteHooks, revokedTokenHook)
+	case boil.AfterDeleteHook:
+		revokedTokenAfterDeleteHooks = append(revokedTokenAfterDeleteHooks, revokedTokenHook)
+	case boil.BeforeUpsertHook:
+		revokedTokenBeforeUpsertHooks = append(revokedTokenBeforeUpsertHooks, revokedTokenHook)
+	case boil.AfterUpsertHook:
+		revokedTokenAfterUpsertHooks = append(revokedTokenAfterUpsertHooks, revokedTokenHook)
+	}
+}
+
+// One returns a single revokedToken record from the query.
+func (q revokedTokenQuery) One(ctx context.Context, exec boil.ContextExecutor) (*RevokedToken, error) {
+	o := &RevokedToken{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "model: failed to execute a one query for revoked_tokens")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all RevokedToken records from the query.
+func (q revokedTokenQuery) All(ctx context.Context, exec boil.ContextExecutor) (RevokedTokenSlice, error) {
+	var o []*RevokedToken
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "model: failed to assign all query results to RevokedToken slice")
+	}
+
+	if len(revokedTokenAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all RevokedToken records in the query.
+func (q revokedTokenQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "model: failed to count revoked_tokens rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q revokedTokenQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "model: failed to check if revoked_tokens exists")
+	}
+
+	return count > 0, nil
+}
+
+// RevokedTokens retrieves all the records using an executor.
+func RevokedTokens(mods ...qm.QueryMod) revokedTokenQuery {
+	mods = append(mods, qm.From("`revoked_tokens`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`revoked_tokens`.*"})
+	}
+
+	return revokedTokenQuery{q}
+}
+
+// FindRevokedToken retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindRevokedToken(ctx context.Context, exec boil.ContextExecutor, tokenID string, selectCols ...string) (*RevokedToken, error) {
+	revokedTokenObj := &RevokedToken{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `revoked_tokens` where `token_id`=?", sel,
+	)
+
+	q := queries.Raw(query, tokenID)
+
+	err := q.Bind(ctx, exec, revokedTokenObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "model: unable to select from revoked_tokens")
+	}
+
+	if err = revokedTokenObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return revokedTokenObj, err
+	}
+
+	return revokedTokenObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *RevokedToken) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("model: no revoked_tokens provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(revokedTokenColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	revokedTokenInsertCacheMut.RLock()
+	cache, cached := revokedTokenInsertCache[key]
+	revokedTokenInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			revokedTokenAllColumns,
+			revokedTokenColumnsWithDefault,
+			revokedTokenColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(revokedTokenType, revokedTokenMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(revokedTokenType, revokedTokenMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `revoked_tokens` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `revoked_tokens` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `revoked_tokens` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, revokedTokenPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "model: unable to insert into revoked_tokens")
+	}
+
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.TokenID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to populate default values for revoked_tokens")
+	}
+
+CacheNoHooks:
+	if !cached {
+		revokedTokenInsertCacheMut.Lock()
+		revokedTokenInsertCache[key] = cache
+		revokedTokenInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the RevokedToken.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *RevokedToken) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	revokedTokenUpdateCacheMut.RLock()
+	cache, cached := revokedTokenUpdateCache[key]
+	revokedTokenUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			revokedTokenAllColumns,
+			revokedTokenPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("model: unable to update revoked_tokens, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `revoked_tokens` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, revokedTokenPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(revokedTokenType, revokedTokenMapping, append(wl, revokedTokenPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "model: unable to update revoked_tokens row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "model: failed to get rows affected by update for revoked_tokens")
+	}
+
+	if !cached {
+		revokedTokenUpdateCacheMut.Lock()
+		revokedTokenUpdateCache[key] = cache
+		revokedTokenUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q revokedTokenQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "model: unable to update all for revoked_tokens")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "model: unable to retrieve rows affected for revoked_tokens")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o RevokedTokenSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("model: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), revokedTokenPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `revoked_tokens` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, revokedTokenPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "model: unable to update all in revokedToken slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "model: unable to retrieve rows affected all in update all revokedToken")
+	}
+	return rowsAff, nil
+}
+
+var mySQLRevokedTokenUniqueColumns = []string{
+	"token_id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *RevokedToken) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("model: no revoked_tokens provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(revokedTokenColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLRevokedTokenUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	revokedTokenUpsertCacheMut.RLock()
+	cache, cached := revokedTokenUpsertCache[key]
+	revokedTokenUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			revokedTokenAllColumns,
+			revokedTokenColumnsWithDefault,
+			revokedTokenColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			revokedTokenAllColumns,
+			revokedTokenPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("model: unable to upsert revoked_tokens, could not build update column list")
+		}
+
+		ret = strmangle.SetComplement(ret, nzUniques)
+		cache.query = buildUpsertQueryMySQL(dialect, "`revoked_tokens`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `revoked_tokens` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(revokedTokenType, revokedTokenMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(revokedTokenType, revokedTokenMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "model: unable to upsert for revoked_tokens")
+	}
+
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(revokedTokenType, revokedTokenMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to retrieve unique values for revoked_tokens")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to populate default values for revoked_tokens")
+	}
+
+CacheNoHooks:
+	if !cached {
+		revokedTokenUpsertCacheMut.Lock()
+		revokedTokenUpsertCache[key] = cache
+		revokedTokenUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single RevokedToken record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *RevokedToken) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("model: no RevokedToken provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), revokedTokenPrimaryKeyMapping)
+	sql := "DELETE FROM `revoked_tokens` WHERE `token_id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "model: unable to delete from revoked_tokens")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "model: failed to get rows affected by delete for revoked_tokens")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q revokedTokenQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("model: no revokedTokenQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "model: unable to delete all from revoked_tokens")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "model: failed to get rows affected by deleteall for revoked_tokens")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o RevokedTokenSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(revokedTokenBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), revokedTokenPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `revoked_tokens` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, revokedTokenPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "model: unable to delete all from revokedToken slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "model: failed to get rows affected by deleteall for revoked_tokens")
+	}
+
+	if len(revokedTokenAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *RevokedToken) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindRevokedToken(ctx, exec, o.TokenID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *RevokedTokenSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := RevokedTokenSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), revokedTokenPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `revoked_tokens`.* FROM `revoked_tokens` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, revokedTokenPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to reload all in RevokedTokenSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// RevokedTokenExists checks if the RevokedToken row exists.
+func RevokedTokenExists(ctx context.Context, exec boil.ContextExecutor, tokenID string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `revoked_tokens` where `token_id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, tokenID)
+	}
+	row := exec.QueryRowContext(ctx, sql, tokenID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "model: unable to check if revoked_tokens exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the RevokedToken row exists.
+func (o *RevokedToken) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return RevokedTokenExists(ctx, exec, o.TokenID)
+}
@@ -0,0 +1,954 @@
+// Code generated by SQLBoiler 4.15.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// TagAlias is an object representing the database table.
+type TagAlias struct {
+	Alias     string    `boil:"alias" json:"alias" toml:"alias" yaml:"alias"`
+	TagName   string    `boil:"tag_name" json:"tag_name" toml:"tag_name" yaml:"tag_name"`
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *tagAliasR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L tagAliasL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var TagAliasColumns = struct {
+	Alias     string
+	TagName   string
+	CreatedAt string
+}{
+	Alias:     "alias",
+	TagName:   "tag_name",
+	CreatedAt: "created_at",
+}
+
+var TagAliasTableColumns = struct {
+	Alias     string
+	TagName   string
+	CreatedAt string
+}{
+	Alias:     "tag_aliases.alias",
+	TagName:   "tag_aliases.tag_name",
+	CreatedAt: "tag_aliases.created_at",
+}
+
+// Generated where
+
+var TagAliasWhere = struct {
+	Alias     whereHelperstring
+	TagName   whereHelperstring
+	CreatedAt whereHelpertime_Time
+}{
+	Alias:     whereHelperstring{field: "`tag_aliases`.`alias`"},
+	TagName:   whereHelperstring{field: "`tag_aliases`.`tag_name`"},
+	CreatedAt: whereHelpertime_Time{field: "`tag_aliases`.`created_at`"},
+}
+
+// TagAliasRels is where relationship names are stored.
+var TagAliasRels = struct {
+}{}
+
+// tagAliasR is where relationships are stored.
+type tagAliasR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*tagAliasR) NewStruct() *tagAliasR {
+	return &tagAliasR{}
+}
+
+// tagAliasL is where Load methods for each relationship are stored.
+type tagAliasL struct{}
+
+var (
+	tagAliasAllColumns            = []string{"alias", "tag_name", "created_at"}
+	tagAliasColumnsWithoutDefault = []string{"alias", "tag_name"}
+	tagAliasColumnsWithDefault    = []string{"created_at"}
+	tagAliasPrimaryKeyColumns     = []string{"alias"}
+	tagAliasGeneratedColumns      = []string{}
+)
+
+type (
+	// TagAliasSlice is an alias for a slice of pointers to TagAlias.
+	// This should almost always be used instead of []TagAlias.
+	TagAliasSlice []*TagAlias
+	// TagAliasHook is the signature for custom TagAlias hook methods
+	TagAliasHook func(context.Context, boil.ContextExecutor, *TagAlias) error
+
+	tagAliasQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	tagAliasType                 = reflect.TypeOf(&TagAlias{})
+	tagAliasMapping              = queries.MakeStructMapping(tagAliasType)
+	tagAliasPrimaryKeyMapping, _ = queries.BindMapping(tagAliasType, tagAliasMapping, tagAliasPrimaryKeyColumns)
+	tagAliasInsertCacheMut       sync.RWMutex
+	tagAliasInsertCache          = make(map[string]insertCache)
+	tagAliasUpdateCacheMut       sync.RWMutex
+	tagAliasUpdateCache          = make(map[string]updateCache)
+	tagAliasUpsertCacheMut       sync.RWMutex
+	tagAliasUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var tagAliasAfterSelectHooks []TagAliasHook
+
+var tagAliasBeforeInsertHooks []TagAliasHook
+var tagAliasAfterInsertHooks []TagAliasHook
+
+var tagAliasBeforeUpdateHooks []TagAliasHook
+var tagAliasAfterUpdateHooks []TagAliasHook
+
+var tagAliasBeforeDeleteHooks []TagAliasHook
+var tagAliasAfterDeleteHooks []TagAliasHook
+
+var tagAliasBeforeUpsertHooks []TagAliasHook
+var tagAliasAfterUpsertHooks []TagAliasHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *TagAlias) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tagAliasAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *TagAlias) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tagAliasBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *TagAlias) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tagAliasAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *TagAlias) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tagAliasBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *TagAlias) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tagAliasAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *TagAlias) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tagAliasBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *TagAlias) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tagAliasAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *TagAlias) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tagAliasBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *TagAlias) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tagAliasAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddTagAliasHook registers your hook function for all future operations.
+func AddTagAliasHook(hookPoint boil.HookPoint, tagAliasHook TagAliasHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		tagAliasAfterSelectHooks = append(tagAliasAfterSelectHooks, tagAliasHook)
+	case boil.BeforeInsertHook:
+		tagAliasBeforeInsertHooks = append(tagAliasBeforeInsertHooks, tagAliasHook)
+	case boil.AfterInsertHook:
+		tagAliasAfterInsertHooks = append(tagAliasAfterInsertHooks, tagAliasHook)
+	case boil.BeforeUpdateHook:
+		tagAliasBeforeUpdateHooks = append(tagAliasBeforeUpdateHooks, tagAliasHook)
+	case boil.AfterUpdateHook:
+		tagAliasAfterUpdateHooks = append(tagAliasAfterUpdateHooks, tagAliasHook)
+	case boil.BeforeDeleteHook:
+		tagAliasBeforeDeleteHooks = append(tagAliasBeforeDeleteHooks, tagAliasHook)
+	case boil.AfterDeleteHook:
+		tagAliasAfterDeleteHooks = append(tagAliasAfterDeleteHooks, tagAliasHook)
+	case boil.BeforeUpsertHook:
+		tagAliasBeforeUpsertHooks = append(tagAliasBeforeUpsertHooks, tagAliasHook)
+	case boil.AfterUpsertHook:
+		tagAliasAfterUpsertHooks = append(tagAliasAfterUpsertHooks, tagAliasHook)
+	}
+}
+
+// One returns a single tagAlias record from the query.
+func (q tagAliasQuery) One(ctx context.Context, exec boil.ContextExecutor) (*TagAlias, error) {
+	o := &TagAlias{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "model: failed to execute a one query for tag_aliases")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all TagAlias records from the query.
+func (q tagAliasQuery) All(ctx context.Context, exec boil.ContextExecutor) (TagAliasSlice, error) {
+	var o []*TagAlias
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "model: failed to assign all query results to TagAlias slice")
+	}
+
+	if len(tagAliasAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all TagAlias records in the query.
+func (q tagAliasQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "model: failed to count tag_aliases rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q tagAliasQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "model: failed to check if tag_aliases exists")
+	}
+
+	return count > 0, nil
+}
+
+// TagAliases retrieves all the records using an executor.
+func TagAliases(mods ...qm.QueryMod) tagAliasQuery {
+	mods = append(mods, qm.From("`tag_aliases`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`tag_aliases`.*"})
+	}
+
+	return tagAliasQuery{q}
+}
+
+// FindTagAlias retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindTagAlias(ctx context.Context, exec boil.ContextExecutor, alias string, selectCols ...string) (*TagAlias, error) {
+	tagAliasObj := &TagAlias{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `tag_aliases` where `alias`=?", sel,
+	)
+
+	q := queries.Raw(query, alias)
+
+	err := q.Bind(ctx, exec, tagAliasObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "model: unable to select from tag_aliases")
+	}
+
+	if err = tagAliasObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return tagAliasObj, err
+	}
+
+	return tagAliasObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *TagAlias) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("model: no tag_aliases provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tagAliasColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	tagAliasInsertCacheMut.RLock()
+	cache, cached := tagAliasInsertCache[key]
+	tagAliasInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			tagAliasAllColumns,
+			tagAliasColumnsWithDefault,
+			tagAliasColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(tagAliasType, tagAliasMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(tagAliasType, tagAliasMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `tag_aliases` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `tag_aliases` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `tag_aliases` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, tagAliasPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "model: unable to insert into tag_aliases")
+	}
+
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.Alias,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to populate default values for tag_aliases")
+	}
+
+CacheNoHooks:
+	if !cached {
+		tagAliasInsertCacheMut.Lock()
+		tagAliasInsertCache[key] = cache
+		tagAliasInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the TagAlias.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *TagAlias) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	tagAliasUpdateCacheMut.RLock()
+	cache, cached := tagAliasUpdateCache[key]
+	tagAliasUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			tagAliasAllColumns,
+			tagAliasPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("model: unable to update tag_aliases, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `tag_aliases` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, tagAliasPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(tagAliasType, tagAliasMapping, append(wl, tagAliasPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "model: unable to update tag_aliases row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "model: failed to get rows affected by update for tag_aliases")
+	}
+
+	if !cached {
+		tagAliasUpdateCacheMut.Lock()
+		tagAliasUpdateCache[key] = cache
+		tagAliasUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q tagAliasQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "model: unable to update all for tag_aliases")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "model: unable to retrieve rows affected for tag_aliases")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o TagAliasSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("model: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tagAliasPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `tag_aliases` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tagAliasPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "model: unable to update all in tagAlias slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "model: unable to retrieve rows affected all in update all tagAlias")
+	}
+	return rowsAff, nil
+}
+
+var mySQLTagAliasUniqueColumns = []string{
+	"alias",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *TagAlias) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("model: no tag_aliases provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tagAliasColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLTagAliasUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	tagAliasUpsertCacheMut.RLock()
+	cache, cached := tagAliasUpsertCache[key]
+	tagAliasUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			tagAliasAllColumns,
+			tagAliasColumnsWithDefault,
+			tagAliasColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			tagAliasAllColumns,
+			tagAliasPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("model: unable to upsert tag_aliases, could not build update column list")
+		}
+
+		ret = strmangle.SetComplement(ret, nzUniques)
+		cache.query = buildUpsertQueryMySQL(dialect, "`tag_aliases`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `tag_aliases` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(tagAliasType, tagAliasMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(tagAliasType, tagAliasMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "model: unable to upsert for tag_aliases")
+	}
+
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(tagAliasType, tagAliasMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to retrieve unique values for tag_aliases")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to populate default values for tag_aliases")
+	}
+
+CacheNoHooks:
+	if !cached {
+		tagAliasUpsertCacheMut.Lock()
+		tagAliasUpsertCache[key] = cache
+		tagAliasUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single TagAlias record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *TagAlias) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("model: no TagAlias provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), tagAliasPrimaryKeyMapping)
+	sql := "DELETE FROM `tag_aliases` WHERE `alias`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "model: unable to delete from tag_aliases")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "model: failed to get rows affected by delete for tag_aliases")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q tagAliasQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("model: no tagAliasQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "model: unable to delete all from tag_aliases")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "model: failed to get rows affected by deleteall for tag_aliases")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o TagAliasSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(tagAliasBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tagAliasPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `tag_aliases` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tagAliasPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "model: unable to delete all from tagAlias slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "model: failed to get rows affected by deleteall for tag_aliases")
+	}
+
+	if len(tagAliasAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *TagAlias) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindTagAlias(ctx, exec, o.Alias)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TagAliasSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := TagAliasSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tagAliasPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `tag_aliases`.* FROM `tag_aliases` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tagAliasPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "model: unable to reload all in TagAliasSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// TagAliasExists checks if the TagAlias row exists.
+func TagAliasExists(ctx context.Context, exec boil.ContextExecutor, alias string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `tag_aliases` where `alias`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, alias)
+	}
+	row := exec.QueryRowContext(ctx, sql, alias)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "model: unable to check if tag_aliases exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the TagAlias row exists.
+func (o *TagAlias) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return TagAliasExists(ctx, exec, o.Alias)
+}
@@ -0,0 +1,38 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/momonoki1990/tech-blog-api/domain/repository"
+	dbModel "github.com/momonoki1990/tech-blog-api/infra/database/model"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type RevokedTokenRepository struct {
+	exec boil.ContextExecutor
+}
+
+func NewRevokedTokenRepository(exec boil.ContextExecutor) repository.RevokedTokenRepository {
+	return &RevokedTokenRepository{exec}
+}
+
+func (r *RevokedTokenRepository) Insert(ctx context.Context, tokenId string, expiresAt time.Time) (bool, error) {
+	dbRevokedToken := &dbModel.RevokedToken{TokenID: tokenId, ExpiresAt: expiresAt}
+	err := dbRevokedToken.Insert(ctx, r.exec, boil.Infer())
+	if isDuplicateEntryError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *RevokedTokenRepository) Exists(ctx context.Context, tokenId string) (bool, error) {
+	return dbModel.RevokedTokenExists(ctx, r.exec, tokenId)
+}
+
+func (r *RevokedTokenRepository) DeleteExpired(ctx context.Context, before time.Time) (int64, error) {
+	return dbModel.RevokedTokens(dbModel.RevokedTokenWhere.ExpiresAt.LT(before)).DeleteAll(ctx, r.exec)
+}
@@ -0,0 +1,32 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+	"github.com/momonoki1990/tech-blog-api/domain/repository"
+	dbModel "github.com/momonoki1990/tech-blog-api/infra/database/model"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type TagAliasRepository struct {
+	exec boil.ContextExecutor
+}
+
+func NewTagAliasRepository(exec boil.ContextExecutor) repository.TagAliasRepository {
+	return &TagAliasRepository{exec}
+}
+
+func (r *TagAliasRepository) Find(ctx context.Context) ([]*model.TagAlias, error) {
+	dbTagAliases, err := dbModel.TagAliases(qm.OrderBy(dbModel.TagAliasColumns.Alias)).All(ctx, r.exec)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+	aliases := make([]*model.TagAlias, 0, len(dbTagAliases))
+	for _, v := range dbTagAliases {
+		aliases = append(aliases, &model.TagAlias{Alias: v.Alias, TagName: v.TagName})
+	}
+	return aliases, nil
+}
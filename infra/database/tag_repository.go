@@ -0,0 +1,233 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+	"github.com/momonoki1990/tech-blog-api/domain/repository"
+	dbModel "github.com/momonoki1990/tech-blog-api/infra/database/model"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+type TagRepository struct {
+	exec boil.ContextExecutor
+}
+
+func NewTagRepository(exec boil.ContextExecutor) repository.TagRepository {
+	return &TagRepository{exec}
+}
+
+type tagUsageRow struct {
+	Name string `boil:"name"`
+	ArticleCount int `boil:"article_count"`
+	PublishedCount int `boil:"published_count"`
+}
+
+// ゴミ箱に移した記事のtaggingは外してあるので、taggingsを数えればよい
+const findTagUsagesQuery = `
+SELECT tags.name AS name, COUNT(articles.id) AS article_count,
+	COALESCE(SUM(CASE WHEN articles.status = ? THEN 1 ELSE 0 END), 0) AS published_count
+FROM tags
+LEFT JOIN taggings ON taggings.tag_name = tags.name
+LEFT JOIN articles ON articles.id = taggings.article_id AND articles.deleted_at IS NULL
+%s
+GROUP BY tags.name
+ORDER BY %s`
+
+func (r *TagRepository) Find(ctx context.Context, sortKey repository.TagSortKey) ([]*model.TagUsage, error) {
+	orderBy := "article_count DESC, tags.name ASC"
+	if sortKey == repository.SortTagsByName {
+		orderBy = "tags.name ASC"
+	}
+	var rows []*tagUsageRow
+	err := queries.Raw(fmt.Sprintf(findTagUsagesQuery, "", orderBy), model.Published.String()).Bind(ctx, r.exec, &rows)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+	tags := make([]*model.TagUsage, 0, len(rows))
+	for _, v := range rows {
+		tags = append(tags, toTagUsage(v))
+	}
+	return tags, nil
+}
+
+func (r *TagRepository) FindOneByName(ctx context.Context, name string) (*model.TagUsage, error) {
+	var rows []*tagUsageRow
+	err := queries.Raw(fmt.Sprintf(findTagUsagesQuery, "WHERE tags.name = ?", "tags.name ASC"), model.Published.String(), name).Bind(ctx, r.exec, &rows)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return toTagUsage(rows[0]), nil
+}
+
+func (r *TagRepository) Merge(ctx context.Context, from string, to string) (error) {
+	if from == to {
+		return nil
+	}
+	toTag, err := dbModel.FindTag(ctx, r.exec, to)
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+	// 照合順序が大文字・小文字などを区別しない場合、toで引くとfromのタグが見つかる（例: Go -> go）
+	if toTag != nil && toTag.Name == from {
+		return r.rename(ctx, from, to)
+	}
+	if toTag == nil {
+		toTag = &dbModel.Tag{Name: to}
+		if err := toTag.Insert(ctx, r.exec, boil.Infer()); err != nil {
+			return err
+		}
+	}
+
+	fromDbTaggings, err := dbModel.Taggings(dbModel.TaggingWhere.TagName.EQ(from)).All(ctx, r.exec)
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+	var articleIds []string
+	for _, v := range fromDbTaggings {
+		exists, err := dbModel.TaggingExists(ctx, r.exec, v.ArticleID, to)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			dbTagging := &dbModel.Tagging{ArticleID: v.ArticleID, TagName: to}
+			if err := dbTagging.Insert(ctx, r.exec, boil.Infer()); err != nil {
+				return err
+			}
+		}
+		// tagsの削除はtaggingsの処理の後で（外部キー制約に引っかかるので）
+		if _, err := v.Delete(ctx, r.exec); err != nil {
+			return err
+		}
+		articleIds = append(articleIds, v.ArticleID)
+	}
+	if _, err := dbModel.Tags(dbModel.TagWhere.Name.EQ(from)).DeleteAll(ctx, r.exec); err != nil {
+		return err
+	}
+	// 記事のタグが変わったので、編集中のクライアントが古いタグで上書きしないようにversionを上げる
+	if err := incrementArticleVersions(ctx, r.exec, articleIds); err != nil {
+		return err
+	}
+	return mergeDeletedTagNames(ctx, r.exec, from, to)
+}
+
+// 同じ行として扱われる名前には付け替えられないので、taggingを外してタグを作り直す
+func (r *TagRepository) rename(ctx context.Context, from string, to string) (error) {
+	dbTaggings, err := dbModel.Taggings(dbModel.TaggingWhere.TagName.EQ(from)).All(ctx, r.exec)
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+	if _, err := dbTaggings.DeleteAll(ctx, r.exec); err != nil {
+		return err
+	}
+	if _, err := dbModel.Tags(dbModel.TagWhere.Name.EQ(from)).DeleteAll(ctx, r.exec); err != nil {
+		return err
+	}
+	toTag := &dbModel.Tag{Name: to}
+	if err := toTag.Insert(ctx, r.exec, boil.Infer()); err != nil {
+		return err
+	}
+	var articleIds []string
+	for _, v := range dbTaggings {
+		dbTagging := &dbModel.Tagging{ArticleID: v.ArticleID, TagName: to}
+		if err := dbTagging.Insert(ctx, r.exec, boil.Infer()); err != nil {
+			return err
+		}
+		articleIds = append(articleIds, v.ArticleID)
+	}
+	if err := incrementArticleVersions(ctx, r.exec, articleIds); err != nil {
+		return err
+	}
+	return mergeDeletedTagNames(ctx, r.exec, from, to)
+}
+
+// ゴミ箱の記事は外したタグ名を持っているので、復元したときに古い名前に戻らないように付け替える
+func mergeDeletedTagNames(ctx context.Context, exec boil.ContextExecutor, from string, to string) (error) {
+	dbArticles, err := dbModel.Articles(
+		dbModel.ArticleWhere.DeletedAt.IsNotNull(),
+		dbModel.ArticleWhere.DeletedTagNames.IsNotNull(),
+	).All(ctx, exec)
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+	for _, v := range dbArticles {
+		var tagNames []string
+		if err := v.DeletedTagNames.Unmarshal(&tagNames); err != nil {
+			return err
+		}
+		merged, changed := replaceTagName(tagNames, from, to)
+		if !changed {
+			continue
+		}
+		deletedTagNames, err := json.Marshal(merged)
+		if err != nil {
+			return err
+		}
+		v.DeletedTagNames = null.JSONFrom(deletedTagNames)
+		if _, err := v.Update(ctx, exec, boil.Whitelist(dbModel.ArticleColumns.DeletedTagNames)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fromをtoに置き換える（既にtoがあればfromを取り除く）
+func replaceTagName(tagNames []string, from string, to string) ([]string, bool) {
+	replaced := []string{}
+	changed := false
+	hasTo := false
+	for _, v := range tagNames {
+		if v == to {
+			hasTo = true
+		}
+	}
+	for _, v := range tagNames {
+		if v != from {
+			replaced = append(replaced, v)
+			continue
+		}
+		changed = true
+		if !hasTo {
+			replaced = append(replaced, to)
+			hasTo = true
+		}
+	}
+	return replaced, changed
+}
+
+func incrementArticleVersions(ctx context.Context, exec boil.ContextExecutor, articleIds []string) (error) {
+	if len(articleIds) == 0 {
+		return nil
+	}
+	placeholders := strings.TrimSuffix(strings.Repeat("?,", len(articleIds)), ",")
+	query := fmt.Sprintf(
+		"UPDATE %s SET %s = %s + 1 WHERE %s IN (%s)",
+		dbModel.TableNames.Articles,
+		dbModel.ArticleColumns.Version, dbModel.ArticleColumns.Version,
+		dbModel.ArticleColumns.ID,
+		placeholders,
+	)
+	args := make([]interface{}, 0, len(articleIds))
+	for _, v := range articleIds {
+		args = append(args, v)
+	}
+	_, err := exec.ExecContext(ctx, query, args...)
+	return err
+}
+
+func toTagUsage(row *tagUsageRow) *model.TagUsage {
+	return &model.TagUsage{
+		Name: row.Name,
+		ArticleCount: row.ArticleCount,
+		PublishedCount: &row.PublishedCount,
+	}
+}
@@ -0,0 +1,218 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+	"github.com/momonoki1990/tech-blog-api/domain/repository"
+	dbModel "github.com/momonoki1990/tech-blog-api/infra/database/model"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func insertTagTestArticles(ctx context.Context, tx boil.ContextExecutor) (*model.Article, *model.Article, *model.Article) {
+	dbCategory1 := &dbModel.Category{
+		ID: "21111111-1111-1111-1111-111111111111",
+		Name: "Category1",
+		Slug: "category1",
+		DisplayOrder: null.IntFrom(99),
+	}
+	if err := dbCategory1.Insert(ctx, tx, boil.Infer()); err != nil {
+		panic(err)
+	}
+	category1, err := toCategory(dbCategory1)
+	if err != nil {
+		panic(err)
+	}
+	ar := NewArticleRepository(tx)
+	article1, err := model.NewArticle("Title1", "Content1", category1.Id, []string{"golang", "Go"}, true)
+	if err != nil {
+		panic(err)
+	}
+	article2, err := model.NewArticle("Title2", "Content2", category1.Id, []string{"golang"}, false)
+	if err != nil {
+		panic(err)
+	}
+	article3, err := model.NewArticle("Title3", "Content3", category1.Id, []string{"golang", "Rust"}, false)
+	if err != nil {
+		panic(err)
+	}
+	for _, v := range []*model.Article{article1, article2, article3} {
+		if err := ar.Insert(ctx, v); err != nil {
+			panic(err)
+		}
+	}
+	// ゴミ箱の記事は数えない
+	if err := ar.Delete(ctx, article3.Id, article3.Version); err != nil {
+		panic(err)
+	}
+	return article1, article2, article3
+}
+
+func TestTagFind(t *testing.T) {
+	db := GetTestConnection()
+	ctx := context.TODO()
+	tx := GetTestTransaction(db, ctx)
+	defer tx.Rollback()
+
+	// Prepare data
+	insertTagTestArticles(ctx, tx)
+
+	// Execute
+	r := NewTagRepository(tx)
+	byCount, err := r.Find(ctx, repository.SortTagsByCount)
+	if err != nil {
+		t.Fatalf("err of r.Find: Expected %v, but got %v", nil, err)
+	}
+	byName, err := r.Find(ctx, repository.SortTagsByName)
+	if err != nil {
+		t.Fatalf("err of r.Find: Expected %v, but got %v", nil, err)
+	}
+	found, err := r.FindOneByName(ctx, "golang")
+	if err != nil {
+		t.Fatalf("err of r.FindOneByName: Expected %v, but got %v", nil, err)
+	}
+	notFound, err := r.FindOneByName(ctx, "Rust")
+	if err != nil {
+		t.Fatalf("err of r.FindOneByName: Expected %v, but got %v", nil, err)
+	}
+
+	// Check
+	if len(byCount) != 2 || byCount[0].Name != "golang" || byCount[0].ArticleCount != 2 || byCount[0].PublishedCount == nil || *byCount[0].PublishedCount != 1 || byCount[1].Name != "Go" || byCount[1].ArticleCount != 1 {
+		t.Errorf("byCount: Expected %s, but got %v %v", "golang(2, 1 published), Go(1)", byCount[0], byCount[1:])
+	}
+	if len(byName) != 2 || byName[0].Name != "Go" || byName[1].Name != "golang" {
+		t.Errorf("byName: Expected %s, but got %v", "Go, golang", byName)
+	}
+	if found == nil || found.ArticleCount != 2 {
+		t.Errorf("found: Expected golang with %d articles, but got %v", 2, found)
+	}
+	if notFound != nil {
+		t.Errorf("notFound: Expected %v, but got %v", nil, notFound)
+	}
+}
+
+func TestTagMerge(t *testing.T) {
+	db := GetTestConnection()
+	ctx := context.TODO()
+	tx := GetTestTransaction(db, ctx)
+	defer tx.Rollback()
+
+	// Prepare data
+	article1, article2, article3 := insertTagTestArticles(ctx, tx)
+
+	// Execute
+	r := NewTagRepository(tx)
+	err := r.Merge(ctx, "golang", "Go")
+	if err != nil {
+		t.Fatalf("err of r.Merge: Expected %v, but got %v", nil, err)
+	}
+
+	// Check
+	ar := NewArticleRepository(tx)
+	merged1, err := ar.FindOneById(ctx, article1.Id)
+	if err != nil {
+		panic(err)
+	}
+	merged2, err := ar.FindOneById(ctx, article2.Id)
+	if err != nil {
+		panic(err)
+	}
+	// 既にGoが付いている記事からはgolangを外すだけ
+	if len(merged1.Tags) != 1 || merged1.Tags[0].Name != "Go" || merged1.Version != article1.Version+1 {
+		t.Errorf("merged1: Expected tags [Go] and version %d, but got %v %d", article1.Version+1, merged1.Tags, merged1.Version)
+	}
+	if len(merged2.Tags) != 1 || merged2.Tags[0].Name != "Go" {
+		t.Errorf("merged2.Tags: Expected %s, but got %v", "[Go]", merged2.Tags)
+	}
+	deleted, err := ar.FindDeleted(ctx)
+	if err != nil {
+		panic(err)
+	}
+	if len(deleted) != 1 || deleted[0].Id != article3.Id {
+		t.Fatalf("deleted: Expected %v, but got %v", article3.Id, deleted)
+	}
+	// 復元したときに付くタグ名も付け替える
+	deletedTagNames := map[string]bool{}
+	for _, v := range deleted[0].Tags {
+		deletedTagNames[v.Name] = true
+	}
+	if len(deletedTagNames) != 2 || !deletedTagNames["Go"] || !deletedTagNames["Rust"] {
+		t.Errorf("deleted[0].Tags: Expected %s, but got %v", "Go, Rust", deleted[0].Tags)
+	}
+	golang, err := r.FindOneByName(ctx, "golang")
+	if err != nil {
+		panic(err)
+	}
+	if golang != nil {
+		t.Errorf("golang: Expected %v, but got %v", nil, golang)
+	}
+	goTag, err := r.FindOneByName(ctx, "Go")
+	if err != nil {
+		panic(err)
+	}
+	if goTag == nil || goTag.ArticleCount != 2 {
+		t.Errorf("goTag: Expected Go with %d articles, but got %v", 2, goTag)
+	}
+}
+
+func TestTagMergeCaseOnly(t *testing.T) {
+	db := GetTestConnection()
+	ctx := context.TODO()
+	tx := GetTestTransaction(db, ctx)
+	defer tx.Rollback()
+
+	// Prepare data
+	article1, _, _ := insertTagTestArticles(ctx, tx)
+
+	// Execute: 大文字・小文字だけが違う名前に付け替える（照合順序によっては同じタグとして扱われる）
+	r := NewTagRepository(tx)
+	err := r.Merge(ctx, "Go", "go")
+	if err != nil {
+		t.Fatalf("err of r.Merge: Expected %v, but got %v", nil, err)
+	}
+
+	// Check
+	merged1, err := NewArticleRepository(tx).FindOneById(ctx, article1.Id)
+	if err != nil {
+		panic(err)
+	}
+	tagNames := map[string]bool{}
+	for _, v := range merged1.Tags {
+		tagNames[v.Name] = true
+	}
+	if len(tagNames) != 2 || !tagNames["go"] || !tagNames["golang"] {
+		t.Errorf("merged1.Tags: Expected %s, but got %v", "go, golang", merged1.Tags)
+	}
+}
+
+func TestTagAliasFind(t *testing.T) {
+	db := GetTestConnection()
+	ctx := context.TODO()
+	tx := GetTestTransaction(db, ctx)
+	defer tx.Rollback()
+
+	// Prepare data
+	_, err := dbModel.TagAliases().DeleteAll(ctx, tx)
+	if err != nil {
+		panic(err)
+	}
+	for _, v := range []*dbModel.TagAlias{{Alias: "golang", TagName: "Go"}, {Alias: "go", TagName: "Go"}} {
+		if err := v.Insert(ctx, tx, boil.Infer()); err != nil {
+			panic(err)
+		}
+	}
+
+	// Execute
+	aliases, err := NewTagAliasRepository(tx).Find(ctx)
+
+	// Check
+	if err != nil {
+		t.Fatalf("err of r.Find: Expected %v, but got %v", nil, err)
+	}
+	if len(aliases) != 2 || aliases[0].Alias != "go" || aliases[1].Alias != "golang" || aliases[1].TagName != "Go" {
+		t.Errorf("aliases: Expected %s, but got %v %v", "go -> Go, golang -> Go", aliases[0], aliases[1:])
+	}
+}
@@ -0,0 +1,70 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/momonoki1990/tech-blog-api/application/transaction"
+)
+
+const (
+	txMaxRetries = 3
+	txRetryInterval = 50 * time.Millisecond
+)
+
+type TxManager struct {
+	db *sql.DB
+}
+
+func NewTxManager(db *sql.DB) transaction.TxManager {
+	return &TxManager{db}
+}
+
+// デッドロック・ロック待ちタイムアウトの場合はfnごとやり直す
+func (m *TxManager) RunInTx(ctx context.Context, fn func(r *transaction.Repositories) error) (error) {
+	var err error
+	for attempt := 0; attempt <= txMaxRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(time.Duration(attempt) * txRetryInterval):
+			}
+		}
+		err = m.runInTx(ctx, fn)
+		if !isRetryableTxError(err) {
+			return err
+		}
+	}
+	return err
+}
+
+func (m *TxManager) runInTx(ctx context.Context, fn func(r *transaction.Repositories) error) (err error) {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	repos := &transaction.Repositories{
+		ArticleRepository: NewArticleRepository(tx),
+		CategoryRepository: NewCategoryRepository(tx),
+		ArticleRevisionRepository: NewArticleRevisionRepository(tx),
+		TagRepository: NewTagRepository(tx),
+		AuthorRepository: NewAuthorRepository(tx),
+	}
+	if err := fn(repos); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("%w (rollback failed: %v)", err, rbErr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,129 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/momonoki1990/tech-blog-api/application/transaction"
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+	dbModel "github.com/momonoki1990/tech-blog-api/infra/database/model"
+)
+
+func TestTxManagerRunInTxCommit(t *testing.T) {
+	db := GetTestConnection()
+	ctx := context.TODO()
+	m := NewTxManager(db)
+
+	// Prepare data
+	category, err := model.NewCategory("TxCategory1", 1)
+	if err != nil {
+		panic(err)
+	}
+	defer dbModel.Categories(dbModel.CategoryWhere.ID.EQ(category.Id.String())).DeleteAll(ctx, db)
+
+	// Execute
+	err = m.RunInTx(ctx, func(r *transaction.Repositories) error {
+		return r.CategoryRepository.Insert(ctx, category)
+	})
+
+	// Check
+	if err != nil {
+		t.Errorf("err of m.RunInTx: Expected %v, but got %v", nil, err)
+	}
+	exists, err := dbModel.CategoryExists(ctx, db, category.Id.String())
+	if err != nil {
+		panic(err)
+	}
+	if !exists {
+		t.Errorf("exists: Expected %v, but got %v", true, exists)
+	}
+}
+
+func TestTxManagerRunInTxRollback(t *testing.T) {
+	db := GetTestConnection()
+	ctx := context.TODO()
+	m := NewTxManager(db)
+
+	// Prepare data
+	category, err := model.NewCategory("TxCategory1", 1)
+	if err != nil {
+		panic(err)
+	}
+	defer dbModel.Categories(dbModel.CategoryWhere.ID.EQ(category.Id.String())).DeleteAll(ctx, db)
+
+	// Execute
+	err = m.RunInTx(ctx, func(r *transaction.Repositories) error {
+		if err := r.CategoryRepository.Insert(ctx, category); err != nil {
+			return err
+		}
+		return errors.New("Failed after insert")
+	})
+
+	// Check
+	if err == nil || err.Error() != "Failed after insert" {
+		t.Errorf("err of m.RunInTx: Expected %s, but got %v", "Failed after insert", err)
+	}
+	exists, err := dbModel.CategoryExists(ctx, db, category.Id.String())
+	if err != nil {
+		panic(err)
+	}
+	if exists {
+		t.Errorf("exists: Expected %v, but got %v", false, exists)
+	}
+}
+
+func TestTxManagerRunInTxRetry(t *testing.T) {
+	db := GetTestConnection()
+	ctx := context.TODO()
+	m := NewTxManager(db)
+
+	// Execute1 (deadlock is retried)
+	calls := 0
+	err := m.RunInTx(ctx, func(r *transaction.Repositories) error {
+		calls++
+		if calls == 1 {
+			return &mysql.MySQLError{Number: 1213, Message: "Deadlock found when trying to get lock"}
+		}
+		return nil
+	})
+
+	// Check1
+	if err != nil {
+		t.Errorf("err of m.RunInTx: Expected %v, but got %v", nil, err)
+	}
+	if calls != 2 {
+		t.Errorf("calls: Expected %d, but got %d", 2, calls)
+	}
+
+	// Execute2 (other errors are not retried)
+	calls = 0
+	err = m.RunInTx(ctx, func(r *transaction.Repositories) error {
+		calls++
+		return &mysql.MySQLError{Number: 1062, Message: "Duplicate entry"}
+	})
+
+	// Check2
+	if err == nil {
+		t.Errorf("err of m.RunInTx: Expected %s, but got %v", "not nil", err)
+	}
+	if calls != 1 {
+		t.Errorf("calls: Expected %d, but got %d", 1, calls)
+	}
+
+	// Execute3 (gives up after max retries)
+	calls = 0
+	err = m.RunInTx(ctx, func(r *transaction.Repositories) error {
+		calls++
+		return &mysql.MySQLError{Number: 1205, Message: "Lock wait timeout exceeded"}
+	})
+
+	// Check3
+	if err == nil {
+		t.Errorf("err of m.RunInTx: Expected %s, but got %v", "not nil", err)
+	}
+	if calls != txMaxRetries+1 {
+		t.Errorf("calls: Expected %d, but got %d", txMaxRetries+1, calls)
+	}
+}
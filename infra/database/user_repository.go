@@ -0,0 +1,79 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/momonoki1990/tech-blog-api/domain/errs"
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+	"github.com/momonoki1990/tech-blog-api/domain/repository"
+	dbModel "github.com/momonoki1990/tech-blog-api/infra/database/model"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type UserRepository struct {
+	exec boil.ContextExecutor
+}
+
+func NewUserRepository(exec boil.ContextExecutor) repository.UserRepository {
+	return &UserRepository{exec}
+}
+
+func (r *UserRepository) FindOneByName(ctx context.Context, name string) (*model.User, error) {
+	dbUser, err := dbModel.Users(dbModel.UserWhere.Name.EQ(name)).One(ctx, r.exec)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toUser(dbUser)
+}
+
+func (r *UserRepository) FindOneById(ctx context.Context, id uuid.UUID) (*model.User, error) {
+	dbUser, err := dbModel.FindUser(ctx, r.exec, id.String())
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toUser(dbUser)
+}
+
+func (r *UserRepository) Insert(ctx context.Context, u *model.User) (error) {
+	dbUser := &dbModel.User{
+		ID: u.Id.String(),
+		Name: u.Name,
+		PasswordHash: u.PasswordHash,
+		Role: string(u.Role),
+		CreatedAt: u.CreatedAt,
+		UpdatedAt: u.UpdatedAt,
+	}
+	err := dbUser.Insert(ctx, r.exec, boil.Infer())
+	// 主キー以外の一意制約はnameだけ
+	if isDuplicateEntryError(err) {
+		return errs.NewConflict(errs.CodeUserNameConflict, "User name is already in use")
+	}
+	return err
+}
+
+func toUser(d *dbModel.User) (*model.User, error) {
+	id, err := uuid.Parse(d.ID)
+	if err != nil {
+		return nil, err
+	}
+	role, err := model.ParseRole(d.Role)
+	if err != nil {
+		return nil, err
+	}
+	return &model.User{
+		Id: id,
+		Name: d.Name,
+		PasswordHash: d.PasswordHash,
+		Role: role,
+		CreatedAt: d.CreatedAt,
+		UpdatedAt: d.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,115 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/momonoki1990/tech-blog-api/domain/errs"
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+)
+
+func TestUserInsertAndFind(t *testing.T) {
+	db := GetTestConnection()
+	ctx := context.TODO()
+	tx := GetTestTransaction(db, ctx)
+	defer tx.Rollback()
+
+	// Prepare
+	user, err := model.NewUser("admin1", "correct horse battery", model.RoleAdmin)
+	if err != nil {
+		panic(err)
+	}
+	duplicated, err := model.NewUser("admin1", "another password", model.RoleAdmin)
+	if err != nil {
+		panic(err)
+	}
+
+	// Execute
+	r := NewUserRepository(tx)
+	if err := r.Insert(ctx, user); err != nil {
+		panic(err)
+	}
+	byName, err := r.FindOneByName(ctx, "admin1")
+	if err != nil {
+		panic(err)
+	}
+	byId, err := r.FindOneById(ctx, user.Id)
+	if err != nil {
+		panic(err)
+	}
+	notFound, err := r.FindOneByName(ctx, "admin2")
+	if err != nil {
+		panic(err)
+	}
+	conflictErr := r.Insert(ctx, duplicated)
+
+	// Check
+	if byName == nil || byName.Id != user.Id {
+		t.Errorf("byName: Expected %v, but got %v", user, byName)
+	}
+	if byId == nil || byId.PasswordHash != user.PasswordHash {
+		t.Errorf("byId: Expected %v, but got %v", user, byId)
+	}
+	if notFound != nil {
+		t.Errorf("notFound: Expected %v, but got %v", nil, notFound)
+	}
+	if !errs.IsKind(conflictErr, errs.Conflict) {
+		t.Errorf("conflictErr: Expected %v, but got %v", errs.Conflict, conflictErr)
+	}
+}
+
+func TestRevokedTokenInsertAndDeleteExpired(t *testing.T) {
+	db := GetTestConnection()
+	ctx := context.TODO()
+	tx := GetTestTransaction(db, ctx)
+	defer tx.Rollback()
+
+	// Prepare
+	// 他のテストなどで残っている行より前の時刻にする
+	now := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	r := NewRevokedTokenRepository(tx)
+
+	// Execute
+	first, err := r.Insert(ctx, "11111111-1111-1111-1111-111111111111", now.Add(-time.Minute))
+	if err != nil {
+		panic(err)
+	}
+	second, err := r.Insert(ctx, "11111111-1111-1111-1111-111111111111", now.Add(-time.Minute))
+	if err != nil {
+		panic(err)
+	}
+	if _, err := r.Insert(ctx, "22222222-2222-2222-2222-222222222222", now.Add(time.Hour)); err != nil {
+		panic(err)
+	}
+	deleted, err := r.DeleteExpired(ctx, now)
+	if err != nil {
+		panic(err)
+	}
+	expiredExists, err := r.Exists(ctx, "11111111-1111-1111-1111-111111111111")
+	if err != nil {
+		panic(err)
+	}
+	activeExists, err := r.Exists(ctx, "22222222-2222-2222-2222-222222222222")
+	if err != nil {
+		panic(err)
+	}
+
+	// Check
+	if !first {
+		t.Errorf("first: Expected %v, but got %v", true, first)
+	}
+	if second {
+		t.Errorf("second: Expected %v, but got %v", false, second)
+	}
+	if deleted != 1 {
+		t.Errorf("deleted: Expected %v, but got %v", 1, deleted)
+	}
+	if expiredExists {
+		t.Errorf("expiredExists: Expected %v, but got %v", false, expiredExists)
+	}
+	if !activeExists {
+		t.Errorf("activeExists: Expected %v, but got %v", true, activeExists)
+	}
+}
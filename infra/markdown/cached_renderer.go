@@ -0,0 +1,91 @@
+package markdown
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/momonoki1990/tech-blog-api/application/render"
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+)
+
+// 変換結果を記事ごとにプロセス内でキャッシュする（最近使われていないものから捨てる）
+// 本文のハッシュも持つため、他のインスタンスで更新された記事でも古い結果は返さない
+type CachedRenderer struct {
+	converter *Converter
+	maxEntries int
+	mu sync.Mutex
+	entries map[uuid.UUID]*list.Element
+	// 先頭ほど最近使われたもの
+	order *list.List
+}
+
+type cacheEntry struct {
+	id uuid.UUID
+	contentHash [sha256.Size]byte
+	html string
+}
+
+func NewCachedRenderer(c *Converter, maxEntries int) render.ContentRenderer {
+	return &CachedRenderer{
+		converter: c,
+		maxEntries: maxEntries,
+		entries: map[uuid.UUID]*list.Element{},
+		order: list.New(),
+	}
+}
+
+func (r *CachedRenderer) Render(ctx context.Context, a *model.Article) (string, error) {
+	hash := sha256.Sum256([]byte(a.Content))
+	if html, ok := r.get(a.Id, hash); ok {
+		return html, nil
+	}
+	html, err := r.converter.Convert(a.Content)
+	if err != nil {
+		return "", err
+	}
+	r.put(&cacheEntry{id: a.Id, contentHash: hash, html: html})
+	return html, nil
+}
+
+func (r *CachedRenderer) Invalidate(id uuid.UUID) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if e, ok := r.entries[id]; ok {
+		r.order.Remove(e)
+		delete(r.entries, id)
+	}
+}
+
+func (r *CachedRenderer) get(id uuid.UUID, hash [sha256.Size]byte) (string, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	e, ok := r.entries[id]
+	if !ok {
+		return "", false
+	}
+	entry := e.Value.(*cacheEntry)
+	if entry.contentHash != hash {
+		return "", false
+	}
+	r.order.MoveToFront(e)
+	return entry.html, true
+}
+
+func (r *CachedRenderer) put(entry *cacheEntry) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if e, ok := r.entries[entry.id]; ok {
+		e.Value = entry
+		r.order.MoveToFront(e)
+		return
+	}
+	r.entries[entry.id] = r.order.PushFront(entry)
+	for r.order.Len() > r.maxEntries {
+		oldest := r.order.Back()
+		r.order.Remove(oldest)
+		delete(r.entries, oldest.Value.(*cacheEntry).id)
+	}
+}
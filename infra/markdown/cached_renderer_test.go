@@ -0,0 +1,96 @@
+package markdown
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+)
+
+func TestCachedRendererRender(t *testing.T) {
+	ctx := context.TODO()
+
+	// Prepare
+	article, err := model.NewArticle("Title1", "# Heading1", uuid.New(), []string{}, false)
+	if err != nil {
+		panic(err)
+	}
+	r := NewCachedRenderer(NewConverter(), 10).(*CachedRenderer)
+
+	// Execute1
+	first, err := r.Render(ctx, article)
+	if err != nil {
+		panic(err)
+	}
+	second, err := r.Render(ctx, article)
+	if err != nil {
+		panic(err)
+	}
+
+	// Check1
+	if first != `<h1 id="heading1">Heading1</h1>`+"\n" {
+		t.Errorf("first: Expected %q, but got %q", `<h1 id="heading1">Heading1</h1>`+"\n", first)
+	}
+	if second != first {
+		t.Errorf("second: Expected %q, but got %q", first, second)
+	}
+	if r.order.Len() != 1 {
+		t.Errorf("r.order.Len(): Expected %d, but got %d", 1, r.order.Len())
+	}
+
+	// Execute2: 本文が変わっていればキャッシュを使わない
+	article.Content = "# Heading2"
+	changed, err := r.Render(ctx, article)
+	if err != nil {
+		panic(err)
+	}
+
+	// Check2
+	if changed != `<h1 id="heading2">Heading2</h1>`+"\n" {
+		t.Errorf("changed: Expected %q, but got %q", `<h1 id="heading2">Heading2</h1>`+"\n", changed)
+	}
+
+	// Execute3
+	r.Invalidate(article.Id)
+
+	// Check3
+	if _, ok := r.entries[article.Id]; ok {
+		t.Errorf("r.entries[article.Id]: Expected to be invalidated, but exists")
+	}
+}
+
+func TestCachedRendererEviction(t *testing.T) {
+	ctx := context.TODO()
+
+	// Prepare
+	r := NewCachedRenderer(NewConverter(), 2).(*CachedRenderer)
+	var articles []*model.Article
+	for _, v := range []string{"Title1", "Title2", "Title3"} {
+		a, err := model.NewArticle(v, "Content", uuid.New(), []string{}, false)
+		if err != nil {
+			panic(err)
+		}
+		articles = append(articles, a)
+	}
+
+	// Execute: 1, 2, 1, 3 の順に使うと、最も使われていない2が捨てられる
+	for _, i := range []int{0, 1, 0, 2} {
+		if _, err := r.Render(ctx, articles[i]); err != nil {
+			panic(err)
+		}
+	}
+
+	// Check
+	if r.order.Len() != 2 {
+		t.Errorf("r.order.Len(): Expected %d, but got %d", 2, r.order.Len())
+	}
+	if _, ok := r.entries[articles[1].Id]; ok {
+		t.Errorf("entries of article2: Expected to be evicted, but exists")
+	}
+	for _, i := range []int{0, 2} {
+		if _, ok := r.entries[articles[i].Id]; !ok {
+			t.Errorf("entries of article%d: Expected to exist, but not", i+1)
+		}
+	}
+}
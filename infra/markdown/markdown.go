@@ -0,0 +1,101 @@
+package markdown
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	chromahtml "github.com/alecthomas/chroma/v2/formatters/html"
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	highlighting "github.com/yuin/goldmark-highlighting/v2"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+)
+
+// Markdownをサニタイズ済みのHTMLに変換する
+// GFM（表・取り消し線・タスクリスト・自動リンク）、脚注、見出しのid、コードブロックのハイライト（chromaのクラス）に対応する
+type Converter struct {
+	md goldmark.Markdown
+	policy *bluemonday.Policy
+}
+
+func NewConverter() *Converter {
+	md := goldmark.New(
+		goldmark.WithExtensions(
+			extension.GFM,
+			extension.Footnote,
+			highlighting.NewHighlighting(
+				highlighting.WithFormatOptions(chromahtml.WithClasses(true)),
+			),
+		),
+		goldmark.WithParserOptions(parser.WithAutoHeadingID()),
+	)
+	return &Converter{md: md, policy: newPolicy()}
+}
+
+func (c *Converter) Convert(content string) (string, error) {
+	var buf bytes.Buffer
+	ctx := parser.NewContext(parser.WithIDs(newHeadingIDs()))
+	if err := c.md.Convert([]byte(content), &buf, parser.WithContext(ctx)); err != nil {
+		return "", err
+	}
+	return c.policy.Sanitize(buf.String()), nil
+}
+
+// 生のHTMLやスクリプトは取り除き、変換結果に必要な属性だけを残す
+func newPolicy() *bluemonday.Policy {
+	p := bluemonday.UGCPolicy()
+	// 見出しと脚注のアンカー（日本語の見出しもidにする）
+	p.AllowAttrs("id").Matching(regexp.MustCompile(`^[\p{L}\p{N}_:.-]+$`)).OnElements("h1", "h2", "h3", "h4", "h5", "h6", "sup", "li")
+	// ハイライト・脚注・タスクリスト用のクラス
+	p.AllowAttrs("class").Matching(regexp.MustCompile(`^[a-zA-Z0-9 _-]+$`)).OnElements("pre", "code", "span", "div", "a")
+	p.AllowAttrs("role").Matching(regexp.MustCompile(`^doc-[a-z]+$`)).OnElements("a", "div")
+	p.AllowAttrs("type").Matching(regexp.MustCompile(`^checkbox$`)).OnElements("input")
+	p.AllowAttrs("checked", "disabled").OnElements("input")
+	return p
+}
+
+// 見出しのid（例: "## はじめに" -> "はじめに"）
+// 重複する場合は連番を付ける
+type headingIDs struct {
+	used map[string]bool
+}
+
+func newHeadingIDs() parser.IDs {
+	return &headingIDs{used: map[string]bool{}}
+}
+
+func (s *headingIDs) Generate(value []byte, kind ast.NodeKind) []byte {
+	var b strings.Builder
+	hyphen := false
+	for _, r := range strings.ToLower(string(value)) {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsNumber(r) || r == '_':
+			if hyphen && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			hyphen = false
+			b.WriteRune(r)
+		default:
+			hyphen = true
+		}
+	}
+	base := b.String()
+	if base == "" {
+		base = "section"
+	}
+	id := base
+	for n := 1; s.used[id]; n++ {
+		id = fmt.Sprintf("%s-%d", base, n)
+	}
+	s.used[id] = true
+	return []byte(id)
+}
+
+func (s *headingIDs) Put(value []byte) {
+	s.used[string(value)] = true
+}
@@ -0,0 +1,52 @@
+package markdown
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestConvert(t *testing.T) {
+	cases := []struct {
+		name string
+		content string
+		expected []string
+	}{
+		{"table", "| a | b |\n|---|---|\n| 1 | 2 |", []string{"<table>", "<th>a</th>", "<td>2</td>"}},
+		{"fenced code", "```go\npackage main\n```", []string{`<pre class="chroma">`, `<span class="kn">package</span>`}},
+		{"footnote", "本文[^1]\n\n[^1]: 脚注", []string{`<sup id="fnref:1">`, `<a href="#fn:1" class="footnote-ref" role="doc-noteref"`, `<li id="fn:1">`}},
+		{"heading", "# はじめに\n\n## Go tips\n\n## Go tips", []string{`<h1 id="はじめに">`, `<h2 id="go-tips">`, `<h2 id="go-tips-1">`}},
+	}
+	c := NewConverter()
+	for _, v := range cases {
+		// Execute
+		actual, err := c.Convert(v.content)
+		if err != nil {
+			panic(err)
+		}
+
+		// Check
+		for _, e := range v.expected {
+			if !strings.Contains(actual, e) {
+				t.Errorf("%s: Expected to contain %s, but got %s", v.name, e, actual)
+			}
+		}
+	}
+}
+
+func TestConvertSanitize(t *testing.T) {
+	// Prepare
+	content := "<script>alert(1)</script>\n\n[link](javascript:alert(1))\n\n<img src=x onerror=alert(1)>\n\n<b onclick=\"alert(1)\">bold</b>"
+
+	// Execute
+	actual, err := NewConverter().Convert(content)
+	if err != nil {
+		panic(err)
+	}
+
+	// Check
+	for _, v := range []string{"<script", "javascript:", "onerror", "onclick"} {
+		if strings.Contains(actual, v) {
+			t.Errorf("actual: Expected not to contain %s, but got %s", v, actual)
+		}
+	}
+}
@@ -9,10 +9,13 @@
 package mock_repository
 
 import (
+	context "context"
 	reflect "reflect"
+	time "time"
 
 	uuid "github.com/google/uuid"
 	model "github.com/momonoki1990/tech-blog-api/domain/model"
+	repository "github.com/momonoki1990/tech-blog-api/domain/repository"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -39,74 +42,209 @@ func (m *MockArticleRepository) EXPECT() *MockArticleRepositoryMockRecorder {
 	return m.recorder
 }
 
+// CountByCategory mocks base method.
+func (m *MockArticleRepository) CountByCategory(ctx context.Context, categoryId uuid.UUID) (*model.CategoryArticleCounts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByCategory", ctx, categoryId)
+	ret0, _ := ret[0].(*model.CategoryArticleCounts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByCategory indicates an expected call of CountByCategory.
+func (mr *MockArticleRepositoryMockRecorder) CountByCategory(ctx, categoryId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByCategory", reflect.TypeOf((*MockArticleRepository)(nil).CountByCategory), ctx, categoryId)
+}
+
+// CountPublishedByMonth mocks base method.
+func (m *MockArticleRepository) CountPublishedByMonth(ctx context.Context, loc *time.Location) ([]*model.ArchiveMonth, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountPublishedByMonth", ctx, loc)
+	ret0, _ := ret[0].([]*model.ArchiveMonth)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountPublishedByMonth indicates an expected call of CountPublishedByMonth.
+func (mr *MockArticleRepositoryMockRecorder) CountPublishedByMonth(ctx, loc any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountPublishedByMonth", reflect.TypeOf((*MockArticleRepository)(nil).CountPublishedByMonth), ctx, loc)
+}
+
 // Delete mocks base method.
-func (m *MockArticleRepository) Delete(id uuid.UUID) error {
+func (m *MockArticleRepository) Delete(ctx context.Context, id uuid.UUID, version int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", id)
+	ret := m.ctrl.Call(m, "Delete", ctx, id, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockArticleRepositoryMockRecorder) Delete(id any) *gomock.Call {
+func (mr *MockArticleRepositoryMockRecorder) Delete(ctx, id, version any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockArticleRepository)(nil).Delete), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockArticleRepository)(nil).Delete), ctx, id, version)
 }
 
 // Find mocks base method.
-func (m *MockArticleRepository) Find() ([]*model.Article, error) {
+func (m *MockArticleRepository) Find(ctx context.Context, criteria *repository.ArticleCriteria) ([]*model.Article, *repository.ArticleCursor, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Find")
+	ret := m.ctrl.Call(m, "Find", ctx, criteria)
+	ret0, _ := ret[0].([]*model.Article)
+	ret1, _ := ret[1].(*repository.ArticleCursor)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockArticleRepositoryMockRecorder) Find(ctx, criteria any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockArticleRepository)(nil).Find), ctx, criteria)
+}
+
+// FindDeleted mocks base method.
+func (m *MockArticleRepository) FindDeleted(ctx context.Context) ([]*model.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeleted", ctx)
 	ret0, _ := ret[0].([]*model.Article)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Find indicates an expected call of Find.
-func (mr *MockArticleRepositoryMockRecorder) Find() *gomock.Call {
+// FindDeleted indicates an expected call of FindDeleted.
+func (mr *MockArticleRepositoryMockRecorder) FindDeleted(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeleted", reflect.TypeOf((*MockArticleRepository)(nil).FindDeleted), ctx)
+}
+
+// FindIdBySlug mocks base method.
+func (m *MockArticleRepository) FindIdBySlug(ctx context.Context, slug string) (*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindIdBySlug", ctx, slug)
+	ret0, _ := ret[0].(*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindIdBySlug indicates an expected call of FindIdBySlug.
+func (mr *MockArticleRepositoryMockRecorder) FindIdBySlug(ctx, slug any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockArticleRepository)(nil).Find))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindIdBySlug", reflect.TypeOf((*MockArticleRepository)(nil).FindIdBySlug), ctx, slug)
 }
 
 // FindOneById mocks base method.
-func (m *MockArticleRepository) FindOneById(id uuid.UUID) (*model.Article, error) {
+func (m *MockArticleRepository) FindOneById(ctx context.Context, id uuid.UUID) (*model.Article, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindOneById", id)
+	ret := m.ctrl.Call(m, "FindOneById", ctx, id)
 	ret0, _ := ret[0].(*model.Article)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindOneById indicates an expected call of FindOneById.
-func (mr *MockArticleRepositoryMockRecorder) FindOneById(id any) *gomock.Call {
+func (mr *MockArticleRepositoryMockRecorder) FindOneById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneById", reflect.TypeOf((*MockArticleRepository)(nil).FindOneById), ctx, id)
+}
+
+// FindOneBySlug mocks base method.
+func (m *MockArticleRepository) FindOneBySlug(ctx context.Context, slug string) (*model.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOneBySlug", ctx, slug)
+	ret0, _ := ret[0].(*model.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOneBySlug indicates an expected call of FindOneBySlug.
+func (mr *MockArticleRepositoryMockRecorder) FindOneBySlug(ctx, slug any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneById", reflect.TypeOf((*MockArticleRepository)(nil).FindOneById), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneBySlug", reflect.TypeOf((*MockArticleRepository)(nil).FindOneBySlug), ctx, slug)
 }
 
 // Insert mocks base method.
-func (m *MockArticleRepository) Insert(arg0 *model.Article) error {
+func (m *MockArticleRepository) Insert(ctx context.Context, a *model.Article) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Insert", arg0)
+	ret := m.ctrl.Call(m, "Insert", ctx, a)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Insert indicates an expected call of Insert.
-func (mr *MockArticleRepositoryMockRecorder) Insert(arg0 any) *gomock.Call {
+func (mr *MockArticleRepositoryMockRecorder) Insert(ctx, a any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockArticleRepository)(nil).Insert), ctx, a)
+}
+
+// PublishScheduled mocks base method.
+func (m *MockArticleRepository) PublishScheduled(ctx context.Context, now time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishScheduled", ctx, now)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishScheduled indicates an expected call of PublishScheduled.
+func (mr *MockArticleRepositoryMockRecorder) PublishScheduled(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishScheduled", reflect.TypeOf((*MockArticleRepository)(nil).PublishScheduled), ctx, now)
+}
+
+// Purge mocks base method.
+func (m *MockArticleRepository) Purge(ctx context.Context, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", ctx, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockArticleRepositoryMockRecorder) Purge(ctx, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockArticleRepository)(nil).Purge), ctx, before)
+}
+
+// ReassignCategory mocks base method.
+func (m *MockArticleRepository) ReassignCategory(ctx context.Context, from, to uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReassignCategory", ctx, from, to)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReassignCategory indicates an expected call of ReassignCategory.
+func (mr *MockArticleRepositoryMockRecorder) ReassignCategory(ctx, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReassignCategory", reflect.TypeOf((*MockArticleRepository)(nil).ReassignCategory), ctx, from, to)
+}
+
+// Restore mocks base method.
+func (m *MockArticleRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockArticleRepositoryMockRecorder) Restore(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockArticleRepository)(nil).Insert), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockArticleRepository)(nil).Restore), ctx, id)
 }
 
 // Update mocks base method.
-func (m *MockArticleRepository) Update(arg0 *model.Article) error {
+func (m *MockArticleRepository) Update(ctx context.Context, a *model.Article) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", arg0)
+	ret := m.ctrl.Call(m, "Update", ctx, a)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockArticleRepositoryMockRecorder) Update(arg0 any) *gomock.Call {
+func (mr *MockArticleRepositoryMockRecorder) Update(ctx, a any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockArticleRepository)(nil).Update), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockArticleRepository)(nil).Update), ctx, a)
 }
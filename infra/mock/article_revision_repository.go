@@ -0,0 +1,100 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./domain/repository/article_revision_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./domain/repository/article_revision_repository.go -destination=./infra/mock/article_revision_repository.go
+//
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	model "github.com/momonoki1990/tech-blog-api/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockArticleRevisionRepository is a mock of ArticleRevisionRepository interface.
+type MockArticleRevisionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockArticleRevisionRepositoryMockRecorder
+}
+
+// MockArticleRevisionRepositoryMockRecorder is the mock recorder for MockArticleRevisionRepository.
+type MockArticleRevisionRepositoryMockRecorder struct {
+	mock *MockArticleRevisionRepository
+}
+
+// NewMockArticleRevisionRepository creates a new mock instance.
+func NewMockArticleRevisionRepository(ctrl *gomock.Controller) *MockArticleRevisionRepository {
+	mock := &MockArticleRevisionRepository{ctrl: ctrl}
+	mock.recorder = &MockArticleRevisionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockArticleRevisionRepository) EXPECT() *MockArticleRevisionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByArticleId mocks base method.
+func (m *MockArticleRevisionRepository) FindByArticleId(ctx context.Context, articleId uuid.UUID) ([]*model.ArticleRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByArticleId", ctx, articleId)
+	ret0, _ := ret[0].([]*model.ArticleRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByArticleId indicates an expected call of FindByArticleId.
+func (mr *MockArticleRevisionRepositoryMockRecorder) FindByArticleId(ctx, articleId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByArticleId", reflect.TypeOf((*MockArticleRevisionRepository)(nil).FindByArticleId), ctx, articleId)
+}
+
+// FindLatest mocks base method.
+func (m *MockArticleRevisionRepository) FindLatest(ctx context.Context, articleId uuid.UUID) (*model.ArticleRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLatest", ctx, articleId)
+	ret0, _ := ret[0].(*model.ArticleRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLatest indicates an expected call of FindLatest.
+func (mr *MockArticleRevisionRepositoryMockRecorder) FindLatest(ctx, articleId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLatest", reflect.TypeOf((*MockArticleRevisionRepository)(nil).FindLatest), ctx, articleId)
+}
+
+// FindOne mocks base method.
+func (m *MockArticleRevisionRepository) FindOne(ctx context.Context, articleId uuid.UUID, number int) (*model.ArticleRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOne", ctx, articleId, number)
+	ret0, _ := ret[0].(*model.ArticleRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOne indicates an expected call of FindOne.
+func (mr *MockArticleRevisionRepositoryMockRecorder) FindOne(ctx, articleId, number any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOne", reflect.TypeOf((*MockArticleRevisionRepository)(nil).FindOne), ctx, articleId, number)
+}
+
+// Insert mocks base method.
+func (m *MockArticleRevisionRepository) Insert(ctx context.Context, r *model.ArticleRevision) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, r)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockArticleRevisionRepositoryMockRecorder) Insert(ctx, r any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockArticleRevisionRepository)(nil).Insert), ctx, r)
+}
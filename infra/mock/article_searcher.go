@@ -0,0 +1,55 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./domain/repository/article_searcher.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./domain/repository/article_searcher.go -destination=./infra/mock/article_searcher.go
+//
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	repository "github.com/momonoki1990/tech-blog-api/domain/repository"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockArticleSearcher is a mock of ArticleSearcher interface.
+type MockArticleSearcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockArticleSearcherMockRecorder
+}
+
+// MockArticleSearcherMockRecorder is the mock recorder for MockArticleSearcher.
+type MockArticleSearcherMockRecorder struct {
+	mock *MockArticleSearcher
+}
+
+// NewMockArticleSearcher creates a new mock instance.
+func NewMockArticleSearcher(ctrl *gomock.Controller) *MockArticleSearcher {
+	mock := &MockArticleSearcher{ctrl: ctrl}
+	mock.recorder = &MockArticleSearcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockArticleSearcher) EXPECT() *MockArticleSearcherMockRecorder {
+	return m.recorder
+}
+
+// Search mocks base method.
+func (m *MockArticleSearcher) Search(ctx context.Context, query string, limit int) ([]*repository.ArticleHit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, query, limit)
+	ret0, _ := ret[0].([]*repository.ArticleHit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockArticleSearcherMockRecorder) Search(ctx, query, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockArticleSearcher)(nil).Search), ctx, query, limit)
+}
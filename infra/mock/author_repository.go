@@ -0,0 +1,115 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./domain/repository/author_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./domain/repository/author_repository.go -destination=./infra/mock/author_repository.go
+//
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	model "github.com/momonoki1990/tech-blog-api/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAuthorRepository is a mock of AuthorRepository interface.
+type MockAuthorRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthorRepositoryMockRecorder
+}
+
+// MockAuthorRepositoryMockRecorder is the mock recorder for MockAuthorRepository.
+type MockAuthorRepositoryMockRecorder struct {
+	mock *MockAuthorRepository
+}
+
+// NewMockAuthorRepository creates a new mock instance.
+func NewMockAuthorRepository(ctrl *gomock.Controller) *MockAuthorRepository {
+	mock := &MockAuthorRepository{ctrl: ctrl}
+	mock.recorder = &MockAuthorRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthorRepository) EXPECT() *MockAuthorRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Find mocks base method.
+func (m *MockAuthorRepository) Find(ctx context.Context) ([]*model.Author, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx)
+	ret0, _ := ret[0].([]*model.Author)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockAuthorRepositoryMockRecorder) Find(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockAuthorRepository)(nil).Find), ctx)
+}
+
+// FindBySlugs mocks base method.
+func (m *MockAuthorRepository) FindBySlugs(ctx context.Context, slugs []string) ([]*model.Author, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBySlugs", ctx, slugs)
+	ret0, _ := ret[0].([]*model.Author)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBySlugs indicates an expected call of FindBySlugs.
+func (mr *MockAuthorRepositoryMockRecorder) FindBySlugs(ctx, slugs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySlugs", reflect.TypeOf((*MockAuthorRepository)(nil).FindBySlugs), ctx, slugs)
+}
+
+// FindOneBySlug mocks base method.
+func (m *MockAuthorRepository) FindOneBySlug(ctx context.Context, slug string) (*model.Author, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOneBySlug", ctx, slug)
+	ret0, _ := ret[0].(*model.Author)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOneBySlug indicates an expected call of FindOneBySlug.
+func (mr *MockAuthorRepositoryMockRecorder) FindOneBySlug(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneBySlug", reflect.TypeOf((*MockAuthorRepository)(nil).FindOneBySlug), ctx, slug)
+}
+
+// FindOneByUserId mocks base method.
+func (m *MockAuthorRepository) FindOneByUserId(ctx context.Context, userId uuid.UUID) (*model.Author, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOneByUserId", ctx, userId)
+	ret0, _ := ret[0].(*model.Author)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOneByUserId indicates an expected call of FindOneByUserId.
+func (mr *MockAuthorRepositoryMockRecorder) FindOneByUserId(ctx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByUserId", reflect.TypeOf((*MockAuthorRepository)(nil).FindOneByUserId), ctx, userId)
+}
+
+// Insert mocks base method.
+func (m *MockAuthorRepository) Insert(ctx context.Context, a *model.Author) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, a)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockAuthorRepositoryMockRecorder) Insert(ctx, a any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockAuthorRepository)(nil).Insert), ctx, a)
+}
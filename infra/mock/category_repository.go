@@ -9,7 +9,9 @@
 package mock_repository
 
 import (
+	context "context"
 	reflect "reflect"
+	time "time"
 
 	uuid "github.com/google/uuid"
 	model "github.com/momonoki1990/tech-blog-api/domain/model"
@@ -40,88 +42,192 @@ func (m *MockCategoryRepository) EXPECT() *MockCategoryRepositoryMockRecorder {
 }
 
 // Delete mocks base method.
-func (m *MockCategoryRepository) Delete(id uuid.UUID) error {
+func (m *MockCategoryRepository) Delete(ctx context.Context, id uuid.UUID, version int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", id)
+	ret := m.ctrl.Call(m, "Delete", ctx, id, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockCategoryRepositoryMockRecorder) Delete(id any) *gomock.Call {
+func (mr *MockCategoryRepositoryMockRecorder) Delete(ctx, id, version any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCategoryRepository)(nil).Delete), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCategoryRepository)(nil).Delete), ctx, id, version)
 }
 
 // Find mocks base method.
-func (m *MockCategoryRepository) Find() ([]*model.Category, error) {
+func (m *MockCategoryRepository) Find(ctx context.Context) ([]*model.Category, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Find")
+	ret := m.ctrl.Call(m, "Find", ctx)
 	ret0, _ := ret[0].([]*model.Category)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Find indicates an expected call of Find.
-func (mr *MockCategoryRepositoryMockRecorder) Find() *gomock.Call {
+func (mr *MockCategoryRepositoryMockRecorder) Find(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockCategoryRepository)(nil).Find))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockCategoryRepository)(nil).Find), ctx)
+}
+
+// FindDeleted mocks base method.
+func (m *MockCategoryRepository) FindDeleted(ctx context.Context) ([]*model.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeleted", ctx)
+	ret0, _ := ret[0].([]*model.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDeleted indicates an expected call of FindDeleted.
+func (mr *MockCategoryRepositoryMockRecorder) FindDeleted(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeleted", reflect.TypeOf((*MockCategoryRepository)(nil).FindDeleted), ctx)
+}
+
+// FindForUpdate mocks base method.
+func (m *MockCategoryRepository) FindForUpdate(ctx context.Context) ([]*model.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindForUpdate", ctx)
+	ret0, _ := ret[0].([]*model.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindForUpdate indicates an expected call of FindForUpdate.
+func (mr *MockCategoryRepositoryMockRecorder) FindForUpdate(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindForUpdate", reflect.TypeOf((*MockCategoryRepository)(nil).FindForUpdate), ctx)
+}
+
+// FindIdByName mocks base method.
+func (m *MockCategoryRepository) FindIdByName(ctx context.Context, name string) (*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindIdByName", ctx, name)
+	ret0, _ := ret[0].(*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindIdByName indicates an expected call of FindIdByName.
+func (mr *MockCategoryRepositoryMockRecorder) FindIdByName(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindIdByName", reflect.TypeOf((*MockCategoryRepository)(nil).FindIdByName), ctx, name)
+}
+
+// FindIdBySlug mocks base method.
+func (m *MockCategoryRepository) FindIdBySlug(ctx context.Context, slug string) (*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindIdBySlug", ctx, slug)
+	ret0, _ := ret[0].(*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindIdBySlug indicates an expected call of FindIdBySlug.
+func (mr *MockCategoryRepositoryMockRecorder) FindIdBySlug(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindIdBySlug", reflect.TypeOf((*MockCategoryRepository)(nil).FindIdBySlug), ctx, slug)
 }
 
 // FindOneById mocks base method.
-func (m *MockCategoryRepository) FindOneById(id uuid.UUID) (*model.Category, error) {
+func (m *MockCategoryRepository) FindOneById(ctx context.Context, id uuid.UUID) (*model.Category, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindOneById", id)
+	ret := m.ctrl.Call(m, "FindOneById", ctx, id)
 	ret0, _ := ret[0].(*model.Category)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindOneById indicates an expected call of FindOneById.
-func (mr *MockCategoryRepositoryMockRecorder) FindOneById(id any) *gomock.Call {
+func (mr *MockCategoryRepositoryMockRecorder) FindOneById(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneById", reflect.TypeOf((*MockCategoryRepository)(nil).FindOneById), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneById", reflect.TypeOf((*MockCategoryRepository)(nil).FindOneById), ctx, id)
 }
 
-// FindOneByName mocks base method.
-func (m *MockCategoryRepository) FindOneByName(name string) (*model.Category, error) {
+// FindOneByIdForUpdate mocks base method.
+func (m *MockCategoryRepository) FindOneByIdForUpdate(ctx context.Context, id uuid.UUID) (*model.Category, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindOneByName", name)
+	ret := m.ctrl.Call(m, "FindOneByIdForUpdate", ctx, id)
 	ret0, _ := ret[0].(*model.Category)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FindOneByName indicates an expected call of FindOneByName.
-func (mr *MockCategoryRepositoryMockRecorder) FindOneByName(name any) *gomock.Call {
+// FindOneByIdForUpdate indicates an expected call of FindOneByIdForUpdate.
+func (mr *MockCategoryRepositoryMockRecorder) FindOneByIdForUpdate(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByName", reflect.TypeOf((*MockCategoryRepository)(nil).FindOneByName), name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByIdForUpdate", reflect.TypeOf((*MockCategoryRepository)(nil).FindOneByIdForUpdate), ctx, id)
+}
+
+// FindStats mocks base method.
+func (m *MockCategoryRepository) FindStats(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*model.CategoryStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindStats", ctx, ids)
+	ret0, _ := ret[0].(map[uuid.UUID]*model.CategoryStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindStats indicates an expected call of FindStats.
+func (mr *MockCategoryRepositoryMockRecorder) FindStats(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindStats", reflect.TypeOf((*MockCategoryRepository)(nil).FindStats), ctx, ids)
 }
 
 // Insert mocks base method.
-func (m *MockCategoryRepository) Insert(arg0 *model.Category) error {
+func (m *MockCategoryRepository) Insert(ctx context.Context, c *model.Category) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Insert", arg0)
+	ret := m.ctrl.Call(m, "Insert", ctx, c)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Insert indicates an expected call of Insert.
-func (mr *MockCategoryRepositoryMockRecorder) Insert(arg0 any) *gomock.Call {
+func (mr *MockCategoryRepositoryMockRecorder) Insert(ctx, c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockCategoryRepository)(nil).Insert), ctx, c)
+}
+
+// Purge mocks base method.
+func (m *MockCategoryRepository) Purge(ctx context.Context, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", ctx, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockCategoryRepositoryMockRecorder) Purge(ctx, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockCategoryRepository)(nil).Purge), ctx, before)
+}
+
+// Restore mocks base method.
+func (m *MockCategoryRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockCategoryRepositoryMockRecorder) Restore(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockCategoryRepository)(nil).Insert), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockCategoryRepository)(nil).Restore), ctx, id)
 }
 
 // Update mocks base method.
-func (m *MockCategoryRepository) Update(arg0 *model.Category) error {
+func (m *MockCategoryRepository) Update(ctx context.Context, c *model.Category) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", arg0)
+	ret := m.ctrl.Call(m, "Update", ctx, c)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockCategoryRepositoryMockRecorder) Update(arg0 any) *gomock.Call {
+func (mr *MockCategoryRepositoryMockRecorder) Update(ctx, c any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCategoryRepository)(nil).Update), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCategoryRepository)(nil).Update), ctx, c)
 }
@@ -0,0 +1,55 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./domain/repository/tag_alias_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./domain/repository/tag_alias_repository.go -destination=./infra/mock/tag_alias_repository.go
+//
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/momonoki1990/tech-blog-api/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTagAliasRepository is a mock of TagAliasRepository interface.
+type MockTagAliasRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTagAliasRepositoryMockRecorder
+}
+
+// MockTagAliasRepositoryMockRecorder is the mock recorder for MockTagAliasRepository.
+type MockTagAliasRepositoryMockRecorder struct {
+	mock *MockTagAliasRepository
+}
+
+// NewMockTagAliasRepository creates a new mock instance.
+func NewMockTagAliasRepository(ctrl *gomock.Controller) *MockTagAliasRepository {
+	mock := &MockTagAliasRepository{ctrl: ctrl}
+	mock.recorder = &MockTagAliasRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTagAliasRepository) EXPECT() *MockTagAliasRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Find mocks base method.
+func (m *MockTagAliasRepository) Find(ctx context.Context) ([]*model.TagAlias, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx)
+	ret0, _ := ret[0].([]*model.TagAlias)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockTagAliasRepositoryMockRecorder) Find(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockTagAliasRepository)(nil).Find), ctx)
+}
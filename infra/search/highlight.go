@@ -0,0 +1,131 @@
+package search
+
+import (
+	"html"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+	"github.com/momonoki1990/tech-blog-api/domain/repository"
+)
+
+// 本文の抜粋の長さ（文字数）
+const SnippetLength = 120
+
+// 検索語に一致した記事から検索結果を組み立てる
+func NewHit(a *model.Article, score float64, terms []string) *repository.ArticleHit {
+	return &repository.ArticleHit{
+		Article: a,
+		Score: score,
+		TitleHighlight: Highlight(a.Title, terms),
+		ContentSnippet: Snippet(a.Content, terms, SnippetLength),
+	}
+}
+
+// 文字列全体をエスケープし、一致箇所を<mark>で囲む
+func Highlight(s string, terms []string) string {
+	return markup(s, matches(s, terms), 0, len(s))
+}
+
+// 最初の一致箇所の周辺を最大maxRunes文字切り出す（一致がなければ先頭から）
+func Snippet(s string, terms []string, maxRunes int) string {
+	ms := matches(s, terms)
+	from := 0
+	if len(ms) > 0 {
+		from = ms[0].start
+		// 一致箇所の前にも少し文脈を残す
+		for i := 0; i < maxRunes/4 && from > 0; i++ {
+			_, size := utf8.DecodeLastRuneInString(s[:from])
+			from -= size
+		}
+	}
+	to := from
+	for i := 0; i < maxRunes && to < len(s); i++ {
+		_, size := utf8.DecodeRuneInString(s[to:])
+		to += size
+	}
+	snippet := markup(s, ms, from, to)
+	if from > 0 {
+		snippet = "…" + snippet
+	}
+	if to < len(s) {
+		snippet += "…"
+	}
+	return snippet
+}
+
+// 一致箇所を元の文字列のバイト範囲で返す（重なりはまとめる）
+func matches(s string, terms []string) []span {
+	f := fold(s)
+	text := f.runes
+	ms := []span{}
+	for _, t := range terms {
+		term := []rune(t)
+		if len(term) == 0 {
+			continue
+		}
+		for i := 0; i+len(term) <= len(text); i++ {
+			if equalRunes(text[i:i+len(term)], term) {
+				ms = append(ms, span{f.spans[i].start, f.spans[i+len(term)-1].end})
+			}
+		}
+	}
+	sort.Slice(ms, func(i, j int) bool { return ms[i].start < ms[j].start })
+	merged := []span{}
+	for _, m := range ms {
+		if n := len(merged); n > 0 && m.start <= merged[n-1].end {
+			if m.end > merged[n-1].end {
+				merged[n-1].end = m.end
+			}
+			continue
+		}
+		merged = append(merged, m)
+	}
+	return merged
+}
+
+func equalRunes(a, b []rune) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// s[from:to]をエスケープし、範囲内の一致箇所を<mark>で囲む
+// 抜粋は1行で表示するため、改行などの空白は半角空白にする
+func markup(s string, ms []span, from, to int) string {
+	var b strings.Builder
+	write := func(start, end int) {
+		text := strings.Map(func(r rune) rune {
+			if unicode.IsSpace(r) {
+				return ' '
+			}
+			return r
+		}, s[start:end])
+		b.WriteString(html.EscapeString(text))
+	}
+	pos := from
+	for _, m := range ms {
+		start, end := m.start, m.end
+		if end <= from || start >= to {
+			continue
+		}
+		if start < from {
+			start = from
+		}
+		if end > to {
+			end = to
+		}
+		write(pos, start)
+		b.WriteString("<mark>")
+		write(start, end)
+		b.WriteString("</mark>")
+		pos = end
+	}
+	write(pos, to)
+	return b.String()
+}
@@ -0,0 +1,78 @@
+package search
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTerms(t *testing.T) {
+	// Prepare
+	query := " Ｇｏ言語　テスト go言語 "
+
+	// Execute
+	actual := Terms(query)
+
+	// Check
+	expected := []string{"go言語", "テスト"}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Terms: Expected %v, but got %v", expected, actual)
+	}
+}
+
+func TestTokens(t *testing.T) {
+	// Execute
+	actual := tokens("東京タワー, a")
+
+	// Check
+	expected := []string{"東京", "京タ", "タワ", "ワー", "a"}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("tokens: Expected %v, but got %v", expected, actual)
+	}
+}
+
+func TestHighlight(t *testing.T) {
+	// Execute
+	actual := Highlight("<b>Ｇｏ</b>で作るAPI", []string{"go", "api"})
+
+	// Check
+	expected := "&lt;b&gt;<mark>Ｇｏ</mark>&lt;/b&gt;で作る<mark>API</mark>"
+	if actual != expected {
+		t.Errorf("Highlight: Expected %v, but got %v", expected, actual)
+	}
+}
+
+func TestHighlightHalfWidthKana(t *testing.T) {
+	// Execute
+	actual := Highlight("ｶﾞｲﾄﾞです", []string{"ガイド"})
+
+	// Check
+	expected := "<mark>ｶﾞｲﾄﾞ</mark>です"
+	if actual != expected {
+		t.Errorf("Highlight: Expected %v, but got %v", expected, actual)
+	}
+}
+
+func TestSnippet(t *testing.T) {
+	// Prepare
+	content := "あいうえおかきくけこ\nさしすせそたちつてと"
+
+	// Execute
+	actual := Snippet(content, []string{"さし"}, 8)
+
+	// Check
+	expected := "…こ <mark>さし</mark>すせそた…"
+	if actual != expected {
+		t.Errorf("Snippet: Expected %v, but got %v", expected, actual)
+	}
+}
+
+func TestSnippetWithoutMatch(t *testing.T) {
+	// Execute
+	actual := Snippet("あいうえおかきくけこ", []string{"さし"}, 5)
+
+	// Check
+	expected := "あいうえお…"
+	if actual != expected {
+		t.Errorf("Snippet: Expected %v, but got %v", expected, actual)
+	}
+}
@@ -0,0 +1,177 @@
+package search
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+	"github.com/momonoki1990/tech-blog-api/domain/repository"
+)
+
+// タイトルに一致した場合は本文より重く評価する
+const titleWeight = 3
+
+const loadPageSize = 100
+
+// MySQLの全文検索が使えない環境向けの、プロセス内の転置インデックス
+// 公開済みの記事をArticleRepositoryから読み込み、ttlを過ぎたら作り直す
+type MemoryIndex struct {
+	source repository.ArticleRepository
+	ttl time.Duration
+	now func() time.Time
+	mu sync.Mutex
+	current *snapshot
+}
+
+type snapshot struct {
+	builtAt time.Time
+	docs map[uuid.UUID]*document
+	// トークン -> 記事Id -> 重み付きの出現回数
+	postings map[string]map[uuid.UUID]int
+}
+
+type document struct {
+	article *model.Article
+	title string
+	content string
+}
+
+func NewMemoryIndex(source repository.ArticleRepository, ttl time.Duration) *MemoryIndex {
+	return &MemoryIndex{source: source, ttl: ttl, now: time.Now}
+}
+
+func (ix *MemoryIndex) Search(ctx context.Context, query string, limit int) ([]*repository.ArticleHit, error) {
+	terms := Terms(query)
+	if len(terms) == 0 {
+		return []*repository.ArticleHit{}, nil
+	}
+	s, err := ix.snapshot(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	hits := []*repository.ArticleHit{}
+	for id, score := range s.score(terms) {
+		hits = append(hits, NewHit(s.docs[id].article, score, terms))
+	}
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		return publishedAfter(hits[i].Article, hits[j].Article)
+	})
+	if limit > 0 && len(hits) > limit {
+		hits = hits[:limit]
+	}
+	return hits, nil
+}
+
+// 次の検索時に作り直す（記事の更新直後に反映させたい場合など）
+func (ix *MemoryIndex) Invalidate() {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+	ix.current = nil
+}
+
+func (ix *MemoryIndex) snapshot(ctx context.Context) (*snapshot, error) {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+	if ix.current != nil && ix.now().Sub(ix.current.builtAt) < ix.ttl {
+		return ix.current, nil
+	}
+	s, err := ix.build(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ix.current = s
+	return s, nil
+}
+
+func (ix *MemoryIndex) build(ctx context.Context) (*snapshot, error) {
+	s := &snapshot{
+		builtAt: ix.now(),
+		docs: map[uuid.UUID]*document{},
+		postings: map[string]map[uuid.UUID]int{},
+	}
+	published := model.Published
+	criteria := &repository.ArticleCriteria{Status: &published, Limit: loadPageSize}
+	for {
+		articles, next, err := ix.source.Find(ctx, criteria)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range articles {
+			s.add(a)
+		}
+		if next == nil {
+			return s, nil
+		}
+		criteria.After = next
+	}
+}
+
+func (s *snapshot) add(a *model.Article) {
+	d := &document{article: a, title: fold(a.Title).String(), content: fold(a.Content).String()}
+	s.docs[a.Id] = d
+	addPostings := func(text string, weight int) {
+		for _, t := range indexTokens(text) {
+			if s.postings[t] == nil {
+				s.postings[t] = map[uuid.UUID]int{}
+			}
+			s.postings[t][a.Id] += weight
+		}
+	}
+	addPostings(d.title, titleWeight)
+	addPostings(d.content, 1)
+}
+
+// すべての検索語を含む記事のスコア（TF-IDF）を返す
+func (s *snapshot) score(terms []string) map[uuid.UUID]float64 {
+	var scores map[uuid.UUID]float64
+	for _, term := range terms {
+		termScores := s.scoreTerm(term)
+		if scores == nil {
+			scores = termScores
+			continue
+		}
+		for id := range scores {
+			if score, ok := termScores[id]; ok {
+				scores[id] += score
+			} else {
+				delete(scores, id)
+			}
+		}
+	}
+	return scores
+}
+
+func (s *snapshot) scoreTerm(term string) map[uuid.UUID]float64 {
+	scores := map[uuid.UUID]float64{}
+	for _, t := range tokens(term) {
+		posting := s.postings[t]
+		idf := math.Log(1 + float64(len(s.docs))/float64(len(posting)+1))
+		for id, tf := range posting {
+			scores[id] += float64(tf) * idf
+		}
+	}
+	// bi-gramがすべて含まれていても連続しているとは限らないため、実際に含むかを確かめる
+	for id := range scores {
+		d := s.docs[id]
+		if !strings.Contains(d.title, term) && !strings.Contains(d.content, term) {
+			delete(scores, id)
+		}
+	}
+	return scores
+}
+
+func publishedAfter(a, b *model.Article) bool {
+	if a.PublishedAt == nil || b.PublishedAt == nil {
+		return a.PublishedAt != nil
+	}
+	return a.PublishedAt.After(*b.PublishedAt)
+}
@@ -0,0 +1,145 @@
+package search
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+	"github.com/momonoki1990/tech-blog-api/domain/repository"
+	mock_repo "github.com/momonoki1990/tech-blog-api/infra/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func newPublishedArticle(title string, content string) *model.Article {
+	categoryId, err := uuid.Parse("11111111-1111-1111-1111-111111111111")
+	if err != nil {
+		panic(err)
+	}
+	a, err := model.NewArticle(title, content, categoryId, []string{}, true)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+func TestMemoryIndexSearch(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := context.TODO()
+
+	// Prepare
+	a1 := newPublishedArticle("東京の観光", "スカイツリーに行きました")
+	a2 := newPublishedArticle("旅行記", "京都と東京を巡りました")
+	a3 := newPublishedArticle("京都の寺", "東の京という意味ではありません")
+	mockArticleRepository := mock_repo.NewMockArticleRepository(mockCtrl)
+	cursor := &repository.ArticleCursor{Id: a2.Id}
+	mockArticleRepository.EXPECT().Find(ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, c *repository.ArticleCriteria) ([]*model.Article, *repository.ArticleCursor, error) {
+			if c.Status == nil || *c.Status != model.Published {
+				t.Errorf("criteria.Status: Expected %v, but got %v", model.Published, c.Status)
+			}
+			if c.After == nil {
+				return []*model.Article{a1, a2}, cursor, nil
+			}
+			return []*model.Article{a3}, nil, nil
+		}).Times(2)
+
+	// Execute
+	ix := NewMemoryIndex(mockArticleRepository, time.Minute)
+	hits, err := ix.Search(ctx, "東京", 10)
+	if err != nil {
+		panic(err)
+	}
+
+	// Check
+	if len(hits) != 2 {
+		t.Fatalf("len(hits): Expected %d, but got %d", 2, len(hits))
+	}
+	// タイトルに一致した記事が上位
+	if hits[0].Article.Id != a1.Id {
+		t.Errorf("hits[0].Article.Id: Expected %s, but got %s", a1.Id, hits[0].Article.Id)
+	}
+	if hits[1].Article.Id != a2.Id {
+		t.Errorf("hits[1].Article.Id: Expected %s, but got %s", a2.Id, hits[1].Article.Id)
+	}
+	if hits[0].Score <= hits[1].Score {
+		t.Errorf("hits[0].Score: Expected greater than %v, but got %v", hits[1].Score, hits[0].Score)
+	}
+	if hits[0].TitleHighlight != "<mark>東京</mark>の観光" {
+		t.Errorf("hits[0].TitleHighlight: Expected %s, but got %s", "<mark>東京</mark>の観光", hits[0].TitleHighlight)
+	}
+	if hits[1].ContentSnippet != "京都と<mark>東京</mark>を巡りました" {
+		t.Errorf("hits[1].ContentSnippet: Expected %s, but got %s", "京都と<mark>東京</mark>を巡りました", hits[1].ContentSnippet)
+	}
+}
+
+func TestMemoryIndexSearchAllTerms(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := context.TODO()
+
+	// Prepare
+	a1 := newPublishedArticle("Go入門", "Goでテストを書く")
+	a2 := newPublishedArticle("Go入門2", "Goで並行処理")
+	mockArticleRepository := mock_repo.NewMockArticleRepository(mockCtrl)
+	mockArticleRepository.EXPECT().Find(ctx, gomock.Any()).Return([]*model.Article{a1, a2}, nil, nil)
+
+	// Execute
+	ix := NewMemoryIndex(mockArticleRepository, time.Minute)
+	hits, err := ix.Search(ctx, "ｇｏ　テスト", 10)
+	if err != nil {
+		panic(err)
+	}
+
+	// Check
+	if len(hits) != 1 {
+		t.Fatalf("len(hits): Expected %d, but got %d", 1, len(hits))
+	}
+	if hits[0].Article.Id != a1.Id {
+		t.Errorf("hits[0].Article.Id: Expected %s, but got %s", a1.Id, hits[0].Article.Id)
+	}
+}
+
+func TestMemoryIndexRebuild(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := context.TODO()
+
+	// Prepare
+	a1 := newPublishedArticle("Go入門", "Goでテストを書く")
+	mockArticleRepository := mock_repo.NewMockArticleRepository(mockCtrl)
+	mockArticleRepository.EXPECT().Find(ctx, gomock.Any()).Return([]*model.Article{}, nil, nil)
+	mockArticleRepository.EXPECT().Find(ctx, gomock.Any()).Return([]*model.Article{a1}, nil, nil)
+	now := time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)
+	ix := NewMemoryIndex(mockArticleRepository, time.Minute)
+	ix.now = func() time.Time { return now }
+
+	// Execute
+	before, err := ix.Search(ctx, "テスト", 10)
+	if err != nil {
+		panic(err)
+	}
+	now = now.Add(30 * time.Second)
+	cached, err := ix.Search(ctx, "テスト", 10)
+	if err != nil {
+		panic(err)
+	}
+	now = now.Add(time.Minute)
+	after, err := ix.Search(ctx, "テスト", 10)
+	if err != nil {
+		panic(err)
+	}
+
+	// Check
+	if len(before) != 0 {
+		t.Errorf("len(before): Expected %d, but got %d", 0, len(before))
+	}
+	if len(cached) != 0 {
+		t.Errorf("len(cached): Expected %d, but got %d", 0, len(cached))
+	}
+	if len(after) != 1 {
+		t.Errorf("len(after): Expected %d, but got %d", 1, len(after))
+	}
+}
@@ -0,0 +1,108 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// 正規化後の文字列と、各runeが元の文字列のどのバイト範囲から来たか
+type folded struct {
+	runes []rune
+	spans []span
+}
+
+type span struct {
+	start int
+	end int
+}
+
+// NFKC（全角英数・半角カナの統一）と小文字化を行う
+// 元の位置に戻せるよう、正規化の区切り単位ごとに処理する
+func fold(s string) *folded {
+	f := &folded{}
+	var it norm.Iter
+	it.InitString(norm.NFKC, s)
+	for !it.Done() {
+		start := it.Pos()
+		seg := it.Next()
+		end := it.Pos()
+		for _, r := range strings.ToLower(string(seg)) {
+			f.runes = append(f.runes, r)
+			f.spans = append(f.spans, span{start, end})
+		}
+	}
+	return f
+}
+
+func (f *folded) String() string {
+	return string(f.runes)
+}
+
+// 検索語を空白（全角空白を含む）で区切り、正規化する
+func Terms(query string) []string {
+	seen := map[string]bool{}
+	terms := []string{}
+	for _, t := range strings.Fields(fold(query).String()) {
+		if seen[t] {
+			continue
+		}
+		seen[t] = true
+		terms = append(terms, t)
+	}
+	return terms
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsNumber(r) || unicode.IsMark(r)
+}
+
+// 文字・数字の連なりごとに分ける
+func wordRuns(s string) [][]rune {
+	runs := [][]rune{}
+	run := []rune{}
+	for _, r := range s {
+		if isWordRune(r) {
+			run = append(run, r)
+			continue
+		}
+		if len(run) > 0 {
+			runs = append(runs, run)
+			run = []rune{}
+		}
+	}
+	if len(run) > 0 {
+		runs = append(runs, run)
+	}
+	return runs
+}
+
+// 日本語は単語の区切りがないため、文字・数字の連なりを2文字ずつ（bi-gram）に分ける
+// 1文字だけの連なりはそのまま1文字のトークンにする
+func tokens(s string) []string {
+	result := []string{}
+	for _, run := range wordRuns(s) {
+		if len(run) == 1 {
+			result = append(result, string(run))
+		}
+		for i := 0; i+1 < len(run); i++ {
+			result = append(result, string(run[i:i+2]))
+		}
+	}
+	return result
+}
+
+// 索引には1文字の検索語にも対応できるよう、bi-gramに加えてすべての1文字を含める
+func indexTokens(s string) []string {
+	result := []string{}
+	for _, run := range wordRuns(s) {
+		for i := range run {
+			result = append(result, string(run[i]))
+			if i+1 < len(run) {
+				result = append(result, string(run[i:i+2]))
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,173 @@
+package token
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/momonoki1990/tech-blog-api/application/auth"
+	"github.com/momonoki1990/tech-blog-api/domain/errs"
+)
+
+// HMAC-SHA256の鍵は32バイト以上にする
+const keyMinLength = 32
+
+// 署名・検証に使う鍵。Idはトークンのヘッダ（kid）に入れる
+type Key struct {
+	Id string
+	Secret []byte
+}
+
+// HS256で署名したJWT
+// 鍵を入れ替えるときは、新しい鍵を先頭に追加し（以降の署名に使う）、古い鍵は
+// それで署名したトークン（リフレッシュトークンを含む）が期限切れになるまで残しておく
+type JWTSigner struct {
+	current *Key
+	keys map[string][]byte
+	now func() time.Time
+}
+
+type jwtHeader struct {
+	Alg string `json:"alg"`
+	Typ string `json:"typ"`
+	Kid string `json:"kid"`
+}
+
+type jwtClaims struct {
+	Jti string `json:"jti"`
+	Sub string `json:"sub"`
+	TokenType string `json:"token_type"`
+	Iat int64 `json:"iat"`
+	Exp int64 `json:"exp"`
+}
+
+// keysの先頭の鍵で署名し、どの鍵で署名したトークンも検証できる
+func NewJWTSigner(keys []*Key) (*JWTSigner, error) {
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("At least one key is required")
+	}
+	s := &JWTSigner{current: keys[0], keys: make(map[string][]byte), now: time.Now}
+	for _, v := range keys {
+		if v.Id == "" {
+			return nil, fmt.Errorf("Key id is required")
+		}
+		if len(v.Secret) < keyMinLength {
+			return nil, fmt.Errorf("Key %q should be at least %d bytes", v.Id, keyMinLength)
+		}
+		if _, ok := s.keys[v.Id]; ok {
+			return nil, fmt.Errorf("Key id %q is duplicated", v.Id)
+		}
+		s.keys[v.Id] = v.Secret
+	}
+	return s, nil
+}
+
+// 例: "2026-10:c2VjcmV0...,2026-04:b2xk..."（鍵のIdとbase64の鍵をカンマで区切る。先頭が署名に使う鍵）
+func ParseKeys(v string) ([]*Key, error) {
+	var keys []*Key
+	for _, pair := range strings.Split(v, ",") {
+		id, encoded, ok := strings.Cut(strings.TrimSpace(pair), ":")
+		if !ok {
+			return nil, fmt.Errorf("Key should be formatted as id:base64")
+		}
+		secret, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("Key %q is not base64: %w", id, err)
+		}
+		keys = append(keys, &Key{Id: id, Secret: secret})
+	}
+	return keys, nil
+}
+
+func (s *JWTSigner) Sign(c *auth.Claims) (string, error) {
+	header, err := json.Marshal(&jwtHeader{Alg: "HS256", Typ: "JWT", Kid: s.current.Id})
+	if err != nil {
+		return "", err
+	}
+	claims, err := json.Marshal(&jwtClaims{
+		Jti: c.Id,
+		Sub: c.UserId.String(),
+		TokenType: string(c.Type),
+		Iat: c.IssuedAt.Unix(),
+		Exp: c.ExpiresAt.Unix(),
+	})
+	if err != nil {
+		return "", err
+	}
+	signingInput := encodeSegment(header) + "." + encodeSegment(claims)
+	return signingInput + "." + encodeSegment(sign(s.current.Secret, signingInput)), nil
+}
+
+func (s *JWTSigner) Verify(token string) (*auth.Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, invalidToken()
+	}
+	header := new(jwtHeader)
+	if err := decodeSegment(parts[0], header); err != nil {
+		return nil, invalidToken()
+	}
+	// algは署名の検証の前に固定する（"none"や別の方式を受け付けない）
+	if header.Alg != "HS256" {
+		return nil, invalidToken()
+	}
+	secret, ok := s.keys[header.Kid]
+	if !ok {
+		return nil, invalidToken()
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil || !hmac.Equal(signature, sign(secret, parts[0]+"."+parts[1])) {
+		return nil, invalidToken()
+	}
+
+	claims := new(jwtClaims)
+	if err := decodeSegment(parts[1], claims); err != nil {
+		return nil, invalidToken()
+	}
+	userId, err := uuid.Parse(claims.Sub)
+	if err != nil || claims.Jti == "" {
+		return nil, invalidToken()
+	}
+	tokenType := auth.TokenType(claims.TokenType)
+	if tokenType != auth.AccessToken && tokenType != auth.RefreshToken {
+		return nil, invalidToken()
+	}
+	expiresAt := time.Unix(claims.Exp, 0)
+	if !s.now().Before(expiresAt) {
+		return nil, errs.NewUnauthenticated(errs.CodeTokenExpired, "Token has expired")
+	}
+	return &auth.Claims{
+		Id: claims.Jti,
+		UserId: userId,
+		Type: tokenType,
+		IssuedAt: time.Unix(claims.Iat, 0),
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+func invalidToken() error {
+	return errs.NewUnauthenticated(errs.CodeInvalidToken, "Token is invalid")
+}
+
+func sign(secret []byte, signingInput string) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(signingInput))
+	return mac.Sum(nil)
+}
+
+func encodeSegment(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeSegment(segment string, v interface{}) (error) {
+	b, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
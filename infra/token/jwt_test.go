@@ -0,0 +1,234 @@
+package token
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/momonoki1990/tech-blog-api/application/auth"
+	"github.com/momonoki1990/tech-blog-api/domain/errs"
+)
+
+func newTestKey(id string) *Key {
+	return &Key{Id: id, Secret: []byte(strings.Repeat(id, keyMinLength))}
+}
+
+func newTestClaims(now time.Time) *auth.Claims {
+	return &auth.Claims{
+		Id: uuid.New().String(),
+		UserId: uuid.New(),
+		Type: auth.AccessToken,
+		IssuedAt: now,
+		ExpiresAt: now.Add(15 * time.Minute),
+	}
+}
+
+func errorCode(err error) string {
+	if e, ok := errs.As(err); ok {
+		return e.Code
+	}
+	return ""
+}
+
+func TestJWTSignerSignAndVerify(t *testing.T) {
+	// Prepare
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	s, err := NewJWTSigner([]*Key{newTestKey("a")})
+	if err != nil {
+		panic(err)
+	}
+	s.now = func() time.Time { return now }
+	claims := newTestClaims(now)
+
+	// Execute
+	token, err := s.Sign(claims)
+	if err != nil {
+		panic(err)
+	}
+	verified, err := s.Verify(token)
+
+	// Check
+	if err != nil {
+		t.Fatalf("err of s.Verify(token): Expected %v, but got %v", nil, err)
+	}
+	if verified.Id != claims.Id {
+		t.Errorf("verified.Id: Expected %v, but got %v", claims.Id, verified.Id)
+	}
+	if verified.UserId != claims.UserId {
+		t.Errorf("verified.UserId: Expected %v, but got %v", claims.UserId, verified.UserId)
+	}
+	if verified.Type != auth.AccessToken {
+		t.Errorf("verified.Type: Expected %v, but got %v", auth.AccessToken, verified.Type)
+	}
+	if !verified.ExpiresAt.Equal(claims.ExpiresAt) {
+		t.Errorf("verified.ExpiresAt: Expected %v, but got %v", claims.ExpiresAt, verified.ExpiresAt)
+	}
+}
+
+func TestJWTSignerVerifyExpired(t *testing.T) {
+	// Prepare
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	s, err := NewJWTSigner([]*Key{newTestKey("a")})
+	if err != nil {
+		panic(err)
+	}
+	s.now = func() time.Time { return now }
+	token, err := s.Sign(newTestClaims(now))
+	if err != nil {
+		panic(err)
+	}
+
+	tests := []struct {
+		name string
+		now time.Time
+		code string
+	}{
+		{"1秒前", now.Add(15*time.Minute - time.Second), ""},
+		{"期限ちょうど", now.Add(15 * time.Minute), errs.CodeTokenExpired},
+		{"期限後", now.Add(time.Hour), errs.CodeTokenExpired},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Execute
+			s.now = func() time.Time { return tt.now }
+			_, err := s.Verify(token)
+
+			// Check
+			if errorCode(err) != tt.code {
+				t.Errorf("code of s.Verify(token): Expected %q, but got %v", tt.code, err)
+			}
+		})
+	}
+}
+
+func TestJWTSignerVerifyTampered(t *testing.T) {
+	// Prepare
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	s, err := NewJWTSigner([]*Key{newTestKey("a")})
+	if err != nil {
+		panic(err)
+	}
+	s.now = func() time.Time { return now }
+	token, err := s.Sign(newTestClaims(now))
+	if err != nil {
+		panic(err)
+	}
+	parts := strings.Split(token, ".")
+	// 同じIdの別の鍵で署名したトークン
+	other, err := NewJWTSigner([]*Key{{Id: "a", Secret: []byte(strings.Repeat("b", keyMinLength))}})
+	if err != nil {
+		panic(err)
+	}
+	forged, err := other.Sign(newTestClaims(now))
+	if err != nil {
+		panic(err)
+	}
+	// 有効期限を延ばしたペイロード（署名はそのまま）
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		panic(err)
+	}
+	extended := strings.Replace(string(payload), `"exp":`, `"exp":9`, 1)
+	noneHeader := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none","typ":"JWT","kid":"a"}`))
+	unknownKidHeader := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT","kid":"z"}`))
+
+	tests := []struct {
+		name string
+		token string
+	}{
+		{"ペイロードの改ざん", parts[0] + "." + base64.RawURLEncoding.EncodeToString([]byte(extended)) + "." + parts[2]},
+		{"別の鍵の署名", forged},
+		{"署名の欠落", parts[0] + "." + parts[1] + "."},
+		{"alg none", noneHeader + "." + parts[1] + "."},
+		{"不明なkid", unknownKidHeader + "." + parts[1] + "." + parts[2]},
+		{"形式の誤り", parts[0] + "." + parts[1]},
+		{"空文字", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Execute
+			_, err := s.Verify(tt.token)
+
+			// Check
+			if errorCode(err) != errs.CodeInvalidToken {
+				t.Errorf("code of s.Verify(token): Expected %q, but got %v", errs.CodeInvalidToken, err)
+			}
+		})
+	}
+}
+
+func TestJWTSignerKeyRotation(t *testing.T) {
+	// Prepare
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	old, err := NewJWTSigner([]*Key{newTestKey("a")})
+	if err != nil {
+		panic(err)
+	}
+	old.now = func() time.Time { return now }
+	oldToken, err := old.Sign(newTestClaims(now))
+	if err != nil {
+		panic(err)
+	}
+	rotated, err := NewJWTSigner([]*Key{newTestKey("b"), newTestKey("a")})
+	if err != nil {
+		panic(err)
+	}
+	rotated.now = func() time.Time { return now }
+
+	// Execute
+	newToken, err := rotated.Sign(newTestClaims(now))
+	if err != nil {
+		panic(err)
+	}
+	_, oldErr := rotated.Verify(oldToken)
+	_, newErr := rotated.Verify(newToken)
+	// 古い鍵を外した後は、古い鍵で署名したトークンは使えない
+	retired, err := NewJWTSigner([]*Key{newTestKey("b")})
+	if err != nil {
+		panic(err)
+	}
+	retired.now = func() time.Time { return now }
+	_, retiredErr := retired.Verify(oldToken)
+
+	// Check
+	if oldErr != nil {
+		t.Errorf("err of rotated.Verify(oldToken): Expected %v, but got %v", nil, oldErr)
+	}
+	if newErr != nil {
+		t.Errorf("err of rotated.Verify(newToken): Expected %v, but got %v", nil, newErr)
+	}
+	if errorCode(retiredErr) != errs.CodeInvalidToken {
+		t.Errorf("code of retired.Verify(oldToken): Expected %q, but got %v", errs.CodeInvalidToken, retiredErr)
+	}
+	if _, err := old.Verify(newToken); errorCode(err) != errs.CodeInvalidToken {
+		t.Errorf("code of old.Verify(newToken): Expected %q, but got %v", errs.CodeInvalidToken, err)
+	}
+}
+
+func TestParseKeys(t *testing.T) {
+	// Prepare
+	secret := strings.Repeat("k", keyMinLength)
+	v := "b:" + base64.StdEncoding.EncodeToString([]byte(secret)) + ", a:" + base64.StdEncoding.EncodeToString([]byte(secret))
+
+	// Execute
+	keys, err := ParseKeys(v)
+
+	// Check
+	if err != nil {
+		t.Fatalf("err of ParseKeys(v): Expected %v, but got %v", nil, err)
+	}
+	if len(keys) != 2 || keys[0].Id != "b" || keys[1].Id != "a" {
+		t.Errorf("keys: Expected ids %v, but got %v", []string{"b", "a"}, keys)
+	}
+	if string(keys[0].Secret) != secret {
+		t.Errorf("keys[0].Secret: Expected %q, but got %q", secret, keys[0].Secret)
+	}
+	if _, err := ParseKeys("a"); err == nil {
+		t.Errorf("err of ParseKeys(\"a\"): Expected an error, but got nil")
+	}
+	if _, err := NewJWTSigner([]*Key{{Id: "a", Secret: []byte("short")}}); err == nil {
+		t.Errorf("err of NewJWTSigner(short key): Expected an error, but got nil")
+	}
+}
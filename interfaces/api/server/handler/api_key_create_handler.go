@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/momonoki1990/tech-blog-api/application/usecase"
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+)
+
+type CreateApiKeyBody struct {
+	Name string `json:"name"`
+	// articles:write などの権限
+	Scopes []string `json:"scopes"`
+	// 省略時は無期限
+	ExpiresAt *time.Time `json:"expiresAt"`
+}
+
+type CreateApiKeyResponseBody struct {
+	ApiKey *model.ApiKey `json:"apiKey"`
+	// この時しか返さない
+	Key string `json:"key"`
+}
+
+type ApiKeyCreateHandler interface {
+    CreateApiKey(c echo.Context) error
+}
+
+type apiKeyCreateHandler struct {
+    u usecase.ApiKeyUseCase
+}
+
+func NewApiKeyCreateHandler(u usecase.ApiKeyUseCase) ApiKeyCreateHandler {
+    return &apiKeyCreateHandler{u}
+}
+
+func (h *apiKeyCreateHandler) CreateApiKey(c echo.Context) error {
+    body := new(CreateApiKeyBody)
+    if err := c.Bind(body); err != nil {
+		return badRequest(err)
+    }
+    apiKey, key, err := h.u.CreateApiKey(c.Request().Context(), body.Name, body.Scopes, body.ExpiresAt)
+    if err != nil {
+        return err
+    }
+	// キーはキャッシュさせない
+	c.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+    return c.JSON(http.StatusCreated, &CreateApiKeyResponseBody{ApiKey: apiKey, Key: key})
+}
@@ -0,0 +1,33 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/momonoki1990/tech-blog-api/application/usecase"
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+)
+
+type ApiKeyListResponseBody struct {
+	ApiKeys []*model.ApiKey `json:"apiKeys"`
+}
+
+type ApiKeyListHandler interface {
+    ApiKeyList(c echo.Context) error
+}
+
+type apiKeyListHandler struct {
+    u usecase.ApiKeyUseCase
+}
+
+func NewApiKeyListHandler(u usecase.ApiKeyUseCase) ApiKeyListHandler {
+    return &apiKeyListHandler{u}
+}
+
+func (h *apiKeyListHandler) ApiKeyList(c echo.Context) error {
+    apiKeys, err := h.u.GetApiKeys(c.Request().Context())
+	if err != nil {
+		return err
+	}
+    return c.JSON(http.StatusOK, &ApiKeyListResponseBody{ApiKeys: apiKeys})
+}
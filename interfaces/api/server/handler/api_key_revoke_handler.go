@@ -0,0 +1,32 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/momonoki1990/tech-blog-api/application/usecase"
+)
+
+type ApiKeyRevokeHandler interface {
+    RevokeApiKey(c echo.Context) error
+}
+
+type apiKeyRevokeHandler struct {
+    u usecase.ApiKeyUseCase
+}
+
+func NewApiKeyRevokeHandler(u usecase.ApiKeyUseCase) ApiKeyRevokeHandler {
+    return &apiKeyRevokeHandler{u}
+}
+
+func (h *apiKeyRevokeHandler) RevokeApiKey(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return badRequest(err)
+	}
+    if err := h.u.RevokeApiKey(c.Request().Context(), id); err != nil {
+        return err
+    }
+    return c.NoContent(http.StatusNoContent)
+}
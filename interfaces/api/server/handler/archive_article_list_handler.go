@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/momonoki1990/tech-blog-api/application/usecase"
+)
+
+type ArchiveArticleListHandler interface {
+    ArchiveArticleList(c echo.Context) error
+}
+
+type archiveArticleListHandler struct {
+    u usecase.ArchiveUseCase
+}
+
+func NewArchiveArticleListHandler(u usecase.ArchiveUseCase) ArchiveArticleListHandler {
+    return &archiveArticleListHandler{u}
+}
+
+func (h *archiveArticleListHandler) ArchiveArticleList(c echo.Context) error {
+	year, err := strconv.Atoi(c.Param("year"))
+	if err != nil {
+		return badRequest(fmt.Errorf("year should be an integer"))
+	}
+	month, err := strconv.Atoi(c.Param("month"))
+	if err != nil {
+		return badRequest(fmt.Errorf("month should be an integer"))
+	}
+	sort := c.QueryParam("sort")
+	if sort == "" {
+		sort = "-publishedAt"
+	}
+	// 状態・カテゴリ・タグでは絞り込まない（公開済みの記事だけ）
+	criteria, err := toArticlePaging(c, sort)
+	if err != nil {
+		return badRequest(err)
+	}
+    articles, next, err := h.u.GetArchiveArticles(c.Request().Context(), year, month, criteria)
+	if err != nil {
+		return err
+	}
+	responseBody, err := toArticleListResponseBody(sort, articles, next)
+	if err != nil {
+		return err
+	}
+    return c.JSON(http.StatusOK, responseBody)
+}
@@ -0,0 +1,33 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/momonoki1990/tech-blog-api/application/usecase"
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+)
+
+type ArchiveListResponseBody struct {
+	Months []*model.ArchiveMonth `json:"months"`
+}
+
+type ArchiveListHandler interface {
+    ArchiveList(c echo.Context) error
+}
+
+type archiveListHandler struct {
+    u usecase.ArchiveUseCase
+}
+
+func NewArchiveListHandler(u usecase.ArchiveUseCase) ArchiveListHandler {
+    return &archiveListHandler{u}
+}
+
+func (h *archiveListHandler) ArchiveList(c echo.Context) error {
+    months, err := h.u.GetArchiveMonths(c.Request().Context())
+	if err != nil {
+		return err
+	}
+    return c.JSON(http.StatusOK, &ArchiveListResponseBody{Months: months})
+}
@@ -1,8 +1,8 @@
 package handler
 
 import (
-	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
@@ -15,6 +15,12 @@ type CreateArticleBody struct {
     CategoryId string `json:"categoryId"`
 	TagNames []string `json:"tagNames"`
 	ShouldPublish bool `json:"shouldPublish"`
+	// 省略時はタイトルから生成する
+	Slug string `json:"slug"`
+	// shouldPublishがtrueの場合のみ。未来の日時なら予約投稿になる
+	PublishedAt *time.Time `json:"publishedAt"`
+	// 著者のスラッグ（表示順）。省略時は送り手のユーザーの著者
+	AuthorSlugs []string `json:"authorSlugs"`
 }
 
 type CreateArticleResponseBody struct {
@@ -36,15 +42,13 @@ func NewArticleCreateHandler(u usecase.ArticleUseCase) ArticleCreateHandler {
 func (h *articleCreateHandler) CreateArticle(c echo.Context) error {
     body := new(CreateArticleBody)
     if err := c.Bind(body); err != nil {
-        fmt.Print(err)
-        return c.String(http.StatusBadRequest, "Bad request")
+		return badRequest(err)
     }
 	categoryId, err := uuid.Parse(body.CategoryId)
 	if err != nil {
-		fmt.Print(err)
-        return c.String(http.StatusBadRequest, "Bad request")
+		return badRequest(err)
 	}
-    articleId, err := h.u.RegisterArticle(body.Title, body.Content, categoryId, body.TagNames, body.ShouldPublish)
+    articleId, err := h.u.RegisterArticle(c.Request().Context(), body.Title, body.Content, categoryId, body.TagNames, body.ShouldPublish, body.Slug, body.PublishedAt, body.AuthorSlugs)
     if err != nil {
         return err
     }
@@ -22,10 +22,14 @@ func NewArticleDeleteHandler(u usecase.ArticleUseCase) ArticleDeleteHandler {
 
 func (h *articleDeleteHandler) DeleteArticle(c echo.Context) error {
 	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return badRequest(err)
+	}
+	version, err := ifMatchVersion(c)
 	if err != nil {
 		return err
 	}
-    if err := h.u.DeleteArticle(id); err != nil {
+    if err := h.u.DeleteArticle(c.Request().Context(), id, version); err != nil {
         return err
     }
     return c.String(http.StatusOK, "Delete article ok")
@@ -0,0 +1,33 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/momonoki1990/tech-blog-api/application/usecase"
+)
+
+type ArticleGetBySlugHandler interface {
+    ArticleGetBySlug(c echo.Context) error
+}
+
+type articleGetBySlugHandler struct {
+    u usecase.ArticleUseCase
+}
+
+func NewArticleGetBySlugHandler(u usecase.ArticleUseCase) ArticleGetBySlugHandler {
+    return &articleGetBySlugHandler{u}
+}
+
+func (h *articleGetBySlugHandler) ArticleGetBySlug(c echo.Context) error {
+    article, err := h.u.GetArticleBySlug(c.Request().Context(), c.Param("slug"))
+	if err != nil {
+		return err
+	}
+	responseBody, err := toArticleResponseBody(c, h.u, article)
+	if err != nil {
+		return err
+	}
+	setETag(c, article.Version)
+    return c.JSON(http.StatusOK, responseBody)
+}
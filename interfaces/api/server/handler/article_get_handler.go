@@ -22,12 +22,17 @@ func NewArticleGetHandler(u usecase.ArticleUseCase) ArticleGetHandler {
 
 func (h *articleGetHandler) ArticleGet(c echo.Context) error {
 	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return badRequest(err)
+	}
+    articles, err := h.u.GetArticle(c.Request().Context(), id)
 	if err != nil {
 		return err
 	}
-    articles, err := h.u.GetArticle(id)
+	responseBody, err := toArticleResponseBody(c, h.u, articles)
 	if err != nil {
 		return err
 	}
-    return c.JSON(http.StatusOK, articles)
+	setETag(c, articles.Version)
+    return c.JSON(http.StatusOK, responseBody)
 }
\ No newline at end of file
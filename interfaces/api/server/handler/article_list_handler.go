@@ -1,12 +1,38 @@
 package handler
 
 import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
+	"strconv"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/momonoki1990/tech-blog-api/application/usecase"
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+	"github.com/momonoki1990/tech-blog-api/domain/repository"
 )
 
+const (
+	articleListDefaultLimit = 20
+	articleListMaxLimit = 100
+)
+
+type ArticleListResponseBody struct {
+	Articles []*model.Article `json:"articles"`
+	NextCursor *string `json:"nextCursor"`
+}
+
+// クライアントには不透明な文字列として渡す（base64エンコードしたJSON）
+type articleListCursor struct {
+	Sort string `json:"s"`
+	SortValue *time.Time `json:"v"`
+	Id uuid.UUID `json:"i"`
+}
+
 type ArticleListHandler interface {
     ArticleList(c echo.Context) error
 }
@@ -20,9 +46,162 @@ func NewArticleListHandler(u usecase.ArticleUseCase) ArticleListHandler {
 }
 
 func (h *articleListHandler) ArticleList(c echo.Context) error {
-    articles, err := h.u.GetArticleList()
+	sort := c.QueryParam("sort")
+	if sort == "" {
+		sort = "-publishedAt"
+	}
+	criteria, err := toArticleCriteria(c, sort)
+	if err != nil {
+		return badRequest(err)
+	}
+    articles, next, err := h.u.GetArticleList(c.Request().Context(), criteria)
+	if err != nil {
+		return err
+	}
+	responseBody, err := toArticleListResponseBody(sort, articles, next)
 	if err != nil {
 		return err
 	}
-    return c.JSON(http.StatusOK, articles)
-}
\ No newline at end of file
+    return c.JSON(http.StatusOK, responseBody)
+}
+
+func toArticleListResponseBody(sort string, articles []*model.Article, next *repository.ArticleCursor) (*ArticleListResponseBody, error) {
+	responseBody := &ArticleListResponseBody{Articles: articles}
+	if next != nil {
+		nextCursor, err := encodeArticleListCursor(sort, next)
+		if err != nil {
+			return nil, err
+		}
+		responseBody.NextCursor = &nextCursor
+	}
+	return responseBody, nil
+}
+
+func toArticleCriteria(c echo.Context, sort string) (*repository.ArticleCriteria, error) {
+	criteria, err := toArticlePaging(c, sort)
+	if err != nil {
+		return nil, err
+	}
+
+	if v := c.QueryParam("categoryId"); v != "" {
+		categoryId, err := uuid.Parse(v)
+		if err != nil {
+			return nil, err
+		}
+		criteria.CategoryId = &categoryId
+		// 既定では子孫のカテゴリの記事も含める（Backendを指定すればGoの記事も出る）
+		criteria.IncludeDescendantCategories = true
+		if v := c.QueryParam("includeDescendants"); v != "" {
+			includeDescendants, err := strconv.ParseBool(v)
+			if err != nil {
+				return nil, err
+			}
+			criteria.IncludeDescendantCategories = includeDescendants
+		}
+	}
+	if v := c.QueryParam("tag"); v != "" {
+		criteria.TagName = &v
+	}
+	if v := c.QueryParam("status"); v != "" {
+		var status model.Status
+		switch v {
+		case model.Draft.String():
+			status = model.Draft
+		case model.Published.String():
+			status = model.Published
+		case model.Scheduled.String():
+			status = model.Scheduled
+		default:
+			return nil, fmt.Errorf("Invalid status %q", v)
+		}
+		criteria.Status = &status
+	}
+	if v := c.QueryParam("publishedFrom"); v != "" {
+		from, _, err := parseDateOrTime(v)
+		if err != nil {
+			return nil, err
+		}
+		criteria.PublishedFrom = &from
+	}
+	if v := c.QueryParam("publishedTo"); v != "" {
+		to, isDate, err := parseDateOrTime(v)
+		if err != nil {
+			return nil, err
+		}
+		// 日付のみの指定はその日を含める
+		if isDate {
+			to = to.AddDate(0, 0, 1)
+		}
+		criteria.PublishedTo = &to
+	}
+	return criteria, nil
+}
+
+// 並び順とページング（limit・cursor）だけの条件
+func toArticlePaging(c echo.Context, sort string) (*repository.ArticleCriteria, error) {
+	criteria := &repository.ArticleCriteria{Limit: articleListDefaultLimit}
+
+	switch sort {
+	case "publishedAt":
+		criteria.SortKey, criteria.Ascending = repository.SortByPublishedAt, true
+	case "-publishedAt":
+		criteria.SortKey, criteria.Ascending = repository.SortByPublishedAt, false
+	case "createdAt":
+		criteria.SortKey, criteria.Ascending = repository.SortByCreatedAt, true
+	case "-createdAt":
+		criteria.SortKey, criteria.Ascending = repository.SortByCreatedAt, false
+	default:
+		return nil, fmt.Errorf("Invalid sort %q", sort)
+	}
+
+	if v := c.QueryParam("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, err
+		}
+		if limit < 1 || limit > articleListMaxLimit {
+			return nil, fmt.Errorf("limit should be from %d to %d", 1, articleListMaxLimit)
+		}
+		criteria.Limit = limit
+	}
+	if v := c.QueryParam("cursor"); v != "" {
+		after, err := decodeArticleListCursor(sort, v)
+		if err != nil {
+			return nil, err
+		}
+		criteria.After = after
+	}
+	return criteria, nil
+}
+
+func parseDateOrTime(v string) (time.Time, bool, error) {
+	if t, err := time.ParseInLocation("2006-01-02", v, time.Local); err == nil {
+		return t, true, nil
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	return t, false, err
+}
+
+func encodeArticleListCursor(sort string, cursor *repository.ArticleCursor) (string, error) {
+	b, err := json.Marshal(&articleListCursor{Sort: sort, SortValue: cursor.SortValue, Id: cursor.Id})
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func decodeArticleListCursor(sort string, v string) (*repository.ArticleCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(v)
+	if err != nil {
+		return nil, err
+	}
+	var cursor articleListCursor
+	if err := json.Unmarshal(b, &cursor); err != nil {
+		return nil, err
+	}
+	// 並び順が変わるとカーソルの位置が意味をなさない
+	if cursor.Sort != sort {
+		return nil, errors.New("cursor does not match sort")
+	}
+	return &repository.ArticleCursor{SortValue: cursor.SortValue, Id: cursor.Id}, nil
+}
@@ -0,0 +1,74 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/momonoki1990/tech-blog-api/application/usecase"
+)
+
+// 省略した項目は変更しない
+type PatchArticleBody struct {
+	Title *string `json:"title"`
+	Content *string `json:"content"`
+	CategoryId *string `json:"categoryId"`
+	TagNames *[]string `json:"tagNames"`
+	ShouldPublish *bool `json:"shouldPublish"`
+	Slug *string `json:"slug"`
+	// 公開済み・予約中の記事（またはshouldPublishをtrueにする場合）のみ
+	PublishedAt *time.Time `json:"publishedAt"`
+}
+
+type ArticlePatchHandler interface {
+    PatchArticle(c echo.Context) error
+}
+
+type articlePatchHandler struct {
+    u usecase.ArticleUseCase
+}
+
+func NewArticlePatchHandler(u usecase.ArticleUseCase) ArticlePatchHandler {
+    return &articlePatchHandler{u}
+}
+
+func (h *articlePatchHandler) PatchArticle(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return badRequest(err)
+	}
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		return err
+	}
+    body := new(PatchArticleBody)
+    if err := bindMergePatch(c, body); err != nil {
+		return err
+    }
+	patch := &usecase.ArticlePatch{
+		Title: body.Title,
+		Content: body.Content,
+		TagNames: body.TagNames,
+		ShouldPublish: body.ShouldPublish,
+		Slug: body.Slug,
+		PublishedAt: body.PublishedAt,
+	}
+	if body.CategoryId != nil {
+		categoryId, err := uuid.Parse(*body.CategoryId)
+		if err != nil {
+			return badRequest(err)
+		}
+		patch.CategoryId = &categoryId
+	}
+    article, err := h.u.PatchArticle(c.Request().Context(), id, version, patch)
+    if err != nil {
+        return err
+    }
+	responseBody, err := toArticleResponseBody(c, h.u, article)
+	if err != nil {
+		return err
+	}
+	setETag(c, article.Version)
+    return c.JSON(http.StatusOK, responseBody)
+}
@@ -0,0 +1,32 @@
+package handler
+
+import (
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+	"github.com/momonoki1990/tech-blog-api/application/usecase"
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+)
+
+type ArticleResponseBody struct {
+	*model.Article
+	// ?render=html の場合のみ
+	ContentHtml *string `json:"contentHtml,omitempty"`
+}
+
+// ?render=html なら本文をHTMLに変換した結果も返す
+func toArticleResponseBody(c echo.Context, u usecase.ArticleUseCase, a *model.Article) (*ArticleResponseBody, error) {
+	body := &ArticleResponseBody{Article: a}
+	switch v := c.QueryParam("render"); v {
+	case "":
+	case "html":
+		html, err := u.RenderArticleContent(c.Request().Context(), a)
+		if err != nil {
+			return nil, err
+		}
+		body.ContentHtml = &html
+	default:
+		return nil, badRequest(fmt.Errorf("Invalid render %q", v))
+	}
+	return body, nil
+}
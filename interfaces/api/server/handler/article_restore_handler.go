@@ -0,0 +1,32 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/momonoki1990/tech-blog-api/application/usecase"
+)
+
+type ArticleRestoreHandler interface {
+    RestoreArticle(c echo.Context) error
+}
+
+type articleRestoreHandler struct {
+    u usecase.TrashUseCase
+}
+
+func NewArticleRestoreHandler(u usecase.TrashUseCase) ArticleRestoreHandler {
+    return &articleRestoreHandler{u}
+}
+
+func (h *articleRestoreHandler) RestoreArticle(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return badRequest(err)
+	}
+    if err := h.u.RestoreArticle(c.Request().Context(), id); err != nil {
+        return err
+    }
+    return c.String(http.StatusOK, "Restore article ok")
+}
@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/momonoki1990/tech-blog-api/application/usecase"
+)
+
+type ArticleRevisionDiffHandler interface {
+    ArticleRevisionDiff(c echo.Context) error
+}
+
+type articleRevisionDiffHandler struct {
+    u usecase.ArticleRevisionUseCase
+}
+
+func NewArticleRevisionDiffHandler(u usecase.ArticleRevisionUseCase) ArticleRevisionDiffHandler {
+    return &articleRevisionDiffHandler{u}
+}
+
+func (h *articleRevisionDiffHandler) ArticleRevisionDiff(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return badRequest(err)
+	}
+	from, err := parseRevisionNumber("from", c.QueryParam("from"))
+	if err != nil {
+		return badRequest(err)
+	}
+	to, err := parseRevisionNumber("to", c.QueryParam("to"))
+	if err != nil {
+		return badRequest(err)
+	}
+    diff, err := h.u.DiffRevisions(c.Request().Context(), id, from, to)
+	if err != nil {
+		return err
+	}
+    return c.JSON(http.StatusOK, diff)
+}
@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/momonoki1990/tech-blog-api/application/usecase"
+)
+
+type ArticleRevisionGetHandler interface {
+    ArticleRevisionGet(c echo.Context) error
+}
+
+type articleRevisionGetHandler struct {
+    u usecase.ArticleRevisionUseCase
+}
+
+func NewArticleRevisionGetHandler(u usecase.ArticleRevisionUseCase) ArticleRevisionGetHandler {
+    return &articleRevisionGetHandler{u}
+}
+
+func (h *articleRevisionGetHandler) ArticleRevisionGet(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return badRequest(err)
+	}
+	number, err := parseRevisionNumber("number", c.Param("number"))
+	if err != nil {
+		return badRequest(err)
+	}
+    revision, err := h.u.GetRevision(c.Request().Context(), id, number)
+	if err != nil {
+		return err
+	}
+    return c.JSON(http.StatusOK, revision)
+}
+
+// 版の番号は1以上
+func parseRevisionNumber(name string, v string) (int, error) {
+	number, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, fmt.Errorf("%s should be an integer", name)
+	}
+	if number < 1 {
+		return 0, fmt.Errorf("%s should be at least %d", name, 1)
+	}
+	return number, nil
+}
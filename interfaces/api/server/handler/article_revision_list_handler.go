@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/momonoki1990/tech-blog-api/application/usecase"
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+)
+
+type ArticleRevisionListResponseBody struct {
+	Revisions []*model.ArticleRevision `json:"revisions"`
+}
+
+type ArticleRevisionListHandler interface {
+    ArticleRevisionList(c echo.Context) error
+}
+
+type articleRevisionListHandler struct {
+    u usecase.ArticleRevisionUseCase
+}
+
+func NewArticleRevisionListHandler(u usecase.ArticleRevisionUseCase) ArticleRevisionListHandler {
+    return &articleRevisionListHandler{u}
+}
+
+func (h *articleRevisionListHandler) ArticleRevisionList(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return badRequest(err)
+	}
+    revisions, err := h.u.GetRevisions(c.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+	if revisions == nil {
+		revisions = []*model.ArticleRevision{}
+	}
+    return c.JSON(http.StatusOK, &ArticleRevisionListResponseBody{Revisions: revisions})
+}
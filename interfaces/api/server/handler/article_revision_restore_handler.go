@@ -0,0 +1,36 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/momonoki1990/tech-blog-api/application/usecase"
+)
+
+type ArticleRevisionRestoreHandler interface {
+    RestoreArticleRevision(c echo.Context) error
+}
+
+type articleRevisionRestoreHandler struct {
+    u usecase.ArticleRevisionUseCase
+}
+
+func NewArticleRevisionRestoreHandler(u usecase.ArticleRevisionUseCase) ArticleRevisionRestoreHandler {
+    return &articleRevisionRestoreHandler{u}
+}
+
+func (h *articleRevisionRestoreHandler) RestoreArticleRevision(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return badRequest(err)
+	}
+	number, err := parseRevisionNumber("number", c.Param("number"))
+	if err != nil {
+		return badRequest(err)
+	}
+    if err := h.u.RestoreRevision(c.Request().Context(), id, number); err != nil {
+        return err
+    }
+    return c.String(http.StatusOK, "Restore article revision ok")
+}
@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/labstack/echo/v4"
+	"github.com/momonoki1990/tech-blog-api/application/usecase"
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+	"github.com/momonoki1990/tech-blog-api/domain/repository"
+)
+
+type ArticleSearchHit struct {
+	Article *model.Article `json:"article"`
+	Score float64 `json:"score"`
+	TitleHighlight string `json:"titleHighlight"`
+	ContentSnippet string `json:"contentSnippet"`
+}
+
+type ArticleSearchResponseBody struct {
+	Hits []*ArticleSearchHit `json:"hits"`
+}
+
+// MySQLの全文検索（ngram_token_size=2）では1文字の検索語に一致しないため、
+// どちらの検索の実装でも結果が変わらないよう、1文字の検索語は400で断る
+const searchTermMinLength = 2
+
+type ArticleSearchHandler interface {
+    SearchArticles(c echo.Context) error
+}
+
+type articleSearchHandler struct {
+    u usecase.ArticleSearchUseCase
+}
+
+func NewArticleSearchHandler(u usecase.ArticleSearchUseCase) ArticleSearchHandler {
+    return &articleSearchHandler{u}
+}
+
+func (h *articleSearchHandler) SearchArticles(c echo.Context) error {
+	limit := articleListDefaultLimit
+	if v := c.QueryParam("limit"); v != "" {
+		l, err := strconv.Atoi(v)
+		if err != nil {
+			return badRequest(err)
+		}
+		if l < 1 || l > articleListMaxLimit {
+			return badRequest(fmt.Errorf("limit should be from %d to %d", 1, articleListMaxLimit))
+		}
+		limit = l
+	}
+	if err := validateSearchTerms(c.QueryParam("q")); err != nil {
+		return badRequest(err)
+	}
+    hits, err := h.u.SearchArticles(c.Request().Context(), c.QueryParam("q"), limit)
+	if err != nil {
+		return err
+	}
+    return c.JSON(http.StatusOK, toArticleSearchResponseBody(hits))
+}
+
+// 空や長すぎる検索語はユースケースで検証する
+func validateSearchTerms(query string) error {
+	for _, v := range strings.Fields(query) {
+		if utf8.RuneCountInString(v) < searchTermMinLength {
+			return fmt.Errorf("each word in q should be at least %d characters", searchTermMinLength)
+		}
+	}
+	return nil
+}
+
+func toArticleSearchResponseBody(hits []*repository.ArticleHit) *ArticleSearchResponseBody {
+	body := &ArticleSearchResponseBody{Hits: []*ArticleSearchHit{}}
+	for _, v := range hits {
+		body.Hits = append(body.Hits, &ArticleSearchHit{
+			Article: v.Article,
+			Score: v.Score,
+			TitleHighlight: v.TitleHighlight,
+			ContentSnippet: v.ContentSnippet,
+		})
+	}
+	return body
+}
@@ -0,0 +1,30 @@
+package handler
+
+import (
+	"testing"
+)
+
+func TestValidateSearchTerms(t *testing.T) {
+	// Prepare
+	cases := []struct {
+		query string
+		ok bool
+	}{
+		{"Go", true},
+		{"検索 Go言語", true},
+		{"", true},
+		{"a", false},
+		{"Go a", false},
+		{"Go　本", false},
+	}
+
+	for _, v := range cases {
+		// Execute
+		err := validateSearchTerms(v.query)
+
+		// Check
+		if (err == nil) != v.ok {
+			t.Errorf("err of validateSearchTerms(%s): Expected ok=%v, but got %v", v.query, v.ok, err)
+		}
+	}
+}
@@ -1,8 +1,8 @@
 package handler
 
 import (
-	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
@@ -15,6 +15,10 @@ type UpdateArticleBody struct {
     CategoryId string `json:"categoryId"`
 	TagNames []string `json:"tagNames"`
 	ShouldPublish bool `json:"shouldPublish"`
+	// 省略時は変更しない
+	Slug string `json:"slug"`
+	// shouldPublishがtrueの場合のみ。未来の日時なら予約投稿になる
+	PublishedAt *time.Time `json:"publishedAt"`
 }
 
 type ArticleUpdateHandler interface {
@@ -31,20 +35,22 @@ func NewArticleUpdateHandler(u usecase.ArticleUseCase) ArticleUpdateHandler {
 
 func (h *articleUpdateHandler) UpdateArticle(c echo.Context) error {
 	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return badRequest(err)
+	}
+	version, err := ifMatchVersion(c)
 	if err != nil {
 		return err
 	}
     body := new(UpdateArticleBody)
     if err := c.Bind(body); err != nil {
-        fmt.Print(err)
-        return c.String(http.StatusBadRequest, "Bad request")
+		return badRequest(err)
     }
 	categoryId, err := uuid.Parse(body.CategoryId)
 	if err != nil {
-		fmt.Print(err)
-        return c.String(http.StatusBadRequest, "Bad request")
+		return badRequest(err)
 	}
-    if err := h.u.UpdateArticle(id, body.Title, body.Content, categoryId, body.TagNames, body.ShouldPublish); err != nil {
+    if err := h.u.UpdateArticle(c.Request().Context(), id, version, body.Title, body.Content, categoryId, body.TagNames, body.ShouldPublish, body.Slug, body.PublishedAt); err != nil {
         return err
     }
     return c.String(http.StatusOK, "Update article ok")
@@ -0,0 +1,57 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/momonoki1990/tech-blog-api/application/usecase"
+)
+
+type LoginBody struct {
+	Name string `json:"name"`
+	Password string `json:"password"`
+}
+
+type TokenResponseBody struct {
+	AccessToken string `json:"accessToken"`
+	RefreshToken string `json:"refreshToken"`
+	TokenType string `json:"tokenType"`
+	// アクセストークンの有効期限
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+type AuthLoginHandler interface {
+    Login(c echo.Context) error
+}
+
+type authLoginHandler struct {
+    u usecase.AuthUseCase
+}
+
+func NewAuthLoginHandler(u usecase.AuthUseCase) AuthLoginHandler {
+    return &authLoginHandler{u}
+}
+
+func (h *authLoginHandler) Login(c echo.Context) error {
+    body := new(LoginBody)
+    if err := c.Bind(body); err != nil {
+		return badRequest(err)
+    }
+    pair, err := h.u.Login(c.Request().Context(), body.Name, body.Password)
+    if err != nil {
+        return err
+    }
+    return tokenResponse(c, pair)
+}
+
+// トークンはキャッシュさせない（RFC 6749 5.1）
+func tokenResponse(c echo.Context, pair *usecase.TokenPair) error {
+	c.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+	return c.JSON(http.StatusOK, &TokenResponseBody{
+		AccessToken: pair.AccessToken,
+		RefreshToken: pair.RefreshToken,
+		TokenType: "Bearer",
+		ExpiresAt: pair.ExpiresAt,
+	})
+}
@@ -0,0 +1,32 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/momonoki1990/tech-blog-api/application/usecase"
+)
+
+type AuthLogoutHandler interface {
+    Logout(c echo.Context) error
+}
+
+type authLogoutHandler struct {
+    u usecase.AuthUseCase
+}
+
+func NewAuthLogoutHandler(u usecase.AuthUseCase) AuthLogoutHandler {
+    return &authLogoutHandler{u}
+}
+
+// refreshTokenは省略できる（省略した場合はアクセストークンだけを失効させる）
+func (h *authLogoutHandler) Logout(c echo.Context) error {
+    body := new(RefreshTokenBody)
+    if err := c.Bind(body); err != nil {
+		return badRequest(err)
+    }
+    if err := h.u.Logout(c.Request().Context(), body.RefreshToken); err != nil {
+        return err
+    }
+    return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,34 @@
+package handler
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/momonoki1990/tech-blog-api/application/usecase"
+)
+
+type RefreshTokenBody struct {
+	RefreshToken string `json:"refreshToken"`
+}
+
+type AuthRefreshHandler interface {
+    Refresh(c echo.Context) error
+}
+
+type authRefreshHandler struct {
+    u usecase.AuthUseCase
+}
+
+func NewAuthRefreshHandler(u usecase.AuthUseCase) AuthRefreshHandler {
+    return &authRefreshHandler{u}
+}
+
+func (h *authRefreshHandler) Refresh(c echo.Context) error {
+    body := new(RefreshTokenBody)
+    if err := c.Bind(body); err != nil {
+		return badRequest(err)
+    }
+    pair, err := h.u.Refresh(c.Request().Context(), body.RefreshToken)
+    if err != nil {
+        return err
+    }
+    return tokenResponse(c, pair)
+}
@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/momonoki1990/tech-blog-api/application/usecase"
+)
+
+type AuthorArticleListHandler interface {
+    AuthorArticleList(c echo.Context) error
+}
+
+type authorArticleListHandler struct {
+    u usecase.AuthorUseCase
+}
+
+func NewAuthorArticleListHandler(u usecase.AuthorUseCase) AuthorArticleListHandler {
+    return &authorArticleListHandler{u}
+}
+
+func (h *authorArticleListHandler) AuthorArticleList(c echo.Context) error {
+	sort := c.QueryParam("sort")
+	if sort == "" {
+		sort = "-publishedAt"
+	}
+	// 状態・カテゴリ・タグでは絞り込まない（公開済みの記事だけ）
+	criteria, err := toArticlePaging(c, sort)
+	if err != nil {
+		return badRequest(err)
+	}
+    articles, next, err := h.u.GetAuthorArticles(c.Request().Context(), c.Param("slug"), criteria)
+	if err != nil {
+		return err
+	}
+	responseBody, err := toArticleListResponseBody(sort, articles, next)
+	if err != nil {
+		return err
+	}
+    return c.JSON(http.StatusOK, responseBody)
+}
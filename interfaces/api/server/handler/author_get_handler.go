@@ -0,0 +1,28 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/momonoki1990/tech-blog-api/application/usecase"
+)
+
+type AuthorGetHandler interface {
+    AuthorGet(c echo.Context) error
+}
+
+type authorGetHandler struct {
+    u usecase.AuthorUseCase
+}
+
+func NewAuthorGetHandler(u usecase.AuthorUseCase) AuthorGetHandler {
+    return &authorGetHandler{u}
+}
+
+func (h *authorGetHandler) AuthorGet(c echo.Context) error {
+    author, err := h.u.GetAuthor(c.Request().Context(), c.Param("slug"))
+	if err != nil {
+		return err
+	}
+    return c.JSON(http.StatusOK, author)
+}
@@ -0,0 +1,33 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/momonoki1990/tech-blog-api/application/usecase"
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+)
+
+type AuthorListResponseBody struct {
+	Authors []*model.Author `json:"authors"`
+}
+
+type AuthorListHandler interface {
+    AuthorList(c echo.Context) error
+}
+
+type authorListHandler struct {
+    u usecase.AuthorUseCase
+}
+
+func NewAuthorListHandler(u usecase.AuthorUseCase) AuthorListHandler {
+    return &authorListHandler{u}
+}
+
+func (h *authorListHandler) AuthorList(c echo.Context) error {
+    authors, err := h.u.GetAuthors(c.Request().Context())
+	if err != nil {
+		return err
+	}
+    return c.JSON(http.StatusOK, &AuthorListResponseBody{Authors: authors})
+}
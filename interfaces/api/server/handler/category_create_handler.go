@@ -1,9 +1,9 @@
 package handler
 
 import (
-	"fmt"
 	"net/http"
 
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/momonoki1990/tech-blog-api/application/usecase"
 )
@@ -11,6 +11,11 @@ import (
 type CreateCategoryBody struct {
     Name string `json:"name"`
     DisplayOrder int `json:"displayOrder"`
+	// 省略時は最上位のカテゴリ
+	ParentId *string `json:"parentId"`
+	// 省略時は名前から生成する
+	Slug string `json:"slug"`
+	Description string `json:"description"`
 }
 type CreateCategoryResponseBody struct {
     CategoryId string `json:"categoryId"`
@@ -31,13 +36,26 @@ func NewCategoryCreateHandler(u usecase.CategoryUseCase) CategoryCreateHandler {
 func (h *categoryCreateHandler) CreateCategory(c echo.Context) error {
     body := new(CreateCategoryBody)
     if err := c.Bind(body); err != nil {
-        fmt.Print(err)
-        return c.String(http.StatusBadRequest, "Bad request")
+		return badRequest(err)
     }
-    categoryId, err := h.u.RegisterCategory(body.Name, body.DisplayOrder)
+	parentId, err := parseParentId(body.ParentId)
+	if err != nil {
+		return badRequest(err)
+	}
+    categoryId, err := h.u.RegisterCategory(c.Request().Context(), body.Name, body.DisplayOrder, parentId, body.Slug, body.Description)
     if err != nil {
         return err
     }
     responseBody := &CreateCategoryResponseBody{CategoryId: categoryId}
     return c.JSON(http.StatusCreated, responseBody)
-}
\ No newline at end of file
+}
+func parseParentId(v *string) (*uuid.UUID, error) {
+	if v == nil {
+		return nil, nil
+	}
+	parentId, err := uuid.Parse(*v)
+	if err != nil {
+		return nil, err
+	}
+	return &parentId, nil
+}
@@ -1,7 +1,6 @@
 package handler
 
 import (
-	"fmt"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -23,11 +22,23 @@ func NewCategoryDeleteHandler(u usecase.CategoryUseCase) CategoryDeleteHandler {
 
 func (h *categoryDeleteHandler) DeleteCategory(c echo.Context) error {
 	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return badRequest(err)
+	}
+	version, err := ifMatchVersion(c)
 	if err != nil {
 		return err
 	}
-    if err := h.u.DeleteCategory(id); err != nil {
-		fmt.Println("👹ここ")
+	// 記事があるカテゴリは、記事の移動先を指定しないと削除できない
+	var reassignTo *uuid.UUID
+	if v := c.QueryParam("reassignTo"); v != "" {
+		categoryId, err := uuid.Parse(v)
+		if err != nil {
+			return badRequest(err)
+		}
+		reassignTo = &categoryId
+	}
+    if err := h.u.DeleteCategory(c.Request().Context(), id, version, reassignTo); err != nil {
         return err
     }
     return c.String(http.StatusOK, "Delete category ok")
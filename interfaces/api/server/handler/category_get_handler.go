@@ -0,0 +1,34 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/momonoki1990/tech-blog-api/application/usecase"
+)
+
+type CategoryGetHandler interface {
+    CategoryGet(c echo.Context) error
+}
+
+type categoryGetHandler struct {
+    u usecase.CategoryUseCase
+}
+
+func NewCategoryGetHandler(u usecase.CategoryUseCase) CategoryGetHandler {
+    return &categoryGetHandler{u}
+}
+
+func (h *categoryGetHandler) CategoryGet(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return badRequest(err)
+	}
+    category, err := h.u.GetCategory(c.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+	setETag(c, category.Version)
+    return c.JSON(http.StatusOK, category)
+}
@@ -2,6 +2,7 @@ package handler
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/labstack/echo/v4"
 	"github.com/momonoki1990/tech-blog-api/application/usecase"
@@ -20,9 +21,34 @@ func NewCategoryListHandler(u usecase.CategoryUseCase) CategoryListHandler {
 }
 
 func (h *categoryListHandler) CategoryList(c echo.Context) error {
-    categories, err := h.u.GetCategoryList()
+	tree, err := boolQueryParam(c, "tree")
+	if err != nil {
+		return badRequest(err)
+	}
+	// 記事の集計は必要な場合だけ（一覧の表示には不要なことが多い）
+	stats, err := boolQueryParam(c, "stats")
+	if err != nil {
+		return badRequest(err)
+	}
+	if tree {
+		nodes, err := h.u.GetCategoryTree(c.Request().Context(), stats)
+		if err != nil {
+			return err
+		}
+		return c.JSON(http.StatusOK, nodes)
+	}
+    categories, err := h.u.GetCategoryList(c.Request().Context(), stats)
 	if err != nil {
 		return err
 	}
     return c.JSON(http.StatusOK, categories)
-}
\ No newline at end of file
+}
+
+// 省略時はfalse
+func boolQueryParam(c echo.Context, name string) (bool, error) {
+	v := c.QueryParam(name)
+	if v == "" {
+		return false, nil
+	}
+	return strconv.ParseBool(v)
+}
@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/momonoki1990/tech-blog-api/application/usecase"
+)
+
+// 省略した項目は変更しない
+type PatchCategoryBody struct {
+    Name *string `json:"name"`
+    DisplayOrder *int `json:"displayOrder"`
+	// nullなら最上位のカテゴリにする
+	ParentId nullableString `json:"parentId"`
+	Slug *string `json:"slug"`
+	Description *string `json:"description"`
+}
+
+type CategoryPatchHandler interface {
+    PatchCategory(c echo.Context) error
+}
+
+type categoryPatchHandler struct {
+    u usecase.CategoryUseCase
+}
+
+func NewCategoryPatchHandler(u usecase.CategoryUseCase) CategoryPatchHandler {
+    return &categoryPatchHandler{u}
+}
+
+func (h *categoryPatchHandler) PatchCategory(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return badRequest(err)
+	}
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		return err
+	}
+    body := new(PatchCategoryBody)
+    if err := bindMergePatch(c, body, "parentId"); err != nil {
+		return err
+    }
+	patch := &usecase.CategoryPatch{Name: body.Name, DisplayOrder: body.DisplayOrder, SetParent: body.ParentId.Set, Slug: body.Slug, Description: body.Description}
+	if body.ParentId.Set {
+		parentId, err := parseParentId(body.ParentId.Value)
+		if err != nil {
+			return badRequest(err)
+		}
+		patch.ParentId = parentId
+	}
+    category, err := h.u.PatchCategory(c.Request().Context(), id, version, patch)
+    if err != nil {
+        return err
+    }
+	setETag(c, category.Version)
+    return c.JSON(http.StatusOK, category)
+}
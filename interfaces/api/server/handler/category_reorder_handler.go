@@ -0,0 +1,45 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/momonoki1990/tech-blog-api/application/usecase"
+)
+
+type ReorderCategoriesBody struct {
+    CategoryIds []string `json:"categoryIds"`
+}
+
+type CategoryReorderHandler interface {
+    ReorderCategories(c echo.Context) error
+}
+
+type categoryReorderHandler struct {
+    u usecase.CategoryUseCase
+}
+
+func NewCategoryReorderHandler(u usecase.CategoryUseCase) CategoryReorderHandler {
+    return &categoryReorderHandler{u}
+}
+
+func (h *categoryReorderHandler) ReorderCategories(c echo.Context) error {
+    body := new(ReorderCategoriesBody)
+    if err := c.Bind(body); err != nil {
+		return badRequest(err)
+    }
+	ids := make([]uuid.UUID, 0, len(body.CategoryIds))
+	for _, v := range body.CategoryIds {
+		id, err := uuid.Parse(v)
+		if err != nil {
+			return badRequest(err)
+		}
+		ids = append(ids, id)
+	}
+    categories, err := h.u.ReorderCategories(c.Request().Context(), ids)
+    if err != nil {
+        return err
+    }
+    return c.JSON(http.StatusOK, categories)
+}
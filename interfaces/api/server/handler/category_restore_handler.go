@@ -0,0 +1,32 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/momonoki1990/tech-blog-api/application/usecase"
+)
+
+type CategoryRestoreHandler interface {
+    RestoreCategory(c echo.Context) error
+}
+
+type categoryRestoreHandler struct {
+    u usecase.TrashUseCase
+}
+
+func NewCategoryRestoreHandler(u usecase.TrashUseCase) CategoryRestoreHandler {
+    return &categoryRestoreHandler{u}
+}
+
+func (h *categoryRestoreHandler) RestoreCategory(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return badRequest(err)
+	}
+    if err := h.u.RestoreCategory(c.Request().Context(), id); err != nil {
+        return err
+    }
+    return c.String(http.StatusOK, "Restore category ok")
+}
@@ -1,7 +1,6 @@
 package handler
 
 import (
-	"fmt"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -12,6 +11,11 @@ import (
 type UpdateCategoryBody struct {
     Name string `json:"name"`
     DisplayOrder int `json:"displayOrder"`
+	// 省略時は最上位のカテゴリにする
+	ParentId *string `json:"parentId"`
+	// 省略時は変更しない
+	Slug string `json:"slug"`
+	Description string `json:"description"`
 }
 
 type CategoryUpdateHandler interface {
@@ -28,15 +32,22 @@ func NewCategoryUpdateHandler(u usecase.CategoryUseCase) CategoryUpdateHandler {
 
 func (h *categoryUpdateHandler) UpdateCategory(c echo.Context) error {
 	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return badRequest(err)
+	}
+	version, err := ifMatchVersion(c)
 	if err != nil {
 		return err
 	}
     body := new(UpdateCategoryBody)
     if err := c.Bind(body); err != nil {
-        fmt.Print(err)
-        return c.String(http.StatusBadRequest, "Bad request")
+		return badRequest(err)
     }
-    if err := h.u.UpdateCategory(id, body.Name, body.DisplayOrder); err != nil {
+	parentId, err := parseParentId(body.ParentId)
+	if err != nil {
+		return badRequest(err)
+	}
+    if err := h.u.UpdateCategory(c.Request().Context(), id, version, body.Name, body.DisplayOrder, parentId, body.Slug, body.Description); err != nil {
         return err
     }
     return c.String(http.StatusOK, "Update category ok")
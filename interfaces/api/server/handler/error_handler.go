@@ -0,0 +1,132 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/momonoki1990/tech-blog-api/domain/errs"
+)
+
+const problemContentType = "application/problem+json"
+
+// RFC 7807 のレスポンスボディ
+type ProblemDetails struct {
+	Type string `json:"type"`
+	Title string `json:"title"`
+	Status int `json:"status"`
+	Detail string `json:"detail,omitempty"`
+	Code string `json:"code"`
+	Errors []errs.FieldError `json:"errors,omitempty"`
+	Details interface{} `json:"details,omitempty"`
+}
+
+// echoのHTTPErrorHandlerとして登録する。ハンドラはエラーをそのまま返せばよい
+func HTTPErrorHandler(err error, c echo.Context) {
+	if c.Response().Committed {
+		return
+	}
+	problem := toProblemDetails(err, c.Echo().Debug)
+	if problem.Status >= http.StatusInternalServerError {
+		c.Logger().Error(err)
+	}
+
+	// RFC 6750: 401にはどの方式で認証すればよいかを示す
+	if problem.Status == http.StatusUnauthorized {
+		c.Response().Header().Set(echo.HeaderWWWAuthenticate, wwwAuthenticate(problem.Code))
+	}
+
+	var sendErr error
+	if c.Request().Method == http.MethodHead {
+		sendErr = c.NoContent(problem.Status)
+	} else {
+		c.Response().Header().Set(echo.HeaderContentType, problemContentType)
+		sendErr = c.JSON(problem.Status, problem)
+	}
+	if sendErr != nil {
+		c.Logger().Error(sendErr)
+	}
+}
+
+func toProblemDetails(err error, debug bool) *ProblemDetails {
+	if e, ok := errs.As(err); ok {
+		status := http.StatusInternalServerError
+		switch e.Kind {
+		case errs.NotFound:
+			status = http.StatusNotFound
+		case errs.Conflict:
+			status = http.StatusConflict
+		case errs.Validation:
+			status = http.StatusUnprocessableEntity
+		case errs.PreconditionFailed:
+			status = http.StatusPreconditionFailed
+		case errs.Unauthenticated:
+			status = http.StatusUnauthorized
+		case errs.Forbidden:
+			status = http.StatusForbidden
+		}
+		problem := newProblemDetails(status, e.Code, e.Message, e.Fields)
+		problem.Details = e.Details
+		return problem
+	}
+
+	var he *echo.HTTPError
+	if errors.As(err, &he) {
+		detail := ""
+		if m, ok := he.Message.(string); ok {
+			detail = m
+		}
+		return newProblemDetails(he.Code, codeFromStatus(he.Code), detail, nil)
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return newProblemDetails(http.StatusServiceUnavailable, "request_timeout", "Request timed out", nil)
+	}
+
+	// 想定外のエラーの内容はデバッグ時のみ返す
+	detail := ""
+	if debug {
+		detail = err.Error()
+	}
+	return newProblemDetails(http.StatusInternalServerError, "internal_error", detail, nil)
+}
+
+func newProblemDetails(status int, code string, detail string, fields []errs.FieldError) *ProblemDetails {
+	return &ProblemDetails{
+		Type: "about:blank",
+		Title: http.StatusText(status),
+		Status: status,
+		Detail: detail,
+		Code: code,
+		Errors: fields,
+	}
+}
+
+// 例: 404 -> "not_found"
+func codeFromStatus(status int) string {
+	text := http.StatusText(status)
+	if text == "" {
+		return fmt.Sprintf("http_%d", status)
+	}
+	return strings.ToLower(strings.ReplaceAll(text, " ", "_"))
+}
+
+// トークンがない場合はエラーコードを付けない（RFC 6750 3.1）
+func wwwAuthenticate(code string) string {
+	if code == errs.CodeInvalidToken || code == errs.CodeTokenExpired {
+		return `Bearer error="invalid_token"`
+	}
+	return "Bearer"
+}
+
+func badRequest(err error) error {
+	// c.Bindのエラーは既にHTTPError
+	var he *echo.HTTPError
+	if errors.As(err, &he) {
+		return he
+	}
+	return echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
+}
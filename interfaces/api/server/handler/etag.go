@@ -0,0 +1,45 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// バージョンをETagとして返す（例: "3"）
+func setETag(c echo.Context, version int) {
+	c.Response().Header().Set("ETag", formatETag(version))
+}
+
+func formatETag(version int) string {
+	return strconv.Quote(strconv.Itoa(version))
+}
+
+// 更新・削除ではIf-Matchを必須にし、GETで返したETagからバージョンを取り出す
+func ifMatchVersion(c echo.Context) (int, error) {
+	header := c.Request().Header.Get("If-Match")
+	if header == "" {
+		return 0, echo.NewHTTPError(http.StatusPreconditionRequired, "If-Match header is required")
+	}
+	version, err := parseETag(header)
+	if err != nil {
+		return 0, badRequest(err)
+	}
+	return version, nil
+}
+
+// 弱いETag（W/"3"）や*は受け付けない（どのバージョンに対する変更か分からないため）
+func parseETag(header string) (int, error) {
+	v := strings.TrimSpace(header)
+	if len(v) < 2 || v[0] != '"' || v[len(v)-1] != '"' {
+		return 0, fmt.Errorf("If-Match should be an ETag returned by GET, but got %s", header)
+	}
+	version, err := strconv.Atoi(v[1 : len(v)-1])
+	if err != nil || version < 1 {
+		return 0, fmt.Errorf("If-Match should be an ETag returned by GET, but got %s", header)
+	}
+	return version, nil
+}
@@ -0,0 +1,37 @@
+package handler
+
+import (
+	"testing"
+)
+
+func TestParseETag(t *testing.T) {
+	// Prepare
+	cases := []struct {
+		header string
+		version int
+		ok bool
+	}{
+		{`"3"`, 3, true},
+		{` "12" `, 12, true},
+		{formatETag(7), 7, true},
+		{`3`, 0, false},
+		{`W/"3"`, 0, false},
+		{`*`, 0, false},
+		{`"0"`, 0, false},
+		{`"abc"`, 0, false},
+		{`"3", "4"`, 0, false},
+	}
+
+	for _, v := range cases {
+		// Execute
+		version, err := parseETag(v.header)
+
+		// Check
+		if (err == nil) != v.ok {
+			t.Errorf("err of parseETag(%s): Expected ok=%v, but got %v", v.header, v.ok, err)
+		}
+		if version != v.version {
+			t.Errorf("parseETag(%s): Expected %d, but got %d", v.header, v.version, version)
+		}
+	}
+}
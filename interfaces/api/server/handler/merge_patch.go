@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+const mergePatchContentType = "application/merge-patch+json"
+
+// RFC 7396 のJSON Merge Patchをdstに読み込む。dstのフィールドはポインタにしておき、nilなら変更しない
+// nullableに挙げたメンバー以外は削除できないので、nullを指定した場合は400を返す
+func bindMergePatch(c echo.Context, dst interface{}, nullable ...string) error {
+	mediaType, _, err := mime.ParseMediaType(c.Request().Header.Get(echo.HeaderContentType))
+	if err != nil || (mediaType != mergePatchContentType && mediaType != echo.MIMEApplicationJSON) {
+		return echo.NewHTTPError(http.StatusUnsupportedMediaType, fmt.Sprintf("Content-Type should be %s", mergePatchContentType))
+	}
+	body, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		return badRequest(err)
+	}
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(body, &members); err != nil || members == nil {
+		return badRequest(fmt.Errorf("merge patch should be a JSON object"))
+	}
+	for k, v := range members {
+		if string(bytes.TrimSpace(v)) == "null" && !containsString(nullable, k) {
+			return badRequest(fmt.Errorf("%s cannot be removed", k))
+		}
+	}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(dst); err != nil {
+		return badRequest(err)
+	}
+	return nil
+}
+
+func containsString(values []string, v string) bool {
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
+
+// nullを指定できるメンバー（省略した場合はSetがfalse、nullの場合はValueがnil）
+type nullableString struct {
+	Set bool
+	Value *string
+}
+
+func (n *nullableString) UnmarshalJSON(data []byte) error {
+	n.Set = true
+	return json.Unmarshal(data, &n.Value)
+}
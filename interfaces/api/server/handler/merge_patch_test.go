@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+func TestBindMergePatch(t *testing.T) {
+	// Prepare
+	cases := []struct {
+		contentType string
+		body string
+		status int
+	}{
+		{"application/merge-patch+json", `{"name": "Name1"}`, 0},
+		{"application/json; charset=UTF-8", `{"displayOrder": 3}`, 0},
+		{"text/plain", `{"name": "Name1"}`, http.StatusUnsupportedMediaType},
+		{"application/merge-patch+json", `["name"]`, http.StatusBadRequest},
+		{"application/merge-patch+json", `null`, http.StatusBadRequest},
+		{"application/merge-patch+json", `{"name": null}`, http.StatusBadRequest},
+		{"application/merge-patch+json", `{"unknown": 1}`, http.StatusBadRequest},
+	}
+
+	for _, v := range cases {
+		req := httptest.NewRequest(http.MethodPatch, "/category/1", strings.NewReader(v.body))
+		req.Header.Set(echo.HeaderContentType, v.contentType)
+		c := echo.New().NewContext(req, httptest.NewRecorder())
+
+		// Execute
+		body := new(PatchCategoryBody)
+		err := bindMergePatch(c, body)
+
+		// Check
+		status := 0
+		if he, ok := err.(*echo.HTTPError); ok {
+			status = he.Code
+		} else if err != nil {
+			status = -1
+		}
+		if status != v.status {
+			t.Errorf("status of bindMergePatch(%s): Expected %d, but got %d (%v)", v.body, v.status, status, err)
+		}
+	}
+}
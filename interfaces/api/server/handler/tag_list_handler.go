@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/momonoki1990/tech-blog-api/application/usecase"
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+	"github.com/momonoki1990/tech-blog-api/domain/repository"
+)
+
+type TagListResponseBody struct {
+	Tags []*model.TagUsage `json:"tags"`
+}
+
+type TagListHandler interface {
+    TagList(c echo.Context) error
+}
+
+type tagListHandler struct {
+    u usecase.TagUseCase
+}
+
+func NewTagListHandler(u usecase.TagUseCase) TagListHandler {
+    return &tagListHandler{u}
+}
+
+func (h *tagListHandler) TagList(c echo.Context) error {
+	var sortKey repository.TagSortKey
+	switch sort := c.QueryParam("sort"); sort {
+	case "", "-articleCount":
+		sortKey = repository.SortTagsByCount
+	case "name":
+		sortKey = repository.SortTagsByName
+	default:
+		return badRequest(fmt.Errorf("Invalid sort %q", sort))
+	}
+    tags, err := h.u.GetTagList(c.Request().Context(), sortKey)
+	if err != nil {
+		return err
+	}
+    return c.JSON(http.StatusOK, &TagListResponseBody{Tags: tags})
+}
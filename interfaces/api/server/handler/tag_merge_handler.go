@@ -0,0 +1,37 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/momonoki1990/tech-blog-api/application/usecase"
+)
+
+type MergeTagsBody struct {
+    Sources []string `json:"sources"`
+    Target string `json:"target"`
+}
+
+type TagMergeHandler interface {
+    MergeTags(c echo.Context) error
+}
+
+type tagMergeHandler struct {
+    u usecase.TagUseCase
+}
+
+func NewTagMergeHandler(u usecase.TagUseCase) TagMergeHandler {
+    return &tagMergeHandler{u}
+}
+
+func (h *tagMergeHandler) MergeTags(c echo.Context) error {
+    body := new(MergeTagsBody)
+    if err := c.Bind(body); err != nil {
+		return badRequest(err)
+    }
+    tag, err := h.u.MergeTags(c.Request().Context(), body.Sources, body.Target)
+    if err != nil {
+        return err
+    }
+    return c.JSON(http.StatusOK, tag)
+}
@@ -0,0 +1,42 @@
+package handler
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/labstack/echo/v4"
+	"github.com/momonoki1990/tech-blog-api/application/usecase"
+)
+
+type RenameTagBody struct {
+    Name string `json:"name"`
+}
+
+type TagRenameHandler interface {
+    RenameTag(c echo.Context) error
+}
+
+type tagRenameHandler struct {
+    u usecase.TagUseCase
+}
+
+func NewTagRenameHandler(u usecase.TagUseCase) TagRenameHandler {
+    return &tagRenameHandler{u}
+}
+
+func (h *tagRenameHandler) RenameTag(c echo.Context) error {
+	// タグ名には記号や日本語も使えるので、エンコードされたまま渡された場合に備えて戻す
+	name, err := url.PathUnescape(c.Param("name"))
+	if err != nil {
+		return badRequest(err)
+	}
+    body := new(RenameTagBody)
+    if err := c.Bind(body); err != nil {
+		return badRequest(err)
+    }
+    tag, err := h.u.RenameTag(c.Request().Context(), name, body.Name)
+    if err != nil {
+        return err
+    }
+    return c.JSON(http.StatusOK, tag)
+}
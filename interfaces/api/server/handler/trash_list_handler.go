@@ -0,0 +1,34 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/momonoki1990/tech-blog-api/application/usecase"
+	"github.com/momonoki1990/tech-blog-api/domain/model"
+)
+
+type TrashListResponseBody struct {
+	Articles []*model.Article `json:"articles"`
+	Categories []*model.Category `json:"categories"`
+}
+
+type TrashListHandler interface {
+    TrashList(c echo.Context) error
+}
+
+type trashListHandler struct {
+    u usecase.TrashUseCase
+}
+
+func NewTrashListHandler(u usecase.TrashUseCase) TrashListHandler {
+    return &trashListHandler{u}
+}
+
+func (h *trashListHandler) TrashList(c echo.Context) error {
+    articles, categories, err := h.u.GetTrash(c.Request().Context())
+	if err != nil {
+		return err
+	}
+    return c.JSON(http.StatusOK, &TrashListResponseBody{Articles: articles, Categories: categories})
+}
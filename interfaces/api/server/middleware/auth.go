@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/momonoki1990/tech-blog-api/application/auth"
+	"github.com/momonoki1990/tech-blog-api/application/usecase"
+	"github.com/momonoki1990/tech-blog-api/domain/errs"
+)
+
+// Authorization: Bearer のアクセストークンまたはAPIキーを検証し、送り手をリクエストのcontextに入れる
+// requiredがfalseならトークンのないリクエストもそのまま通す（トークンが不正なら拒否する）
+func Authenticate(u usecase.AuthUseCase, required bool) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			token, ok := bearerToken(c.Request().Header.Get(echo.HeaderAuthorization))
+			if !ok {
+				if required {
+					return errs.NewUnauthenticated(errs.CodeAuthenticationRequired, "Authentication is required")
+				}
+				return next(c)
+			}
+			ctx := c.Request().Context()
+			principal, err := u.Authenticate(ctx, token)
+			if err != nil {
+				return err
+			}
+			c.SetRequest(c.Request().WithContext(auth.WithPrincipal(ctx, principal)))
+			return next(c)
+		}
+	}
+}
+
+// スキーム名は大文字・小文字を区別しない（RFC 7235）
+func bearerToken(header string) (string, bool) {
+	scheme, token, ok := strings.Cut(header, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
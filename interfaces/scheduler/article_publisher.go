@@ -0,0 +1,46 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/momonoki1990/tech-blog-api/application/usecase"
+)
+
+// 予約投稿を定期的に公開する
+type ArticlePublisher struct {
+	u usecase.ArticlePublishUseCase
+	timeout time.Duration
+}
+
+func NewArticlePublisher(u usecase.ArticlePublishUseCase, timeout time.Duration) *ArticlePublisher {
+	return &ArticlePublisher{u, timeout}
+}
+
+// 起動直後に1回、以降はticksを受け取るたびに実行する（ctxがキャンセルされるまで）
+// 本番ではtime.Ticker、テストでは任意のチャネルを渡す
+func (p *ArticlePublisher) Run(ctx context.Context, ticks <-chan time.Time) {
+	p.publish(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticks:
+			p.publish(ctx)
+		}
+	}
+}
+
+func (p *ArticlePublisher) publish(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+	n, err := p.u.PublishDueArticles(ctx)
+	if err != nil {
+		log.Printf("failed to publish scheduled articles: %v", err)
+		return
+	}
+	if n > 0 {
+		log.Printf("published %d scheduled articles", n)
+	}
+}
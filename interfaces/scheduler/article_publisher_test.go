@@ -0,0 +1,43 @@
+package scheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+type fakeArticlePublishUseCase struct {
+	calls chan struct{}
+}
+
+func (u *fakeArticlePublishUseCase) PublishDueArticles(ctx context.Context) (int64, error) {
+	u.calls <- struct{}{}
+	return 0, nil
+}
+
+func TestArticlePublisherRun(t *testing.T) {
+	// Prepare
+	u := &fakeArticlePublishUseCase{calls: make(chan struct{}, 10)}
+	ticks := make(chan time.Time)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	// Execute
+	go func() {
+		NewArticlePublisher(u, time.Second).Run(ctx, ticks)
+		close(done)
+	}()
+	// 起動直後の1回
+	<-u.calls
+	ticks <- time.Now()
+	<-u.calls
+	ticks <- time.Now()
+	<-u.calls
+	cancel()
+	<-done
+
+	// Check
+	if len(u.calls) != 0 {
+		t.Errorf("len(u.calls): Expected %d, but got %d", 0, len(u.calls))
+	}
+}
@@ -0,0 +1,40 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/momonoki1990/tech-blog-api/application/usecase"
+)
+
+// tag_aliasesを定期的に読み込み直す
+type TagAliasReloader struct {
+	u usecase.TagAliasUseCase
+	timeout time.Duration
+}
+
+func NewTagAliasReloader(u usecase.TagAliasUseCase, timeout time.Duration) *TagAliasReloader {
+	return &TagAliasReloader{u, timeout}
+}
+
+// ticksを受け取るたびに実行する（起動時の別名はmainで読み込み済み。ctxがキャンセルされるまで）
+func (p *TagAliasReloader) Run(ctx context.Context, ticks <-chan time.Time) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticks:
+			p.reload(ctx)
+		}
+	}
+}
+
+// 読み込みに失敗した場合は前回の別名を使い続ける
+func (p *TagAliasReloader) reload(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+	if err := p.u.ReloadTagAliases(ctx); err != nil {
+		log.Printf("failed to reload tag aliases: %v", err)
+	}
+}
@@ -0,0 +1,45 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/momonoki1990/tech-blog-api/application/usecase"
+)
+
+// 保持期間を過ぎたゴミ箱の中身を定期的に完全削除する
+type TrashPurger struct {
+	u usecase.TrashPurgeUseCase
+	timeout time.Duration
+}
+
+func NewTrashPurger(u usecase.TrashPurgeUseCase, timeout time.Duration) *TrashPurger {
+	return &TrashPurger{u, timeout}
+}
+
+// 起動直後に1回、以降はticksを受け取るたびに実行する（ctxがキャンセルされるまで）
+func (p *TrashPurger) Run(ctx context.Context, ticks <-chan time.Time) {
+	p.purge(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticks:
+			p.purge(ctx)
+		}
+	}
+}
+
+func (p *TrashPurger) purge(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+	articles, categories, err := p.u.PurgeTrash(ctx)
+	if err != nil {
+		log.Printf("failed to purge trash: %v", err)
+		return
+	}
+	if articles > 0 || categories > 0 {
+		log.Printf("purged %d articles and %d categories from trash", articles, categories)
+	}
+}
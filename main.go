@@ -8,13 +8,23 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"strings"
+	"time"
+
+	_ "time/tzdata"
 
 	_ "github.com/go-sql-driver/mysql"
 
 	"github.com/momonoki1990/tech-blog-api/application/usecase"
+	"github.com/momonoki1990/tech-blog-api/domain/repository"
 	"github.com/momonoki1990/tech-blog-api/domain/service"
 	"github.com/momonoki1990/tech-blog-api/infra/database"
+	"github.com/momonoki1990/tech-blog-api/infra/markdown"
+	"github.com/momonoki1990/tech-blog-api/infra/search"
+	"github.com/momonoki1990/tech-blog-api/infra/token"
 	"github.com/momonoki1990/tech-blog-api/interfaces/api/server/handler"
+	apiMiddleware "github.com/momonoki1990/tech-blog-api/interfaces/api/server/middleware"
+	"github.com/momonoki1990/tech-blog-api/interfaces/scheduler"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
@@ -34,34 +44,183 @@ func connectToDb() (*sql.DB) {
 
 }
 
+// 環境変数が未設定なら既定値を使う（例: READ_TIMEOUT=5s）
+func durationFromEnv(key string, defaultValue time.Duration) time.Duration {
+    v := os.Getenv(key)
+    if v == "" {
+        return defaultValue
+    }
+    d, err := time.ParseDuration(v)
+    if err != nil {
+        log.Fatalf("%s is invalid: %v", key, err)
+    }
+    return d
+}
+
+// SEARCH_BACKEND=memoryならMySQLの全文検索の代わりにプロセス内のインデックスを使う
+func newArticleSearcher(db *sql.DB, ar repository.ArticleRepository) repository.ArticleSearcher {
+    switch backend := os.Getenv("SEARCH_BACKEND"); backend {
+    case "", "mysql":
+        return database.NewArticleSearcher(db)
+    case "memory":
+        return search.NewMemoryIndex(ar, durationFromEnv("SEARCH_INDEX_TTL", time.Minute))
+    default:
+        log.Fatalf("SEARCH_BACKEND is invalid: %s", backend)
+        return nil
+    }
+}
+
+// TAG_NORMALIZERS=nfkc,trim のように、タグ名に適用する正規化を順に指定する（未設定なら既定）
+func tagNormalizerNames() []string {
+    v := os.Getenv("TAG_NORMALIZERS")
+    if v == "" {
+        return nil
+    }
+    return strings.Split(v, ",")
+}
+
+// アーカイブの年月を数える地域（TIME_ZONE=Asia/Tokyo のように指定する。未設定なら日本時間）
+func archiveLocation() *time.Location {
+    name := os.Getenv("TIME_ZONE")
+    if name == "" {
+        name = "Asia/Tokyo"
+    }
+    loc, err := time.LoadLocation(name)
+    if err != nil {
+        log.Fatalf("TIME_ZONE is invalid: %v", err)
+    }
+    return loc
+}
+
+// JWT_KEYS=id:base64,... の先頭の鍵で署名する（鍵の入れ替えはREADMEを参照）
+func newTokenSigner() *token.JWTSigner {
+    v := os.Getenv("JWT_KEYS")
+    if v == "" {
+        log.Fatal("JWT_KEYS is required")
+    }
+    keys, err := token.ParseKeys(v)
+    if err != nil {
+        log.Fatalf("JWT_KEYS is invalid: %v", err)
+    }
+    signer, err := token.NewJWTSigner(keys)
+    if err != nil {
+        log.Fatalf("JWT_KEYS is invalid: %v", err)
+    }
+    return signer
+}
+
 func main() {
     db := connectToDb()
-    ctx := context.TODO()
+    rules, err := usecase.LoadTagNameRules(context.Background(), database.NewTagAliasRepository(db), tagNormalizerNames())
+    if err != nil {
+        log.Fatal(err)
+    }
     e := echo.New()
     stage := flag.String("stage", "prd", "Stage in which the application runs")
     flag.Parse()
     if *stage == "local" {
         e.Debug = true
     }
+    e.HTTPErrorHandler = handler.HTTPErrorHandler
     e.Use(middleware.Logger())
     e.GET("/hello", func(c echo.Context) error {
         return c.String(http.StatusOK, "Hello, World!")
     })
-    cr := database.NewCategoryRepository(ctx, db)
+    read := apiMiddleware.Deadline(durationFromEnv("READ_TIMEOUT", 5*time.Second))
+    write := apiMiddleware.Deadline(durationFromEnv("WRITE_TIMEOUT", 10*time.Second))
+
+    kr := database.NewApiKeyRepository(db)
+    auu := usecase.NewAuthUseCase(
+        database.NewUserRepository(db),
+        database.NewRevokedTokenRepository(db),
+        kr,
+        newTokenSigner(),
+        durationFromEnv("ACCESS_TOKEN_TTL", 15*time.Minute),
+        durationFromEnv("REFRESH_TOKEN_TTL", 30*24*time.Hour),
+        time.Now,
+    )
+    // 書き込みと管理用の読み取りには認証（アクセストークンかAPIキー）が必要。記事の読み取りは認証していれば下書きも返す
+    authn := apiMiddleware.Authenticate(auu, true)
+    optionalAuthn := apiMiddleware.Authenticate(auu, false)
+    e.POST("/auth/login", handler.NewAuthLoginHandler(auu).Login, write)
+    e.POST("/auth/refresh", handler.NewAuthRefreshHandler(auu).Refresh, write)
+    e.POST("/auth/logout", handler.NewAuthLogoutHandler(auu).Logout, write, authn)
+
+    ku := usecase.NewApiKeyUseCase(kr, time.Now)
+    e.POST("/api-key", handler.NewApiKeyCreateHandler(ku).CreateApiKey, write, authn)
+    e.GET("/api-keys", handler.NewApiKeyListHandler(ku).ApiKeyList, read, authn)
+    e.DELETE("/api-key/:id", handler.NewApiKeyRevokeHandler(ku).RevokeApiKey, write, authn)
+
+    tm := database.NewTxManager(db)
+    cr := database.NewCategoryRepository(db)
     cc := service.NewCategoryCreator(cr)
-    cu := usecase.NewCategoryUseCase(cr, cc)
-    e.GET("/categories", handler.NewCategoryListHandler(cu).CategoryList)
-    e.POST("/category", handler.NewCategoryCreateHandler(cu).CreateCategory)
-    e.PUT("/category/:id", handler.NewCategoryUpdateHandler(cu).UpdateCategory)
-    e.DELETE("/category/:id", handler.NewCategoryDeleteHandler(cu).DeleteCategory)
-
-    ar := database.NewArticleRepository(ctx, db)
-    au := usecase.NewArticleUseCase(ar)
-    e.GET("/article/:id", handler.NewArticleGetHandler(au).ArticleGet)
-    e.GET("/articles", handler.NewArticleListHandler(au).ArticleList)
-    e.POST("/article", handler.NewArticleCreateHandler(au).CreateArticle)
-    e.PUT("/article/:id", handler.NewArticleUpdateHandler(au).UpdateArticle)
-    e.DELETE("/article/:id", handler.NewArticleDeleteHandler(au).DeleteArticle)
+    cs := service.NewCategorySlugAssigner()
+    cu := usecase.NewCategoryUseCase(cr, cc, cs, tm)
+    e.GET("/categories", handler.NewCategoryListHandler(cu).CategoryList, read, optionalAuthn)
+    e.GET("/categories/:id", handler.NewCategoryGetHandler(cu).CategoryGet, read, optionalAuthn)
+    e.POST("/category", handler.NewCategoryCreateHandler(cu).CreateCategory, write, authn)
+    e.PUT("/category/:id", handler.NewCategoryUpdateHandler(cu).UpdateCategory, write, authn)
+    e.PATCH("/category/:id", handler.NewCategoryPatchHandler(cu).PatchCategory, write, authn)
+    e.DELETE("/category/:id", handler.NewCategoryDeleteHandler(cu).DeleteCategory, write, authn)
+    e.PUT("/categories/order", handler.NewCategoryReorderHandler(cu).ReorderCategories, write, authn)
+
+    ar := database.NewArticleRepository(db)
+    ac := service.NewArticleCreator(rules)
+    av := service.NewArticleValidator()
+    sa := service.NewArticleSlugAssigner()
+    // 変換結果は記事1000件分までキャッシュする
+    rr := markdown.NewCachedRenderer(markdown.NewConverter(), 1000)
+    au := usecase.NewArticleUseCase(ar, tm, ac, av, sa, rr, rules)
+    e.GET("/article/:id", handler.NewArticleGetHandler(au).ArticleGet, read, optionalAuthn)
+    e.GET("/articles/by-slug/:slug", handler.NewArticleGetBySlugHandler(au).ArticleGetBySlug, read, optionalAuthn)
+    e.GET("/articles", handler.NewArticleListHandler(au).ArticleList, read, optionalAuthn)
+    e.GET("/articles/search", handler.NewArticleSearchHandler(usecase.NewArticleSearchUseCase(newArticleSearcher(db, ar))).SearchArticles, read)
+    e.POST("/article", handler.NewArticleCreateHandler(au).CreateArticle, write, authn)
+    e.PUT("/article/:id", handler.NewArticleUpdateHandler(au).UpdateArticle, write, authn)
+    e.PATCH("/article/:id", handler.NewArticlePatchHandler(au).PatchArticle, write, authn)
+    e.DELETE("/article/:id", handler.NewArticleDeleteHandler(au).DeleteArticle, write, authn)
+
+    hu := usecase.NewArchiveUseCase(ar, archiveLocation())
+    e.GET("/archives", handler.NewArchiveListHandler(hu).ArchiveList, read)
+    e.GET("/archives/:year/:month", handler.NewArchiveArticleListHandler(hu).ArchiveArticleList, read)
+
+    wu := usecase.NewAuthorUseCase(database.NewAuthorRepository(db), ar)
+    e.GET("/authors", handler.NewAuthorListHandler(wu).AuthorList, read)
+    e.GET("/authors/:slug", handler.NewAuthorGetHandler(wu).AuthorGet, read)
+    e.GET("/authors/:slug/articles", handler.NewAuthorArticleListHandler(wu).AuthorArticleList, read)
+
+    vu := usecase.NewArticleRevisionUseCase(ar, database.NewArticleRevisionRepository(db), tm, av, rr, rules)
+    e.GET("/article/:id/revisions", handler.NewArticleRevisionListHandler(vu).ArticleRevisionList, read, authn)
+    e.GET("/article/:id/revisions/diff", handler.NewArticleRevisionDiffHandler(vu).ArticleRevisionDiff, read, authn)
+    e.GET("/article/:id/revisions/:number", handler.NewArticleRevisionGetHandler(vu).ArticleRevisionGet, read, authn)
+    e.POST("/article/:id/revisions/:number/restore", handler.NewArticleRevisionRestoreHandler(vu).RestoreArticleRevision, write, authn)
+
+    tgu := usecase.NewTagUseCase(database.NewTagRepository(db), tm, rules)
+    e.GET("/tags", handler.NewTagListHandler(tgu).TagList, read, optionalAuthn)
+    e.PUT("/tag/:name", handler.NewTagRenameHandler(tgu).RenameTag, write, authn)
+    e.POST("/tags/merge", handler.NewTagMergeHandler(tgu).MergeTags, write, authn)
+
+    // 予約投稿の公開（複数インスタンスで動いても同じ記事を二重に公開しない）
+    pu := usecase.NewArticlePublishUseCase(ar, time.Now)
+    ticker := time.NewTicker(durationFromEnv("PUBLISH_INTERVAL", time.Minute))
+    defer ticker.Stop()
+    go scheduler.NewArticlePublisher(pu, durationFromEnv("WRITE_TIMEOUT", 10*time.Second)).Run(context.Background(), ticker.C)
+
+    // tag_aliasesの変更はTAG_ALIAS_RELOAD_INTERVAL（デフォルト1分）ごとに読み込み直す
+    aliasTicker := time.NewTicker(durationFromEnv("TAG_ALIAS_RELOAD_INTERVAL", time.Minute))
+    defer aliasTicker.Stop()
+    go scheduler.NewTagAliasReloader(usecase.NewTagAliasUseCase(database.NewTagAliasRepository(db), rules), durationFromEnv("READ_TIMEOUT", 5*time.Second)).Run(context.Background(), aliasTicker.C)
+
+    tu := usecase.NewTrashUseCase(ar, cr, tm, av)
+    e.GET("/trash", handler.NewTrashListHandler(tu).TrashList, read, authn)
+    e.POST("/article/:id/restore", handler.NewArticleRestoreHandler(tu).RestoreArticle, write, authn)
+    e.POST("/category/:id/restore", handler.NewCategoryRestoreHandler(tu).RestoreCategory, write, authn)
+
+    // ゴミ箱に移してからTRASH_RETENTION（デフォルト30日）を過ぎたものを完全に削除する
+    gu := usecase.NewTrashPurgeUseCase(tm, durationFromEnv("TRASH_RETENTION", 30*24*time.Hour), time.Now)
+    purgeTicker := time.NewTicker(durationFromEnv("TRASH_PURGE_INTERVAL", time.Hour))
+    defer purgeTicker.Stop()
+    go scheduler.NewTrashPurger(gu, durationFromEnv("WRITE_TIMEOUT", 10*time.Second)).Run(context.Background(), purgeTicker.C)
 
     e.Logger.Fatal(e.Start(":1323"))
 }
\ No newline at end of file
@@ -0,0 +1,7 @@
+
+-- +migrate Up
+-- 日本語は空白で区切られないため、ngramパーサ（2文字単位）で索引する
+ALTER TABLE articles ADD FULLTEXT INDEX ft_articles_title_content (title, content) WITH PARSER ngram;
+
+-- +migrate Down
+ALTER TABLE articles DROP INDEX ft_articles_title_content;
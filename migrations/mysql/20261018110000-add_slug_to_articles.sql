@@ -0,0 +1,11 @@
+
+-- +migrate Up
+ALTER TABLE articles ADD COLUMN slug VARCHAR(100) NULL AFTER title;
+-- 既存の記事はIdをそのままスラッグにする（タイトルからの生成は記事の更新時に行える）
+UPDATE articles SET slug = id;
+ALTER TABLE articles MODIFY COLUMN slug VARCHAR(100) NOT NULL;
+ALTER TABLE articles ADD UNIQUE INDEX uq_articles_slug (slug);
+
+-- +migrate Down
+ALTER TABLE articles DROP INDEX uq_articles_slug;
+ALTER TABLE articles DROP COLUMN slug;
@@ -0,0 +1,11 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS article_slug_histories (
+    slug VARCHAR(100) NOT NULL PRIMARY KEY,
+    article_id CHAR(36) NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (article_id) REFERENCES articles(id)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS article_slug_histories;
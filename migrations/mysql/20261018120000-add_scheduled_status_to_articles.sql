@@ -0,0 +1,9 @@
+
+-- +migrate Up
+ALTER TABLE articles ADD CONSTRAINT chk_articles_status CHECK (status IN ('Draft', 'Scheduled', 'Published'));
+-- 予約投稿の公開処理で公開日時を過ぎたScheduledの記事を探す
+ALTER TABLE articles ADD INDEX idx_articles_status_published_at (status, published_at);
+
+-- +migrate Down
+ALTER TABLE articles DROP INDEX idx_articles_status_published_at;
+ALTER TABLE articles DROP CHECK chk_articles_status;
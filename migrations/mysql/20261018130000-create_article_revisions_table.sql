@@ -0,0 +1,17 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS article_revisions (
+    id CHAR(36) NOT NULL PRIMARY KEY,
+    article_id CHAR(36) NOT NULL,
+    revision_number INT NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    content TEXT NOT NULL,
+    category_id CHAR(36) NOT NULL,
+    tag_names JSON NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (article_id) REFERENCES articles(id),
+    UNIQUE KEY uq_article_revisions_article_id_revision_number (article_id, revision_number)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS article_revisions;
@@ -0,0 +1,15 @@
+
+-- +migrate Up
+ALTER TABLE articles ADD COLUMN deleted_at DATETIME NULL;
+-- ゴミ箱に移したときに外したタグ（復元時に付け直す）
+ALTER TABLE articles ADD COLUMN deleted_tag_names JSON NULL;
+ALTER TABLE articles ADD INDEX idx_articles_deleted_at (deleted_at);
+ALTER TABLE categories ADD COLUMN deleted_at DATETIME NULL;
+ALTER TABLE categories ADD INDEX idx_categories_deleted_at (deleted_at);
+
+-- +migrate Down
+ALTER TABLE categories DROP INDEX idx_categories_deleted_at;
+ALTER TABLE categories DROP COLUMN deleted_at;
+ALTER TABLE articles DROP INDEX idx_articles_deleted_at;
+ALTER TABLE articles DROP COLUMN deleted_tag_names;
+ALTER TABLE articles DROP COLUMN deleted_at;
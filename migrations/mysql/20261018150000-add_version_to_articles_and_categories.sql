@@ -0,0 +1,9 @@
+
+-- +migrate Up
+-- 楽観的排他制御用（更新のたびに1増える）
+ALTER TABLE articles ADD COLUMN version INT NOT NULL DEFAULT 1;
+ALTER TABLE categories ADD COLUMN version INT NOT NULL DEFAULT 1;
+
+-- +migrate Down
+ALTER TABLE categories DROP COLUMN version;
+ALTER TABLE articles DROP COLUMN version;
@@ -0,0 +1,11 @@
+
+-- +migrate Up
+-- 別名（正規化した後の名前）と正式なタグ名。tag_nameのタグはまだなくてもよい
+CREATE TABLE IF NOT EXISTS tag_aliases (
+    alias VARCHAR(255) NOT NULL PRIMARY KEY,
+    tag_name VARCHAR(255) NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS tag_aliases;
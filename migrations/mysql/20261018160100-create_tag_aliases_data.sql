@@ -0,0 +1,7 @@
+
+-- +migrate Up
+INSERT INTO tech_blog.tag_aliases (alias, tag_name) VALUES
+    ('golang', 'Go');
+
+-- +migrate Down
+DELETE FROM tech_blog.tag_aliases WHERE alias IN ('golang');
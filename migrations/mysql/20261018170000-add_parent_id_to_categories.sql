@@ -0,0 +1,9 @@
+
+-- +migrate Up
+-- NULLなら最上位。親をゴミ箱から完全に削除することがあるので外部キーにはしない
+ALTER TABLE categories ADD COLUMN parent_id CHAR(36) NULL;
+ALTER TABLE categories ADD INDEX idx_categories_parent_id (parent_id);
+
+-- +migrate Down
+ALTER TABLE categories DROP INDEX idx_categories_parent_id;
+ALTER TABLE categories DROP COLUMN parent_id;
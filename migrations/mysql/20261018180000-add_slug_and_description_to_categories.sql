@@ -0,0 +1,13 @@
+
+-- +migrate Up
+ALTER TABLE categories ADD COLUMN slug VARCHAR(100) NULL AFTER `name`;
+-- 既存のカテゴリはIdをそのままスラッグにする（名前からの生成はカテゴリの更新時に行える）
+UPDATE categories SET slug = id;
+ALTER TABLE categories MODIFY COLUMN slug VARCHAR(100) NOT NULL;
+ALTER TABLE categories ADD UNIQUE INDEX uq_categories_slug (slug);
+ALTER TABLE categories ADD COLUMN description VARCHAR(500) NOT NULL DEFAULT '' AFTER slug;
+
+-- +migrate Down
+ALTER TABLE categories DROP COLUMN description;
+ALTER TABLE categories DROP INDEX uq_categories_slug;
+ALTER TABLE categories DROP COLUMN slug;
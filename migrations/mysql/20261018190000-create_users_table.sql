@@ -0,0 +1,14 @@
+
+-- +migrate Up
+-- 管理画面にログインするユーザー（パスワードはbcryptのハッシュのみ保存する）
+CREATE TABLE IF NOT EXISTS users (
+    id CHAR(36) NOT NULL PRIMARY KEY,
+    name VARCHAR(50) NOT NULL,
+    password_hash VARCHAR(255) NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uq_users_name (name)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS users;
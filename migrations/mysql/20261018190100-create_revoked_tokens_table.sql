@@ -0,0 +1,12 @@
+
+-- +migrate Up
+-- 有効期限より前に失効させたトークン（期限を過ぎた行は消してよい）
+CREATE TABLE IF NOT EXISTS revoked_tokens (
+    token_id CHAR(36) NOT NULL PRIMARY KEY,
+    expires_at DATETIME NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    KEY idx_revoked_tokens_expires_at (expires_at)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS revoked_tokens;
@@ -0,0 +1,8 @@
+
+-- +migrate Up
+ALTER TABLE users ADD COLUMN role VARCHAR(20) NOT NULL DEFAULT 'writer' AFTER password_hash;
+-- 役割を入れる前のユーザーはすべての操作ができたので、adminにする
+UPDATE users SET role = 'admin';
+
+-- +migrate Down
+ALTER TABLE users DROP COLUMN role;
@@ -0,0 +1,9 @@
+
+-- +migrate Up
+-- 記事を作ったユーザー（認証を入れる前の記事はNULL。editor以上だけが編集できる）
+ALTER TABLE articles ADD COLUMN created_by CHAR(36) NULL;
+ALTER TABLE articles ADD CONSTRAINT fk_articles_created_by FOREIGN KEY (created_by) REFERENCES users(id);
+
+-- +migrate Down
+ALTER TABLE articles DROP FOREIGN KEY fk_articles_created_by;
+ALTER TABLE articles DROP COLUMN created_by;
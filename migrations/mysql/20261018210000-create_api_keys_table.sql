@@ -0,0 +1,22 @@
+
+-- +migrate Up
+-- CIなど機械から使うAPIキー（キーはSHA-256のハッシュのみ保存し、作成時に1度だけ返す）
+CREATE TABLE IF NOT EXISTS api_keys (
+    id CHAR(36) NOT NULL PRIMARY KEY,
+    user_id CHAR(36) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    -- 一覧でキーを見分けるための先頭部分
+    prefix VARCHAR(16) NOT NULL,
+    key_hash CHAR(64) NOT NULL,
+    -- カンマ区切りの権限（articles:write など）
+    scopes VARCHAR(500) NOT NULL,
+    expires_at DATETIME NULL,
+    last_used_at DATETIME NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uq_api_keys_key_hash (key_hash),
+    INDEX idx_api_keys_user_id (user_id),
+    CONSTRAINT fk_api_keys_user_id FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS api_keys;
@@ -0,0 +1,41 @@
+
+-- +migrate Up
+-- 記事の著者のプロフィール（ログインするユーザーとは別。ユーザーに紐づかない寄稿者もいる）
+CREATE TABLE IF NOT EXISTS authors (
+    id CHAR(36) NOT NULL PRIMARY KEY,
+    -- 記事を書いたときに既定の著者にするユーザー
+    user_id CHAR(36) NULL,
+    display_name VARCHAR(100) NOT NULL,
+    slug VARCHAR(100) NOT NULL,
+    bio TEXT NOT NULL,
+    avatar_url VARCHAR(255) NOT NULL DEFAULT '',
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uq_authors_slug (slug),
+    UNIQUE KEY uq_authors_user_id (user_id),
+    CONSTRAINT fk_authors_user_id FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE SET NULL
+);
+
+-- 記事と著者の多対多（positionは表示順）
+CREATE TABLE IF NOT EXISTS article_authors (
+    article_id CHAR(36) NOT NULL,
+    author_id CHAR(36) NOT NULL,
+    position INT NOT NULL,
+    PRIMARY KEY (article_id, author_id),
+    INDEX idx_article_authors_author_id (author_id),
+    CONSTRAINT fk_article_authors_article_id FOREIGN KEY (article_id) REFERENCES articles(id) ON DELETE CASCADE,
+    CONSTRAINT fk_article_authors_author_id FOREIGN KEY (author_id) REFERENCES authors(id)
+);
+
+-- 記事を作ったことのあるユーザーの著者を作り、その記事の著者にする
+INSERT INTO authors (id, user_id, display_name, slug, bio)
+SELECT UUID(), users.id, users.name, CONCAT('author-', LEFT(users.id, 8)), ''
+FROM users WHERE EXISTS (SELECT 1 FROM articles WHERE articles.created_by = users.id);
+
+INSERT INTO article_authors (article_id, author_id, position)
+SELECT articles.id, authors.id, 0
+FROM articles INNER JOIN authors ON authors.user_id = articles.created_by;
+
+-- +migrate Down
+DROP TABLE IF EXISTS article_authors;
+DROP TABLE IF EXISTS authors;